@@ -0,0 +1,97 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/depshubhq/depshub/internal/project"
+	"github.com/depshubhq/depshub/internal/report"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	licensesCmd.Flags().StringP("format", "f", "text", fmt.Sprintf("output format (%s)", strings.Join(report.Formats, ", ")))
+	licensesCmd.Flags().StringP("output", "o", "", "write the report to a file instead of the standard output")
+	licensesCmd.Flags().String("notices", "", "write the third-party notices with the license texts to a file, like THIRD_PARTY_NOTICES")
+
+	rootCmd.AddCommand(licensesCmd)
+}
+
+var licensesCmd = &cobra.Command{
+	Use:   "licenses [flags] [path]",
+	Short: "List the licenses of your dependencies",
+	Long:  `List every dependency of your project with its ecosystem, version and license, grouped by license.`,
+	Args:  cobra.RangeArgs(0, 1),
+	Run: func(cmd *cobra.Command, args []string) {
+		configPath, _ := cmd.Flags().GetString("config")
+		format, _ := cmd.Flags().GetString("format")
+		output, _ := cmd.Flags().GetString("output")
+		notices, _ := cmd.Flags().GetString("notices")
+
+		if !slices.Contains(report.Formats, format) {
+			exitWithError(fmt.Errorf("unknown format %q, use one of %s", format, strings.Join(report.Formats, ", ")))
+		}
+
+		var p = "."
+
+		if len(args) > 0 {
+			p = args[0]
+		}
+
+		proj, err := project.Scan(p, configPath)
+
+		if err != nil {
+			exitWithError(err)
+		}
+
+		// Keep the standard output for the report
+		fmt.Fprintf(os.Stderr, "Scanning %d manifest files. \n", len(proj.Manifests))
+
+		if err := proj.Fetch(); err != nil {
+			fmt.Fprintln(os.Stderr, "Error: ", err)
+		}
+
+		entries := report.Inventory(proj.Manifests, proj.Packages)
+
+		if err := writeFile(output, func(w io.Writer) error {
+			return report.Write(w, format, report.GroupByLicense(entries))
+		}); err != nil {
+			exitWithError(err)
+		}
+
+		if notices != "" {
+			if err := writeFile(notices, func(w io.Writer) error {
+				return report.WriteNotices(w, entries)
+			}); err != nil {
+				exitWithError(err)
+			}
+		}
+	},
+}
+
+// writeFile writes to a file, or to the standard output when the path is empty.
+func writeFile(path string, write func(w io.Writer) error) error {
+	if path == "" {
+		return write(os.Stdout)
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := write(file); err != nil {
+		file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+func exitWithError(err error) {
+	fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+	os.Exit(1)
+}
@@ -11,6 +11,8 @@ import (
 )
 
 func init() {
+	lintCmd.Flags().String("sbom", "", "lint the packages of a CycloneDX or SPDX JSON document instead of the manifests of the path")
+
 	rootCmd.AddCommand(lintCmd)
 }
 
@@ -27,6 +29,8 @@ var lintCmd = &cobra.Command{
 			return
 		}
 
+		sbomPath, _ := cmd.Flags().GetString("sbom")
+
 		var p = "."
 
 		if len(args) > 0 {
@@ -34,7 +38,13 @@ var lintCmd = &cobra.Command{
 		}
 
 		lint := linter.New()
-		mistakes, err := lint.Run(p, configPath)
+
+		var mistakes []types.Mistake
+		if sbomPath != "" {
+			mistakes, err = lint.RunSBOM(sbomPath, configPath)
+		} else {
+			mistakes, err = lint.Run(p, configPath)
+		}
 
 		if err != nil {
 			fmt.Printf("Error: %s", err)
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/depshubhq/depshub/internal/project"
+	"github.com/depshubhq/depshub/internal/sbom"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	sbomCmd.Flags().StringP("format", "f", sbom.CycloneDXJSON, fmt.Sprintf("SBOM format (%s)", strings.Join(sbom.Formats, ", ")))
+	sbomCmd.Flags().StringP("output", "o", "", "write the SBOM to a file instead of the standard output")
+
+	rootCmd.AddCommand(sbomCmd)
+}
+
+var sbomCmd = &cobra.Command{
+	Use:   "sbom [flags] [path]",
+	Short: "Export a software bill of materials",
+	Long:  `Export the dependencies of your project as a software bill of materials in the CycloneDX or SPDX format.`,
+	Args:  cobra.RangeArgs(0, 1),
+	Run: func(cmd *cobra.Command, args []string) {
+		configPath, _ := cmd.Flags().GetString("config")
+		format, _ := cmd.Flags().GetString("format")
+		output, _ := cmd.Flags().GetString("output")
+
+		if !slices.Contains(sbom.Formats, format) {
+			exitWithError(fmt.Errorf("unknown format %q, use one of %s", format, strings.Join(sbom.Formats, ", ")))
+		}
+
+		var p = "."
+
+		if len(args) > 0 {
+			p = args[0]
+		}
+
+		proj, err := project.Scan(p, configPath)
+
+		if err != nil {
+			exitWithError(err)
+		}
+
+		// Keep the standard output for the SBOM
+		fmt.Fprintf(os.Stderr, "Scanning %d manifest files. \n", len(proj.Manifests))
+
+		if err := proj.Fetch(); err != nil {
+			fmt.Fprintln(os.Stderr, "Error: ", err)
+		}
+
+		name := p
+		if abs, err := filepath.Abs(p); err == nil {
+			name = filepath.Base(abs)
+		}
+
+		doc := sbom.New(sbom.NewMetadata(name, version), proj.Manifests, proj.Packages)
+
+		if err := writeFile(output, func(w io.Writer) error {
+			return sbom.Write(w, format, doc)
+		}); err != nil {
+			exitWithError(err)
+		}
+	},
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/depshubhq/depshub/internal/project"
+	"github.com/depshubhq/depshub/internal/report"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	whyCmd.Flags().StringP("format", "f", "text", fmt.Sprintf("output format (%s)", strings.Join(report.WhyFormats, ", ")))
+	whyCmd.Flags().StringP("ecosystem", "e", "", "only search the packages of an ecosystem, like npm or cargo")
+
+	rootCmd.AddCommand(whyCmd)
+}
+
+var whyCmd = &cobra.Command{
+	Use:   "why [flags] <name>[@version] [path]",
+	Short: "Explain why a package is installed",
+	Long:  `Print the paths from the direct dependencies of your manifests to a package, using the dependency graphs of the lockfiles.`,
+	Args:  cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		configPath, _ := cmd.Flags().GetString("config")
+		format, _ := cmd.Flags().GetString("format")
+		ecosystem, _ := cmd.Flags().GetString("ecosystem")
+
+		if !slices.Contains(report.WhyFormats, format) {
+			exitWithError(fmt.Errorf("unknown format %q, use one of %s", format, strings.Join(report.WhyFormats, ", ")))
+		}
+
+		var p = "."
+
+		if len(args) > 1 {
+			p = args[1]
+		}
+
+		proj, err := project.Scan(p, configPath)
+
+		if err != nil {
+			exitWithError(err)
+		}
+
+		name, version := report.ParseQuery(args[0])
+		paths, truncated := report.Why(proj.Manifests, name, version, ecosystem)
+
+		if err := report.WriteWhy(os.Stdout, format, args[0], paths); err != nil {
+			exitWithError(err)
+		}
+
+		for _, id := range truncated {
+			fmt.Fprintf(os.Stderr, "Only the first %d paths to %s are listed\n", report.MaxPaths, id)
+		}
+
+		// Like grep, the package not being found is a failure
+		if len(paths) == 0 {
+			os.Exit(1)
+		}
+	},
+}
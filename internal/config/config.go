@@ -26,6 +26,10 @@ type Rule struct {
 	Disabled bool        `mapstructure:"disabled"`
 	Value    any         `mapstructure:"value"`
 	Level    types.Level `mapstructure:"level"`
+	// Check the transitive packages of the lockfiles too, for the rules supporting it
+	IncludeTransitive bool `mapstructure:"include_transitive"`
+	// How the rules checking licenses handle the ones that aren't known: allow, warn or deny
+	UnknownLicenses string `mapstructure:"unknown_licenses"`
 }
 
 type ManifestFile struct {
@@ -131,6 +135,24 @@ func (c Config) Apply(manifestPath string, packageName string, rule types.Rule)
 					}
 				}
 
+				if configRule.IncludeTransitive {
+					transitiveRule, ok := rule.(types.TransitiveRule)
+					if !ok {
+						return fmt.Errorf("rule %q doesn't support include_transitive", rule.GetName())
+					}
+					transitiveRule.SetIncludeTransitive(true)
+				}
+
+				if configRule.UnknownLicenses != "" {
+					licensesRule, ok := rule.(types.UnknownLicensesRule)
+					if !ok {
+						return fmt.Errorf("rule %q doesn't support unknown_licenses", rule.GetName())
+					}
+					if err := licensesRule.SetUnknownLicenses(configRule.UnknownLicenses); err != nil {
+						return fmt.Errorf("failed to set unknown_licenses for rule %q: %w", rule.GetName(), err)
+					}
+				}
+
 				break
 			}
 		}
@@ -138,3 +160,17 @@ func (c Config) Apply(manifestPath string, packageName string, rule types.Rule)
 
 	return nil
 }
+
+// IncludesTransitive reports whether a rule checks the transitive packages of
+// the lockfiles, which need their information to be fetched too.
+func (c Config) IncludesTransitive() bool {
+	for _, mf := range c.config.ManifestFiles {
+		for _, rule := range mf.Rules {
+			if rule.IncludeTransitive && !rule.Disabled {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -76,5 +76,75 @@ func (m *mockRule) GetName() string                       { return m.name }
 func (m *mockRule) IsSupported(mt types.ManagerType) bool { return true }
 func (m *mockRule) SetLevel(l types.Level)                { m.level = l }
 func (m *mockRule) SetValue(v any) error                  { m.value = v.(int); return nil }
+func (m *mockRule) Reset()                                { m.level = types.LevelError }
+
+// mockTransitiveRule is a rule checking transitive packages
+type mockTransitiveRule struct {
+	mockRule
+	includeTransitive bool
+}
+
+func (m *mockTransitiveRule) SetIncludeTransitive(include bool) { m.includeTransitive = include }
+
+func TestConfig_IncludeTransitive(t *testing.T) {
+	c := Config{config: ConfigFile{
+		ManifestFiles: []ManifestFile{{
+			Filter: "**",
+			Rules: []Rule{
+				{Name: "transitive-rule", IncludeTransitive: true},
+				{Name: "test-rule", IncludeTransitive: true},
+			},
+		}},
+	}}
+
+	assert.True(t, c.IncludesTransitive())
+	assert.False(t, Config{}.IncludesTransitive())
+
+	transitive := &mockTransitiveRule{mockRule: mockRule{name: "transitive-rule"}}
+	assert.NoError(t, c.Apply("package.json", "react", transitive))
+	assert.True(t, transitive.includeTransitive)
+
+	// Rules that only check direct dependencies can't include transitive packages
+	err := c.Apply("package.json", "react", &mockRule{name: "test-rule"})
+	assert.ErrorContains(t, err, "doesn't support include_transitive")
+}
+
+// mockLicensesRule is a rule checking licenses
+type mockLicensesRule struct {
+	mockRule
+	unknownLicenses string
+}
+
+func (m *mockLicensesRule) SetUnknownLicenses(value string) error {
+	if value != "allow" && value != "warn" && value != "deny" {
+		return types.ErrInvalidRuleValue
+	}
+	m.unknownLicenses = value
+	return nil
+}
+
+func TestConfig_UnknownLicenses(t *testing.T) {
+	config := func(value string) Config {
+		return Config{config: ConfigFile{
+			ManifestFiles: []ManifestFile{{
+				Filter: "**",
+				Rules: []Rule{
+					{Name: "licenses-rule", UnknownLicenses: value},
+					{Name: "test-rule", UnknownLicenses: value},
+				},
+			}},
+		}}
+	}
+
+	rule := &mockLicensesRule{mockRule: mockRule{name: "licenses-rule"}}
+	assert.NoError(t, config("deny").Apply("package.json", "react", rule))
+	assert.Equal(t, "deny", rule.unknownLicenses)
+
+	err := config("block").Apply("package.json", "react", rule)
+	assert.ErrorIs(t, err, types.ErrInvalidRuleValue)
+
+	err = config("deny").Apply("package.json", "react", &mockRule{name: "test-rule"})
+	assert.ErrorContains(t, err, "doesn't support unknown_licenses")
+}
 
 // TODO add tests for the Apply function
@@ -3,10 +3,8 @@ package linter
 import (
 	"fmt"
 
-	"github.com/depshubhq/depshub/internal/config"
 	"github.com/depshubhq/depshub/internal/linter/rules"
-	"github.com/depshubhq/depshub/pkg/manager"
-	"github.com/depshubhq/depshub/pkg/sources"
+	"github.com/depshubhq/depshub/internal/project"
 	"github.com/depshubhq/depshub/pkg/types"
 )
 
@@ -18,12 +16,16 @@ func New() Linter {
 	return Linter{
 		rules: []types.Rule{
 			rules.NewRuleAllowedLicenses(),
+			rules.NewRuleAllowedPackages(),
+			rules.NewRuleBannedPackages(),
 			rules.NewRuleLockfile(),
+			rules.NewRuleLockfileInSync(),
 			rules.NewRuleMaxLibyear(),
 			rules.NewRuleMaxMajorUpdates(),
 			rules.NewRuleMaxMinorUpdates(),
 			rules.NewRuleMaxPackageAge(),
 			rules.NewRuleMaxPatchUpdates(),
+			rules.NewRuleMinReleaseAge(),
 			rules.NewRuleMinWeeklyDownloads(),
 			rules.NewRuleNoAnyTag(),
 			rules.NewRuleNoDeprecated(),
@@ -31,41 +33,56 @@ func New() Linter {
 			rules.NewRuleNoMultipleVersions(),
 			rules.NewRuleNoPreRelease(),
 			rules.NewRuleNoUnstable(),
+			rules.NewRulePinnedActions(),
+			rules.NewRulePinnedImages(),
 			rules.NewRuleSorted(),
 		},
 	}
 }
 
 func (l Linter) Run(path string, configPath string) (mistakes []types.Mistake, err error) {
-	config, err := config.New(configPath)
+	p, err := project.Scan(path, configPath)
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to load config: %w", err)
+		return nil, err
 	}
 
-	scanner := manager.New(config)
-	manifests, err := scanner.Scan(path)
-	if err != nil {
-		return nil, fmt.Errorf("failed to scan manifests: %w", err)
-	}
-
-	fmt.Printf("Scanning %d manifest files. \n", len(manifests))
+	fmt.Printf("Scanning %d manifest files. \n", len(p.Manifests))
 
-	for _, manifest := range manifests {
+	for _, manifest := range p.Manifests {
 		fmt.Printf("  - %s \n", manifest.Path)
 	}
 
-	uniqueDependencies := scanner.UniqueDependencies(manifests)
+	return l.check(p)
+}
 
-	packagesData, err := sources.NewFetcher().Fetch(uniqueDependencies)
+// RunSBOM runs the rules on the packages of a CycloneDX or SPDX document
+// instead of the manifests of a path.
+func (l Linter) RunSBOM(sbomPath string, configPath string) (mistakes []types.Mistake, err error) {
+	p, err := project.FromSBOM(sbomPath, configPath)
 
 	if err != nil {
+		return nil, err
+	}
+
+	count := 0
+	for _, manifest := range p.Manifests {
+		count += len(manifest.Dependencies)
+	}
+
+	fmt.Printf("Checking %d packages of %s. \n", count, sbomPath)
+
+	return l.check(p)
+}
+
+func (l Linter) check(p project.Project) (mistakes []types.Mistake, err error) {
+	if err := p.Fetch(); err != nil {
 		fmt.Println("Error: ", err)
 	}
 
 	// Run all rules
 	for _, rule := range l.rules {
-		m, err := rule.Check(manifests, packagesData, config)
+		m, err := rule.Check(p.Manifests, p.Packages, p.Config)
 
 		if err != nil {
 			return nil, fmt.Errorf("rule check failed: %w", err)
@@ -2,29 +2,46 @@ package rules
 
 import (
 	"slices"
+	"strings"
 
+	"github.com/depshubhq/depshub/pkg/licenses"
 	"github.com/depshubhq/depshub/pkg/types"
 )
 
-var DefaultAllowedLicenses = []string{"", "MIT", "Apache-2.0"}
+var DefaultAllowedLicenses = []string{"MIT", "Apache-2.0"}
+
+// How the packages without a license or with one that isn't an SPDX identifier are handled
+const (
+	UnknownLicensesAllow = "allow"
+	UnknownLicensesWarn  = "warn"
+	UnknownLicensesDeny  = "deny"
+)
 
 type RuleAllowedLicenses struct {
 	name      string
 	level     types.Level
 	supported []types.ManagerType
 	value     []string
+	unknown   string
+	// True for the warnings about packages allowed only by unknown licenses
+	unknownLicense bool
 }
 
 func NewRuleAllowedLicenses() *RuleAllowedLicenses {
 	return &RuleAllowedLicenses{
 		name:      "allowed-licenses",
 		level:     types.LevelError,
-		supported: []types.ManagerType{types.Npm, types.Go, types.Cargo, types.Pip, types.Hex, types.Pyproject, types.Maven},
+		supported: []types.ManagerType{types.Npm, types.Go, types.Cargo, types.Pip, types.Hex, types.Pyproject, types.Maven, types.Pipfile, types.Bundler, types.Composer, types.NuGet, types.Conda},
 		value:     DefaultAllowedLicenses,
+		unknown:   UnknownLicensesAllow,
 	}
 }
 
 func (r RuleAllowedLicenses) GetMessage() string {
+	if r.unknownLicense {
+		return `The license of the package is not known. Check that it is allowed.`
+	}
+
 	return `The license of the package is not allowed.`
 }
 
@@ -60,10 +77,50 @@ func (r *RuleAllowedLicenses) SetValue(value any) error {
 	return types.ErrInvalidRuleValue
 }
 
+func (r *RuleAllowedLicenses) SetUnknownLicenses(value string) error {
+	switch value {
+	case UnknownLicensesAllow, UnknownLicensesWarn, UnknownLicensesDeny:
+		r.unknown = value
+		return nil
+	}
+
+	return types.ErrInvalidRuleValue
+}
+
 func (r *RuleAllowedLicenses) Reset() {
 	*r = *NewRuleAllowedLicenses()
 }
 
+// allowed checks a license against the identifiers and categories of the list.
+// Licenses that aren't SPDX identifiers are only allowed when they are listed.
+func (r RuleAllowedLicenses) allowed(license licenses.License) bool {
+	for _, value := range r.value {
+		if category, ok := licenses.ParseCategory(value); ok {
+			if license.Known && licenses.CategoryOf(license.ID) == category {
+				return true
+			}
+			continue
+		}
+
+		// Exceptions only add permissions, so GPL-2.0-only WITH Classpath-exception-2.0
+		// is allowed when GPL-2.0-only is
+		if id, ok := licenses.Normalize(value); ok && license.Known && id == license.ID {
+			return true
+		}
+
+		if strings.EqualFold(value, license.String()) || strings.EqualFold(value, license.ID) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// allowedOrUnknown also allows the licenses that aren't SPDX identifiers.
+func (r RuleAllowedLicenses) allowedOrUnknown(license licenses.License) bool {
+	return !license.Known || r.allowed(license)
+}
+
 func (r RuleAllowedLicenses) Check(manifests []types.Manifest, info types.PackagesInfo, c types.Config) ([]types.Mistake, error) {
 	mistakes := []types.Mistake{}
 
@@ -79,13 +136,39 @@ func (r RuleAllowedLicenses) Check(manifests []types.Manifest, info types.Packag
 				return nil, err
 			}
 
-			if pkg, ok := info[dep.Name]; ok {
-				if !slices.Contains(r.value, pkg.License) {
-					mistakes = append(mistakes, types.Mistake{
-						Rule:        r,
-						Definitions: []types.Definition{dep.Definition},
-					})
+			if pkg, ok := info.Get(dep.Manager, dep.Name); ok {
+				// Licenses can change between versions, check the used one
+				license := pkg.LicenseOf(dep.Version)
+
+				// Packages without a license are handled like the ones with an unknown license
+				unknown := strings.TrimSpace(license) == ""
+				if !unknown {
+					expression := licenses.ParseOrUnknown(license)
+					if expression.Satisfies(r.allowed) {
+						continue
+					}
+
+					unknown = expression.Satisfies(r.allowedOrUnknown)
 				}
+
+				rule := r
+				if unknown {
+					if r.unknown == UnknownLicensesAllow {
+						continue
+					}
+
+					if r.unknown == UnknownLicensesWarn {
+						rule.unknownLicense = true
+						if rule.level == types.LevelError {
+							rule.level = types.LevelWarning
+						}
+					}
+				}
+
+				mistakes = append(mistakes, types.Mistake{
+					Rule:        rule,
+					Definitions: []types.Definition{dep.Definition},
+				})
 			}
 		}
 	}
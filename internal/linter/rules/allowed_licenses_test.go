@@ -1,6 +1,7 @@
 package rules
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/depshubhq/depshub/internal/config"
@@ -42,8 +43,8 @@ func TestRuleAllowedLicenses(t *testing.T) {
 				},
 			},
 			info: types.PackagesInfo{
-				"pkg1": {License: "MIT"},
-				"pkg2": {License: "Apache-2.0"},
+				"npm:pkg1": {License: "MIT"},
+				"npm:pkg2": {License: "Apache-2.0"},
 			},
 			expected: []types.Mistake{},
 		},
@@ -60,7 +61,7 @@ func TestRuleAllowedLicenses(t *testing.T) {
 				},
 			},
 			info: types.PackagesInfo{
-				"pkg1": {License: ""},
+				"npm:pkg1": {License: ""},
 			},
 			expected: []types.Mistake{},
 		},
@@ -77,7 +78,7 @@ func TestRuleAllowedLicenses(t *testing.T) {
 				},
 			},
 			info: types.PackagesInfo{
-				"pkg1": {License: "GPL-3.0"},
+				"npm:pkg1": {License: "GPL-3.0"},
 			},
 			expected: []types.Mistake{
 				{
@@ -111,8 +112,8 @@ func TestRuleAllowedLicenses(t *testing.T) {
 				},
 			},
 			info: types.PackagesInfo{
-				"pkg1": {License: "MIT"},
-				"pkg2": {License: "GPL-3.0"},
+				"npm:pkg1": {License: "MIT"},
+				"npm:pkg2": {License: "GPL-3.0"},
 			},
 			expected: []types.Mistake{
 				{
@@ -150,3 +151,180 @@ func TestRuleAllowedLicenses(t *testing.T) {
 		})
 	}
 }
+
+func TestRuleAllowedLicenses_Expressions(t *testing.T) {
+	rule := NewRuleAllowedLicenses()
+
+	dependencies := []types.Dependency{}
+	info := types.PackagesInfo{}
+
+	licenses := []string{
+		"MIT OR GPL-3.0-only",
+		"Apache 2.0",
+		"BSD",
+		"MIT AND GPL-3.0-only",
+		"MPL-2.0",
+		"GPL-2.0-only WITH Classpath-exception-2.0",
+		"AGPL-3.0",
+		"Custom terms",
+		"LicenseRef-Internal",
+		"OFL-1.1",
+		"UNLICENSED",
+		"MIT OR Custom terms",
+	}
+
+	for i, license := range licenses {
+		name := fmt.Sprintf("pkg%d", i+1)
+		dependencies = append(dependencies, types.Dependency{Name: name, Definition: types.Definition{Line: i + 1}})
+		info[types.PackageKey(types.Npm, name)] = types.Package{License: license}
+	}
+
+	manifests := []types.Manifest{{Dependencies: dependencies}}
+
+	testCases := []struct {
+		name     string
+		value    any
+		expected []int
+	}{
+		{
+			name:     "default licenses",
+			value:    []any{},
+			expected: []int{3, 4, 5, 6, 7, 9, 10, 11},
+		},
+		{
+			name:     "categories",
+			value:    []any{"permissive", "weak-copyleft"},
+			expected: []int{4, 6, 7, 9, 10, 11},
+		},
+		{
+			name:     "licenses with exceptions",
+			value:    []any{"GPL-2.0-only WITH Classpath-exception-2.0", "BSD-3-Clause"},
+			expected: []int{4, 5, 7, 9, 10, 11},
+		},
+		{
+			name:     "custom licenses",
+			value:    []any{"strong-copyleft", "LicenseRef-Internal"},
+			expected: []int{3, 5, 10, 11},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mistakes, err := rule.Check(manifests, info, valueConfig{value: tc.value})
+			assert.NoError(t, err)
+
+			lines := []int{}
+			for _, mistake := range mistakes {
+				lines = append(lines, mistake.Definitions[0].Line)
+			}
+			assert.Equal(t, tc.expected, lines)
+		})
+	}
+}
+
+func TestRuleAllowedLicenses_Versions(t *testing.T) {
+	rule := NewRuleAllowedLicenses()
+
+	manifests := []types.Manifest{
+		{
+			Dependencies: []types.Dependency{
+				{Name: "relicensed", Version: "1.0.0", Definition: types.Definition{Line: 1}},
+				{Name: "relicensed", Version: "2.0.0", Definition: types.Definition{Line: 2}},
+				{Name: "package-license", Version: "1.0.0", Definition: types.Definition{Line: 3}},
+			},
+		},
+	}
+
+	info := types.PackagesInfo{
+		"npm:relicensed": {
+			License: "BUSL-1.1",
+			Versions: map[string]types.PackageVersion{
+				"1.0.0": {Version: "1.0.0", License: "MIT"},
+				"2.0.0": {Version: "2.0.0", License: "BUSL-1.1"},
+			},
+		},
+		// Versions without a license use the one of the package
+		"npm:package-license": {
+			License: "GPL-3.0-only",
+			Versions: map[string]types.PackageVersion{
+				"1.0.0": {Version: "1.0.0"},
+			},
+		},
+	}
+
+	mistakes, err := rule.Check(manifests, info, config.Config{})
+	assert.NoError(t, err)
+
+	lines := []int{}
+	for _, mistake := range mistakes {
+		lines = append(lines, mistake.Definitions[0].Line)
+	}
+	assert.Equal(t, []int{2, 3}, lines)
+}
+
+// unknownLicensesConfig sets how the rules handle the licenses that aren't known.
+type unknownLicensesConfig struct {
+	unknown string
+}
+
+func (c unknownLicensesConfig) Apply(manifestPath string, packageName string, rule types.Rule) error {
+	rule.Reset()
+	return rule.(types.UnknownLicensesRule).SetUnknownLicenses(c.unknown)
+}
+
+func TestRuleAllowedLicenses_UnknownLicenses(t *testing.T) {
+	rule := NewRuleAllowedLicenses()
+
+	manifests := []types.Manifest{
+		{
+			Dependencies: []types.Dependency{
+				{Name: "custom", Definition: types.Definition{Line: 1}},
+				{Name: "dual", Definition: types.Definition{Line: 2}},
+				{Name: "known", Definition: types.Definition{Line: 3}},
+				{Name: "allowed", Definition: types.Definition{Line: 4}},
+				{Name: "missing", Definition: types.Definition{Line: 5}},
+			},
+		},
+	}
+
+	info := types.PackagesInfo{
+		"npm:custom":  {License: "Custom terms"},
+		"npm:dual":    {License: "GPL-3.0-only OR Custom terms"},
+		"npm:known":   {License: "CPAL-1.0"},
+		"npm:allowed": {License: "MIT OR Custom terms"},
+		"npm:missing": {License: ""},
+	}
+
+	testCases := []struct {
+		unknown  string
+		expected map[int]types.Level
+	}{
+		{
+			unknown:  UnknownLicensesAllow,
+			expected: map[int]types.Level{3: types.LevelError},
+		},
+		{
+			unknown:  UnknownLicensesWarn,
+			expected: map[int]types.Level{1: types.LevelWarning, 2: types.LevelWarning, 3: types.LevelError, 5: types.LevelWarning},
+		},
+		{
+			unknown:  UnknownLicensesDeny,
+			expected: map[int]types.Level{1: types.LevelError, 2: types.LevelError, 3: types.LevelError, 5: types.LevelError},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.unknown, func(t *testing.T) {
+			mistakes, err := rule.Check(manifests, info, unknownLicensesConfig{unknown: tc.unknown})
+			assert.NoError(t, err)
+
+			levels := map[int]types.Level{}
+			for _, mistake := range mistakes {
+				levels[mistake.Definitions[0].Line] = mistake.Rule.GetLevel()
+			}
+			assert.Equal(t, tc.expected, levels)
+		})
+	}
+
+	assert.ErrorIs(t, rule.SetUnknownLicenses("block"), types.ErrInvalidRuleValue)
+}
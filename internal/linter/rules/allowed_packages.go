@@ -0,0 +1,102 @@
+package rules
+
+import (
+	"slices"
+
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+type RuleAllowedPackages struct {
+	name      string
+	level     types.Level
+	supported []types.ManagerType
+	value     []packagePattern
+}
+
+func NewRuleAllowedPackages() *RuleAllowedPackages {
+	return &RuleAllowedPackages{
+		name:      "allowed-packages",
+		level:     types.LevelError,
+		supported: []types.ManagerType{types.Npm, types.Go, types.Cargo, types.Pip, types.Hex, types.Pyproject, types.Maven, types.Pipfile, types.Bundler, types.Composer, types.NuGet, types.GitHubActions, types.Docker, types.Terraform, types.Pub, types.SwiftPM, types.Helm, types.Conda, types.Deno},
+		value:     []packagePattern{},
+	}
+}
+
+func (r RuleAllowedPackages) GetMessage() string {
+	return `The package is not in the list of allowed packages.`
+}
+
+func (r RuleAllowedPackages) GetName() string {
+	return r.name
+}
+
+func (r RuleAllowedPackages) GetLevel() types.Level {
+	return r.level
+}
+
+func (r *RuleAllowedPackages) SetLevel(level types.Level) {
+	r.level = level
+}
+
+func (r *RuleAllowedPackages) SetValue(value any) error {
+	entries, err := parsePackageEntries(value, "ecosystem", "version")
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		r.value = append(r.value, packagePattern{
+			Name:      entry["name"],
+			Ecosystem: entry["ecosystem"],
+			Version:   entry["version"],
+		})
+	}
+
+	return nil
+}
+
+func (r RuleAllowedPackages) IsSupported(t types.ManagerType) bool {
+	return slices.Contains(r.supported, t)
+}
+
+func (r *RuleAllowedPackages) Reset() {
+	*r = *NewRuleAllowedPackages()
+}
+
+func (r RuleAllowedPackages) Check(manifests []types.Manifest, info types.PackagesInfo, c types.Config) ([]types.Mistake, error) {
+	mistakes := []types.Mistake{}
+
+	for _, manifest := range manifests {
+		if !r.IsSupported(manifest.Manager) {
+			continue
+		}
+
+		for _, dep := range manifest.Dependencies {
+			err := c.Apply(manifest.Path, dep.Name, &r)
+
+			if err != nil {
+				return nil, err
+			}
+
+			// All the packages are allowed until the list is set
+			if len(r.value) == 0 {
+				continue
+			}
+
+			allowed := slices.ContainsFunc(r.value, func(p packagePattern) bool {
+				return p.matches(dep)
+			})
+
+			if !allowed {
+				mistakes = append(mistakes, types.Mistake{
+					Rule: r,
+					Definitions: []types.Definition{
+						dep.Definition,
+					},
+				})
+			}
+		}
+	}
+
+	return mistakes, nil
+}
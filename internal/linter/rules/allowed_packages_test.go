@@ -0,0 +1,66 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/depshubhq/depshub/internal/config"
+	"github.com/depshubhq/depshub/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRuleAllowedPackages(t *testing.T) {
+	rule := NewRuleAllowedPackages()
+
+	t.Run("metadata", func(t *testing.T) {
+		assert.Equal(t, "allowed-packages", rule.GetName())
+		assert.Equal(t, types.LevelError, rule.GetLevel())
+		assert.Equal(t, "The package is not in the list of allowed packages.", rule.GetMessage())
+	})
+
+	manifests := []types.Manifest{
+		{
+			Manager: types.Npm,
+			Dependencies: []types.Dependency{
+				{Manager: types.Npm, Name: "react", Version: "18.3.1", Definition: types.Definition{Line: 1}},
+				{Manager: types.Npm, Name: "@types/react", Version: "18.3.12", Definition: types.Definition{Line: 2}},
+				{Manager: types.Npm, Name: "left-pad", Version: "1.3.0", Definition: types.Definition{Line: 3}},
+				{Manager: types.Npm, Name: "lodash", Version: "3.10.1", Definition: types.Definition{Line: 4}},
+			},
+		},
+		{
+			Manager: types.Pip,
+			Dependencies: []types.Dependency{
+				{Manager: types.Pip, Name: "react", Version: "0.1.0", Definition: types.Definition{Line: 5}},
+			},
+		},
+	}
+
+	t.Run("all packages allowed by default", func(t *testing.T) {
+		mistakes, err := rule.Check(manifests, types.PackagesInfo{}, config.Config{})
+		assert.NoError(t, err)
+		assert.Empty(t, mistakes)
+	})
+
+	t.Run("allowed list", func(t *testing.T) {
+		value := []any{
+			map[string]any{"name": "react", "ecosystem": "npm"},
+			"@types/*",
+			map[string]any{"name": "lodash", "version": ">=4"},
+		}
+
+		mistakes, err := rule.Check(manifests, types.PackagesInfo{}, valueConfig{value: value})
+		assert.NoError(t, err)
+
+		lines := []int{}
+		for _, mistake := range mistakes {
+			lines = append(lines, mistake.Definitions[0].Line)
+		}
+
+		assert.Equal(t, []int{3, 4, 5}, lines)
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		assert.ErrorIs(t, rule.SetValue([]any{map[string]any{"name": "react", "reason": "not a field"}}), types.ErrInvalidRuleValue)
+		assert.ErrorIs(t, rule.SetValue(true), types.ErrInvalidRuleValue)
+	})
+}
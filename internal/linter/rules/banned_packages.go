@@ -0,0 +1,175 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+// bannedPackage is a package that can't be used, with the reason and the
+// package to use instead.
+type bannedPackage struct {
+	packagePattern
+	Reason      string
+	Replacement string
+}
+
+type RuleBannedPackages struct {
+	name      string
+	level     types.Level
+	supported []types.ManagerType
+	value     []bannedPackage
+	// Check the transitive packages of the dependency graphs too
+	includeTransitive bool
+	// The banned package of the mistake
+	banned *bannedPackage
+	// The transitive package of the mistake
+	transitive *types.Node
+}
+
+func NewRuleBannedPackages() *RuleBannedPackages {
+	return &RuleBannedPackages{
+		name:      "banned-packages",
+		level:     types.LevelError,
+		supported: []types.ManagerType{types.Npm, types.Go, types.Cargo, types.Pip, types.Hex, types.Pyproject, types.Maven, types.Pipfile, types.Bundler, types.Composer, types.NuGet, types.GitHubActions, types.Docker, types.Terraform, types.Pub, types.SwiftPM, types.Helm, types.Conda, types.Deno},
+		value:     []bannedPackage{},
+	}
+}
+
+func (r RuleBannedPackages) GetMessage() string {
+	if r.banned == nil {
+		return `Disallow the use of banned packages.`
+	}
+
+	message := fmt.Sprintf("The package %s is banned", r.banned.Name)
+	if r.banned.Version != "" {
+		message = fmt.Sprintf("The versions %s of the package %s are banned", r.banned.Version, r.banned.Name)
+	}
+
+	if r.banned.Reason != "" {
+		message += ": " + r.banned.Reason
+	}
+
+	message += "."
+
+	if r.banned.Replacement != "" {
+		message += fmt.Sprintf(" Use %s instead.", r.banned.Replacement)
+	}
+
+	return transitiveMessage(message, r.transitive)
+}
+
+func (r RuleBannedPackages) GetName() string {
+	return r.name
+}
+
+func (r RuleBannedPackages) GetLevel() types.Level {
+	return r.level
+}
+
+func (r *RuleBannedPackages) SetLevel(level types.Level) {
+	r.level = level
+}
+
+func (r *RuleBannedPackages) SetValue(value any) error {
+	entries, err := parsePackageEntries(value, "ecosystem", "version", "reason", "replacement")
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		r.value = append(r.value, bannedPackage{
+			packagePattern: packagePattern{
+				Name:      entry["name"],
+				Ecosystem: entry["ecosystem"],
+				Version:   entry["version"],
+			},
+			Reason:      entry["reason"],
+			Replacement: entry["replacement"],
+		})
+	}
+
+	return nil
+}
+
+func (r *RuleBannedPackages) SetIncludeTransitive(include bool) {
+	r.includeTransitive = include
+}
+
+func (r RuleBannedPackages) IsSupported(t types.ManagerType) bool {
+	return slices.Contains(r.supported, t)
+}
+
+func (r *RuleBannedPackages) Reset() {
+	*r = *NewRuleBannedPackages()
+}
+
+func (r RuleBannedPackages) Check(manifests []types.Manifest, info types.PackagesInfo, c types.Config) ([]types.Mistake, error) {
+	mistakes := []types.Mistake{}
+
+	for _, manifest := range manifests {
+		if !r.IsSupported(manifest.Manager) {
+			continue
+		}
+
+		for _, dep := range manifest.Dependencies {
+			err := c.Apply(manifest.Path, dep.Name, &r)
+
+			if err != nil {
+				return nil, err
+			}
+
+			for _, banned := range r.value {
+				if banned.matches(dep) {
+					mistake := r
+					mistake.banned = &banned
+
+					mistakes = append(mistakes, types.Mistake{
+						Rule: mistake,
+						Definitions: []types.Definition{
+							dep.Definition,
+						},
+					})
+					break
+				}
+			}
+		}
+
+		if manifest.Graph == nil {
+			continue
+		}
+
+		for _, node := range manifest.Graph.Transitive() {
+			err := c.Apply(manifest.Path, node.Name, &r)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if !r.includeTransitive {
+				continue
+			}
+
+			dep := transitiveDependency(node)
+
+			for _, banned := range r.value {
+				if banned.matches(dep) {
+					mistake := r
+					mistake.banned = &banned
+					mistake.transitive = node
+
+					mistakes = append(mistakes, types.Mistake{
+						Rule: mistake,
+						Definitions: []types.Definition{
+							dep.Definition,
+						},
+					})
+					break
+				}
+			}
+		}
+	}
+
+	return mistakes, nil
+}
@@ -0,0 +1,144 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/depshubhq/depshub/internal/config"
+	"github.com/depshubhq/depshub/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRuleBannedPackages(t *testing.T) {
+	rule := NewRuleBannedPackages()
+
+	t.Run("metadata", func(t *testing.T) {
+		assert.Equal(t, "banned-packages", rule.GetName())
+		assert.Equal(t, types.LevelError, rule.GetLevel())
+		assert.Equal(t, "Disallow the use of banned packages.", rule.GetMessage())
+	})
+
+	manifests := []types.Manifest{
+		{
+			Manager: types.Npm,
+			Dependencies: []types.Dependency{
+				{Manager: types.Npm, Name: "moment", Version: "2.30.1", Definition: types.Definition{Line: 1}},
+				{Manager: types.Npm, Name: "request", Version: "2.88.2", Definition: types.Definition{Line: 2}},
+				{Manager: types.Npm, Name: "date-fns", Version: "4.1.0", Definition: types.Definition{Line: 3}},
+			},
+		},
+		{
+			Manager: types.Maven,
+			Dependencies: []types.Dependency{
+				{Manager: types.Maven, Name: "log4j:log4j", Version: "1.2.17", Definition: types.Definition{Line: 4}},
+				{Manager: types.Maven, Name: "org.apache.logging.log4j:log4j-core", Version: "2.14.1", Definition: types.Definition{Line: 5}},
+				{Manager: types.Maven, Name: "org.apache.logging.log4j:log4j-api", Version: "2.24.1", Definition: types.Definition{Line: 6}},
+			},
+		},
+	}
+
+	value := []any{
+		"request",
+		map[string]any{
+			"name":        "moment",
+			"ecosystem":   "npm",
+			"reason":      "it is in maintenance mode",
+			"replacement": "date-fns",
+		},
+		map[string]any{
+			"name":        "log4j:log4j",
+			"version":     "1.x",
+			"replacement": "org.apache.logging.log4j:log4j-core",
+		},
+		map[string]any{
+			"name":    "org.apache.logging.log4j:*",
+			"version": ">=2.0.0, <2.17.1",
+			"reason":  "Log4Shell",
+		},
+		// The ecosystem doesn't match
+		map[string]any{
+			"name":      "date-fns",
+			"ecosystem": "maven",
+		},
+	}
+
+	mistakes, err := rule.Check(manifests, types.PackagesInfo{}, valueConfig{value: value})
+	assert.NoError(t, err)
+
+	lines := []int{}
+	messages := []string{}
+	for _, mistake := range mistakes {
+		lines = append(lines, mistake.Definitions[0].Line)
+		messages = append(messages, mistake.Rule.GetMessage())
+	}
+
+	assert.Equal(t, []int{1, 2, 4, 5}, lines)
+	assert.Equal(t, []string{
+		"The package moment is banned: it is in maintenance mode. Use date-fns instead.",
+		"The package request is banned.",
+		"The versions 1.x of the package log4j:log4j are banned. Use org.apache.logging.log4j:log4j-core instead.",
+		"The versions >=2.0.0, <2.17.1 of the package org.apache.logging.log4j:* are banned: Log4Shell.",
+	}, messages)
+
+	t.Run("no banned packages by default", func(t *testing.T) {
+		mistakes, err := rule.Check(manifests, types.PackagesInfo{}, config.Config{})
+		assert.NoError(t, err)
+		assert.Empty(t, mistakes)
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		for _, value := range []any{
+			"moment",
+			[]any{map[string]any{"reason": "no name"}},
+			[]any{map[string]any{"name": "moment", "unknown": "field"}},
+			[]any{map[string]any{"name": "moment", "version": 1}},
+			[]any{"[invalid"},
+		} {
+			assert.ErrorIs(t, rule.SetValue(value), types.ErrInvalidRuleValue)
+		}
+	})
+}
+
+func TestMatchVersion(t *testing.T) {
+	tests := []struct {
+		version    string
+		constraint string
+		expected   bool
+	}{
+		{version: "1.2.17", constraint: "1.x", expected: true},
+		{version: "2.0.0", constraint: "1.x", expected: false},
+		{version: "1.2.17", constraint: "1.2.*", expected: true},
+		{version: "1.2.17", constraint: "1", expected: true},
+		{version: "1.2.17", constraint: "1.2.17", expected: true},
+		{version: "1.2.17", constraint: "=1.2.1", expected: false},
+		{version: "v1.2.17", constraint: "<2", expected: true},
+		{version: "2.14.1", constraint: ">=2.0.0, <2.17.1", expected: true},
+		{version: "2.17.1", constraint: ">=2.0.0 <2.17.1", expected: false},
+		{version: "3.0.0", constraint: "<2 || >=3", expected: true},
+		{version: "2.5.0", constraint: "<2 || >=3", expected: false},
+		{version: "2.5.0", constraint: "!=2.5.0", expected: false},
+		{version: "", constraint: "<2", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version+" "+tt.constraint, func(t *testing.T) {
+			assert.Equal(t, tt.expected, matchVersion(tt.version, tt.constraint))
+		})
+	}
+}
+
+func TestRuleBannedPackages_Transitive(t *testing.T) {
+	rule := NewRuleBannedPackages()
+	manifests := []types.Manifest{transitiveManifest()}
+	value := []any{map[string]any{"name": "debug", "version": "<3", "reason": "it is slow"}}
+
+	mistakes, err := rule.Check(manifests, types.PackagesInfo{}, valueConfig{value: value})
+	assert.NoError(t, err)
+	assert.Empty(t, mistakes)
+
+	mistakes, err = rule.Check(manifests, types.PackagesInfo{}, transitiveConfig{value: value})
+	assert.NoError(t, err)
+	assert.Len(t, mistakes, 1)
+
+	assert.Equal(t, 3, mistakes[0].Definitions[0].Line)
+	assert.Equal(t, "The versions <3 of the package debug are banned: it is slow. Introduced by express@4.21.1 > debug@2.6.9.", mistakes[0].Rule.GetMessage())
+}
@@ -16,7 +16,7 @@ func NewRuleLockfile() *RuleLockfile {
 	return &RuleLockfile{
 		name:      "lockfile",
 		level:     types.LevelError,
-		supported: []types.ManagerType{types.Npm, types.Go, types.Cargo, types.Pip, types.Hex, types.Pyproject, types.Maven},
+		supported: []types.ManagerType{types.Npm, types.Go, types.Cargo, types.Pip, types.Hex, types.Pyproject, types.Maven, types.Pipfile, types.Bundler, types.Composer, types.NuGet, types.Pub, types.SwiftPM, types.Helm, types.Conda, types.Deno},
 	}
 }
 
@@ -0,0 +1,194 @@
+package rules
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/depshubhq/depshub/pkg/manager/pylock"
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+// outOfSyncPackage is a package of a manifest that its lockfile doesn't match.
+type outOfSyncPackage struct {
+	Name string
+	// The version requirement of the manifest
+	Constraint string
+	// The versions of the lockfile, none when the package is missing from it
+	Versions []string
+	// Stale is true when the lockfile has a package the manifest doesn't declare
+	Stale bool
+}
+
+type RuleLockfileInSync struct {
+	name      string
+	level     types.Level
+	supported []types.ManagerType
+	// The package of the mistake
+	outOfSync *outOfSyncPackage
+}
+
+func NewRuleLockfileInSync() *RuleLockfileInSync {
+	return &RuleLockfileInSync{
+		name:      "lockfile-in-sync",
+		level:     types.LevelError,
+		supported: []types.ManagerType{types.Npm, types.Cargo, types.Hex, types.Pyproject},
+	}
+}
+
+func (r RuleLockfileInSync) GetMessage() string {
+	switch {
+	case r.outOfSync == nil:
+		return "The lockfile should be in sync with the manifest"
+	case r.outOfSync.Stale:
+		return fmt.Sprintf("The package %s is in the lockfile but isn't a dependency of the manifest anymore. Update the lockfile.", r.outOfSync.Name)
+	case len(r.outOfSync.Versions) == 0:
+		return fmt.Sprintf("The package %s is missing from the lockfile. Update the lockfile.", r.outOfSync.Name)
+	}
+
+	return fmt.Sprintf(
+		"The lockfile has the version %s of the package %s, which doesn't satisfy %s. Update the lockfile.",
+		strings.Join(r.outOfSync.Versions, ", "),
+		r.outOfSync.Name,
+		r.outOfSync.Constraint,
+	)
+}
+
+func (r RuleLockfileInSync) GetName() string {
+	return r.name
+}
+
+func (r RuleLockfileInSync) GetLevel() types.Level {
+	return r.level
+}
+
+func (r *RuleLockfileInSync) SetLevel(level types.Level) {
+	r.level = level
+}
+
+func (r *RuleLockfileInSync) SetValue(value any) error {
+	return nil
+}
+
+func (r *RuleLockfileInSync) Reset() {
+	*r = *NewRuleLockfileInSync()
+}
+
+func (r RuleLockfileInSync) IsSupported(t types.ManagerType) bool {
+	return slices.Contains(r.supported, t)
+}
+
+func (r RuleLockfileInSync) Check(manifests []types.Manifest, info types.PackagesInfo, c types.Config) (mistakes []types.Mistake, err error) {
+	for _, manifest := range manifests {
+		if !r.IsSupported(manifest.Manager) || manifest.Lockfile == nil || len(manifest.Lockfile.Packages) == 0 {
+			continue
+		}
+
+		// Lockfiles recording the direct dependencies, like package-lock.json
+		// and Cargo.lock, are only checked against them
+		roots := slices.ContainsFunc(manifest.Lockfile.Packages, func(pkg types.LockedPackage) bool { return pkg.Direct })
+
+		locked := make(map[string][]types.LockedPackage)
+		for _, pkg := range manifest.Lockfile.Packages {
+			if !roots || pkg.Direct {
+				key := lockedName(manifest.Manager, pkg.Name)
+				locked[key] = append(locked[key], pkg)
+			}
+		}
+
+		declared := make(map[string]bool)
+
+		for _, dep := range manifest.Dependencies {
+			declared[lockedName(manifest.Manager, dep.Name)] = true
+
+			// npm aliases like "npm:react@18" are locked with the name of the package
+			if name, ok := aliasName(dep.Constraint); ok {
+				declared[lockedName(manifest.Manager, name)] = true
+			}
+
+			// Path, git and workspace dependencies aren't resolved from a registry
+			if dep.Constraint == "" || strings.ContainsAny(dep.Constraint, ":/") {
+				continue
+			}
+
+			if err := c.Apply(manifest.Path, dep.Name, &r); err != nil {
+				return nil, err
+			}
+
+			var versions []string
+			satisfied := false
+
+			for _, pkg := range locked[lockedName(manifest.Manager, dep.Name)] {
+				versions = append(versions, pkg.Version)
+				satisfied = satisfied || matchRange(manifest.Manager, pkg.Version, dep.Constraint)
+			}
+
+			if satisfied {
+				continue
+			}
+
+			rule := r
+			rule.outOfSync = &outOfSyncPackage{Name: dep.Name, Constraint: dep.Constraint, Versions: versions}
+
+			mistakes = append(mistakes, types.Mistake{
+				Rule:        rule,
+				Definitions: []types.Definition{dep.Definition},
+			})
+		}
+
+		if !roots {
+			continue
+		}
+
+		var stale []types.LockedPackage
+		for _, pkgs := range locked {
+			if !declared[lockedName(manifest.Manager, pkgs[0].Name)] {
+				stale = append(stale, pkgs[0])
+			}
+		}
+
+		slices.SortFunc(stale, func(a, b types.LockedPackage) int {
+			return strings.Compare(a.Name, b.Name)
+		})
+
+		for _, pkg := range stale {
+			if err := c.Apply(manifest.Path, pkg.Name, &r); err != nil {
+				return nil, err
+			}
+
+			rule := r
+			rule.outOfSync = &outOfSyncPackage{Name: pkg.Name, Versions: []string{pkg.Version}, Stale: true}
+
+			mistakes = append(mistakes, types.Mistake{
+				Rule:        rule,
+				Definitions: []types.Definition{{Path: manifest.Lockfile.Path}},
+			})
+		}
+	}
+
+	return mistakes, nil
+}
+
+// lockedName returns the name of a package as compared between manifests and
+// lockfiles, which ignore the case and, for Python, the separators of names.
+func lockedName(manager types.ManagerType, name string) string {
+	if manager == types.Pyproject {
+		return pylock.Normalize(name)
+	}
+
+	return strings.ToLower(name)
+}
+
+// aliasName returns the name of the package of an npm alias like "npm:react@18".
+func aliasName(constraint string) (string, bool) {
+	spec, ok := strings.CutPrefix(constraint, "npm:")
+	if !ok {
+		return "", false
+	}
+
+	if i := strings.LastIndex(spec, "@"); i > 0 {
+		spec = spec[:i]
+	}
+
+	return spec, true
+}
@@ -0,0 +1,232 @@
+package rules
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/depshubhq/depshub/internal/config"
+	"github.com/depshubhq/depshub/pkg/manager/cargo"
+	"github.com/depshubhq/depshub/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRuleLockfileInSync(t *testing.T) {
+	rule := NewRuleLockfileInSync()
+
+	t.Run("metadata", func(t *testing.T) {
+		assert.Equal(t, "lockfile-in-sync", rule.GetName())
+		assert.Equal(t, types.LevelError, rule.GetLevel())
+		assert.Equal(t, "The lockfile should be in sync with the manifest", rule.GetMessage())
+		assert.True(t, rule.IsSupported(types.Npm))
+		assert.True(t, rule.IsSupported(types.Hex))
+		assert.False(t, rule.IsSupported(types.Go))
+	})
+
+	dependency := func(name string, constraint string, line int) types.Dependency {
+		return types.Dependency{
+			Name:       name,
+			Constraint: constraint,
+			Definition: types.Definition{Path: "package.json", Line: line},
+		}
+	}
+
+	t.Run("npm", func(t *testing.T) {
+		manifests := []types.Manifest{
+			{
+				Manager: types.Npm,
+				Path:    "package.json",
+				Dependencies: []types.Dependency{
+					dependency("react", "^18.2.0", 1),
+					dependency("lodash", "~4.17.0", 2),
+					dependency("express", "^4.0.0", 3),
+					dependency("left-pad", "npm:@fork/left-pad@1.3.0", 4),
+					dependency("utils", "workspace:*", 5),
+					dependency("typescript", "5.6.x", 6),
+				},
+				Lockfile: &types.Lockfile{
+					Path: "package-lock.json",
+					Packages: []types.LockedPackage{
+						{Name: "react", Version: "18.3.1", Direct: true},
+						{Name: "lodash", Version: "4.18.0", Direct: true},
+						// Transitive packages don't satisfy the direct dependencies
+						{Name: "express", Version: "4.21.1"},
+						{Name: "@fork/left-pad", Version: "1.3.0", Direct: true},
+						{Name: "typescript", Version: "5.6.3", Direct: true},
+						{Name: "moment", Version: "2.30.1", Direct: true},
+					},
+				},
+			},
+		}
+
+		mistakes, err := rule.Check(manifests, nil, config.Config{})
+		assert.NoError(t, err)
+
+		var messages []string
+		var definitions []types.Definition
+		for _, mistake := range mistakes {
+			messages = append(messages, mistake.Rule.GetMessage())
+			definitions = append(definitions, mistake.Definitions...)
+		}
+
+		assert.Equal(t, []string{
+			"The lockfile has the version 4.18.0 of the package lodash, which doesn't satisfy ~4.17.0. Update the lockfile.",
+			"The package express is missing from the lockfile. Update the lockfile.",
+			"The package moment is in the lockfile but isn't a dependency of the manifest anymore. Update the lockfile.",
+		}, messages)
+		assert.Equal(t, []types.Definition{
+			{Path: "package.json", Line: 2},
+			{Path: "package.json", Line: 3},
+			{Path: "package-lock.json"},
+		}, definitions)
+	})
+
+	t.Run("lockfiles without direct dependencies", func(t *testing.T) {
+		manifests := []types.Manifest{
+			{
+				Manager: types.Pyproject,
+				Path:    "pyproject.toml",
+				Dependencies: []types.Dependency{
+					dependency("Flask_Login", "^0.6", 1),
+					dependency("requests", ">=2.31, <3", 2),
+				},
+				Lockfile: &types.Lockfile{
+					Path: "poetry.lock",
+					Packages: []types.LockedPackage{
+						{Name: "flask-login", Version: "0.6.3"},
+						{Name: "requests", Version: "2.30.0"},
+						// Transitive packages aren't stale
+						{Name: "urllib3", Version: "2.2.3"},
+					},
+				},
+			},
+		}
+
+		mistakes, err := rule.Check(manifests, nil, config.Config{})
+		assert.NoError(t, err)
+		assert.Len(t, mistakes, 1)
+		assert.Equal(t, "The lockfile has the version 2.30.0 of the package requests, which doesn't satisfy >=2.31, <3. Update the lockfile.", mistakes[0].Rule.GetMessage())
+	})
+
+	t.Run("renamed and platform specific crates", func(t *testing.T) {
+		dir := t.TempDir()
+		manifestPath := filepath.Join(dir, "Cargo.toml")
+		lockfilePath := filepath.Join(dir, "Cargo.lock")
+
+		assert.NoError(t, os.WriteFile(manifestPath, []byte(`[package]
+name = "app"
+version = "0.1.0"
+
+[dependencies]
+log2 = { package = "log", version = "0.4" }
+
+[target.'cfg(windows)'.dependencies]
+winapi = "0.3"
+`), 0o644))
+		assert.NoError(t, os.WriteFile(lockfilePath, []byte(`[[package]]
+name = "app"
+version = "0.1.0"
+dependencies = ["log", "winapi"]
+
+[[package]]
+name = "log"
+version = "0.4.22"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+
+[[package]]
+name = "winapi"
+version = "0.3.9"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+`), 0o644))
+
+		dependencies, err := cargo.Cargo{}.Dependencies(manifestPath)
+		assert.NoError(t, err)
+		packages, err := cargo.Cargo{}.LockedPackages(lockfilePath)
+		assert.NoError(t, err)
+
+		manifests := []types.Manifest{
+			{
+				Manager:      types.Cargo,
+				Path:         manifestPath,
+				Dependencies: dependencies,
+				Lockfile:     &types.Lockfile{Path: lockfilePath, Packages: packages},
+			},
+		}
+
+		mistakes, err := rule.Check(manifests, nil, config.Config{})
+		assert.NoError(t, err)
+		assert.Empty(t, mistakes)
+	})
+
+	t.Run("manifests without lockfile packages", func(t *testing.T) {
+		manifests := []types.Manifest{
+			{
+				Manager:      types.Cargo,
+				Path:         "Cargo.toml",
+				Dependencies: []types.Dependency{dependency("serde", "1.0", 1)},
+				Lockfile:     &types.Lockfile{Path: "Cargo.lock"},
+			},
+			{
+				Manager:      types.Npm,
+				Path:         "package.json",
+				Dependencies: []types.Dependency{dependency("react", "^18.2.0", 1)},
+			},
+		}
+
+		mistakes, err := rule.Check(manifests, nil, config.Config{})
+		assert.NoError(t, err)
+		assert.Empty(t, mistakes)
+	})
+}
+
+func TestMatchRange(t *testing.T) {
+	tests := []struct {
+		manager     types.ManagerType
+		version     string
+		requirement string
+		expected    bool
+	}{
+		{types.Npm, "18.3.1", "^18.2.0", true},
+		{types.Npm, "19.0.0", "^18.2.0", false},
+		{types.Npm, "0.2.9", "^0.2.3", true},
+		{types.Npm, "0.3.0", "^0.2.3", false},
+		{types.Npm, "0.0.4", "^0.0.3", false},
+		{types.Npm, "4.17.21", "~4.17.0", true},
+		{types.Npm, "4.18.0", "~4.17.0", false},
+		{types.Npm, "1.9.0", "~1", true},
+		{types.Npm, "2.0.0", "~1", false},
+		{types.Npm, "1.5.0", "1.2.3 - 2.3.4", true},
+		{types.Npm, "2.4.0", "1.2.3 - 2.3.4", false},
+		{types.Npm, "2.1.0", "^1.0.0 || ^2.0.0", true},
+		{types.Npm, "5.6.3", "5.6.x", true},
+		{types.Npm, "1.0.0", "*", true},
+		{types.Npm, "1.0.0", "1.0.1", false},
+		{types.Npm, "1.0.0", ">= 1.0.0 < 2", true},
+		{types.Npm, "1.0.0-beta.2", "^1.0.0-beta.1", true},
+		{types.Cargo, "1.0.215", "1.0", true},
+		{types.Cargo, "2.0.0", "1.0", false},
+		{types.Cargo, "0.4.9", "0.4", true},
+		{types.Cargo, "1.2.3", "=1.2.3", true},
+		{types.Cargo, "1.3.0", ">=1.2, <1.3", false},
+		{types.Hex, "1.7.4", "~> 1.7", true},
+		{types.Hex, "2.0.0", "~> 1.7", false},
+		{types.Hex, "1.7.9", "~> 1.7.2", true},
+		{types.Hex, "1.8.0", "~> 1.7.2", false},
+		{types.Hex, "2.1.0", "~> 1.0 or ~> 2.0", true},
+		{types.Hex, "1.0.0", "== 1.0.0", true},
+		{types.Pyproject, "2.32.3", ">=2.31, <3", true},
+		{types.Pyproject, "2.5.0", "~=2.2", true},
+		{types.Pyproject, "3.0.0", "~=2.2", false},
+		{types.Pyproject, "2.2.9", "~=2.2.0", true},
+		{types.Pyproject, "2.3.0", "~=2.2.0", false},
+		{types.Pyproject, "2.31.0", "2.31.0", true},
+		{types.Pyproject, "2.31.1", "==2.31.*", true},
+		{types.Pyproject, "1.0.0", "!=1.0.0", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.requirement+" "+tt.version, func(t *testing.T) {
+			assert.Equal(t, tt.expected, matchRange(tt.manager, tt.version, tt.requirement))
+		})
+	}
+}
@@ -21,7 +21,7 @@ func NewRuleMaxLibyear() *RuleMaxLibyear {
 	return &RuleMaxLibyear{
 		name:      "max-libyear",
 		level:     types.LevelError,
-		supported: []types.ManagerType{types.Npm, types.Go, types.Cargo, types.Pip, types.Hex, types.Pyproject, types.Maven},
+		supported: []types.ManagerType{types.Npm, types.Go, types.Cargo, types.Pip, types.Hex, types.Pyproject, types.Maven, types.Pipfile, types.Bundler, types.Composer, types.NuGet, types.Terraform, types.Pub, types.Helm, types.Conda, types.Deno},
 		value:     DefaultMaxLibyear,
 	}
 }
@@ -76,7 +76,7 @@ func (r RuleMaxLibyear) Check(manifests []types.Manifest, info types.PackagesInf
 				return nil, err
 			}
 
-			if pkg, ok := info[dep.Name]; ok {
+			if pkg, ok := info.Get(dep.Manager, dep.Name); ok {
 				if t, ok := pkg.Time[dep.Version]; ok {
 					if t.IsZero() {
 						continue
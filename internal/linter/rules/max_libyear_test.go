@@ -46,12 +46,12 @@ func TestRuleMaxLibyear(t *testing.T) {
 				},
 			},
 			info: types.PackagesInfo{
-				"pkg1": types.Package{
+				"npm:pkg1": types.Package{
 					Time: map[string]time.Time{
 						"1.0.0": baseTime.AddDate(0, -6, 0),
 					},
 				},
-				"pkg2": types.Package{
+				"npm:pkg2": types.Package{
 					Time: map[string]time.Time{
 						"2.0.0": baseTime.AddDate(-1, 0, 0),
 					},
@@ -73,7 +73,7 @@ func TestRuleMaxLibyear(t *testing.T) {
 				},
 			},
 			info: types.PackagesInfo{
-				"old-pkg": types.Package{
+				"npm:old-pkg": types.Package{
 					Time: map[string]time.Time{
 						"1.0.0": baseTime.AddDate(-31, 0, 0),
 					},
@@ -109,12 +109,12 @@ func TestRuleMaxLibyear(t *testing.T) {
 				},
 			},
 			info: types.PackagesInfo{
-				"pkg1": types.Package{
+				"npm:pkg1": types.Package{
 					Time: map[string]time.Time{
 						"1.0.0": baseTime.AddDate(-16, 0, 0),
 					},
 				},
-				"pkg2": types.Package{
+				"npm:pkg2": types.Package{
 					Time: map[string]time.Time{
 						"2.0.0": baseTime.AddDate(-16, 0, 0),
 					},
@@ -162,7 +162,7 @@ func TestRuleMaxLibyear(t *testing.T) {
 				},
 			},
 			info: types.PackagesInfo{
-				"pkg": types.Package{
+				"npm:pkg": types.Package{
 					Time: map[string]time.Time{},
 				},
 			},
@@ -19,7 +19,7 @@ func NewRuleMaxMajorUpdates() *RuleMaxMajorUpdates {
 	return &RuleMaxMajorUpdates{
 		name:      "max-major-updates",
 		level:     types.LevelError,
-		supported: []types.ManagerType{types.Npm, types.Go, types.Cargo, types.Pip, types.Hex, types.Pyproject, types.Maven},
+		supported: []types.ManagerType{types.Npm, types.Go, types.Cargo, types.Pip, types.Hex, types.Pyproject, types.Maven, types.Pipfile, types.Bundler, types.Composer, types.NuGet, types.Terraform, types.Pub, types.SwiftPM, types.Helm, types.Conda, types.Deno},
 		value:     DefaultMaxMajorUpdatesPercent,
 	}
 }
@@ -67,7 +67,7 @@ func (r RuleMaxMajorUpdates) Check(manifests []types.Manifest, info types.Packag
 		}
 
 		for _, dep := range manifest.Dependencies {
-			if pkg, ok := info[dep.Name]; ok {
+			if pkg, ok := info.Get(dep.Manager, dep.Name); ok {
 				err := c.Apply(manifest.Path, dep.Name, &r)
 
 				if err != nil {
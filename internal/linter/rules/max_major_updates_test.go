@@ -43,7 +43,7 @@ func TestRuleMaxMajorUpdates(t *testing.T) {
 				},
 			},
 			packagesInfo: types.PackagesInfo{
-				"test-pkg": {
+				"npm:test-pkg": {
 					Versions: map[string]types.PackageVersion{
 						"1.0.0": {},
 					},
@@ -96,28 +96,28 @@ func TestRuleMaxMajorUpdates(t *testing.T) {
 				},
 			},
 			packagesInfo: types.PackagesInfo{
-				"pkg1": {
+				"npm:pkg1": {
 					Versions: map[string]types.PackageVersion{
 						"1.0.0": {},
 						"2.0.0": {},
 					},
 				},
-				"pkg2": {
+				"npm:pkg2": {
 					Versions: map[string]types.PackageVersion{
 						"1.0.0": {},
 					},
 				},
-				"pkg3": {
+				"npm:pkg3": {
 					Versions: map[string]types.PackageVersion{
 						"1.0.0": {},
 					},
 				},
-				"pkg4": {
+				"npm:pkg4": {
 					Versions: map[string]types.PackageVersion{
 						"1.0.0": {},
 					},
 				},
-				"pkg5": {
+				"npm:pkg5": {
 					Versions: map[string]types.PackageVersion{
 						"1.0.0": {},
 					},
@@ -170,29 +170,29 @@ func TestRuleMaxMajorUpdates(t *testing.T) {
 				},
 			},
 			packagesInfo: types.PackagesInfo{
-				"pkg1": {
+				"npm:pkg1": {
 					Versions: map[string]types.PackageVersion{
 						"1.0.0": {},
 						"2.0.0": {},
 					},
 				},
-				"pkg2": {
+				"npm:pkg2": {
 					Versions: map[string]types.PackageVersion{
 						"1.0.0": {},
 						"2.0.0": {},
 					},
 				},
-				"pkg3": {
+				"npm:pkg3": {
 					Versions: map[string]types.PackageVersion{
 						"1.0.0": {},
 					},
 				},
-				"pkg4": {
+				"npm:pkg4": {
 					Versions: map[string]types.PackageVersion{
 						"1.0.0": {},
 					},
 				},
-				"pkg5": {
+				"npm:pkg5": {
 					Versions: map[string]types.PackageVersion{
 						"1.0.0": {},
 					},
@@ -217,7 +217,7 @@ func TestRuleMaxMajorUpdates(t *testing.T) {
 				},
 			},
 			packagesInfo: types.PackagesInfo{
-				"test-pkg": {
+				"npm:test-pkg": {
 					Versions: map[string]types.PackageVersion{
 						"1.0.0": {},
 						"1.1.0": {},
@@ -243,7 +243,7 @@ func TestRuleMaxMajorUpdates(t *testing.T) {
 				},
 			},
 			packagesInfo: types.PackagesInfo{
-				"test-pkg": {
+				"npm:test-pkg": {
 					Versions: map[string]types.PackageVersion{
 						"1.0.0": {},
 						"1.0.1": {},
@@ -280,13 +280,13 @@ func TestRuleMaxMajorUpdates(t *testing.T) {
 				},
 			},
 			packagesInfo: types.PackagesInfo{
-				"pkg1": {
+				"npm:pkg1": {
 					Versions: map[string]types.PackageVersion{
 						"1.0.0": {},
 						"2.0.0": {},
 					},
 				},
-				"pkg2": {
+				"npm:pkg2": {
 					Versions: map[string]types.PackageVersion{
 						"1.0.0": {},
 					},
@@ -330,7 +330,7 @@ func TestRuleMaxMajorUpdates(t *testing.T) {
 				},
 			},
 			packagesInfo: types.PackagesInfo{
-				"test-pkg": {
+				"npm:test-pkg": {
 					Versions: map[string]types.PackageVersion{
 						"1.0.0": {},
 						"2.0.1": {}, // Different patch version
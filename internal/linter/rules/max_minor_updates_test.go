@@ -43,7 +43,7 @@ func TestRuleMaxMinorUpdates(t *testing.T) {
 				},
 			},
 			packagesInfo: types.PackagesInfo{
-				"test-pkg": {
+				"npm:test-pkg": {
 					Versions: map[string]types.PackageVersion{
 						"1.0.0": {},
 					},
@@ -68,7 +68,7 @@ func TestRuleMaxMinorUpdates(t *testing.T) {
 				},
 			},
 			packagesInfo: types.PackagesInfo{
-				"test-pkg": {
+				"npm:test-pkg": {
 					Versions: map[string]types.PackageVersion{
 						"1.0.0": {},
 						"1.1.0": {},
@@ -101,13 +101,13 @@ func TestRuleMaxMinorUpdates(t *testing.T) {
 				},
 			},
 			packagesInfo: types.PackagesInfo{
-				"pkg1": {
+				"npm:pkg1": {
 					Versions: map[string]types.PackageVersion{
 						"1.0.0": {},
 						"1.1.0": {},
 					},
 				},
-				"pkg2": {
+				"npm:pkg2": {
 					Versions: map[string]types.PackageVersion{
 						"2.0.0": {},
 						"2.1.0": {},
@@ -133,7 +133,7 @@ func TestRuleMaxMinorUpdates(t *testing.T) {
 				},
 			},
 			packagesInfo: types.PackagesInfo{
-				"test-pkg": {
+				"npm:test-pkg": {
 					Versions: map[string]types.PackageVersion{
 						"1.0.0": {},
 						"1.0.1": {},
@@ -159,7 +159,7 @@ func TestRuleMaxMinorUpdates(t *testing.T) {
 				},
 			},
 			packagesInfo: types.PackagesInfo{
-				"test-pkg": {
+				"npm:test-pkg": {
 					Versions: map[string]types.PackageVersion{
 						"1.0.0": {},
 						"2.0.0": {},
@@ -196,13 +196,13 @@ func TestRuleMaxMinorUpdates(t *testing.T) {
 				},
 			},
 			packagesInfo: types.PackagesInfo{
-				"pkg1": {
+				"npm:pkg1": {
 					Versions: map[string]types.PackageVersion{
 						"1.0.0": {},
 						"1.1.0": {},
 					},
 				},
-				"pkg2": {
+				"npm:pkg2": {
 					Versions: map[string]types.PackageVersion{
 						"2.0.0": {},
 						"2.1.0": {},
@@ -20,7 +20,7 @@ func NewRuleMaxPackageAge() *RuleMaxPackageAge {
 	return &RuleMaxPackageAge{
 		name:      "max-package-age",
 		level:     types.LevelError,
-		supported: []types.ManagerType{types.Npm, types.Go, types.Cargo, types.Pip, types.Hex, types.Pyproject, types.Maven},
+		supported: []types.ManagerType{types.Npm, types.Go, types.Cargo, types.Pip, types.Hex, types.Pyproject, types.Maven, types.Pipfile, types.Bundler, types.Composer, types.NuGet, types.Docker, types.Terraform, types.Pub, types.Helm, types.Conda, types.Deno},
 		value:     DefaultMaxPackageAge,
 	}
 }
@@ -67,7 +67,7 @@ func (r RuleMaxPackageAge) Check(manifests []types.Manifest, info types.Packages
 
 		for _, dep := range manifest.Dependencies {
 
-			if pkg, ok := info[dep.Name]; ok {
+			if pkg, ok := info.Get(dep.Manager, dep.Name); ok {
 				err := c.Apply(manifest.Path, dep.Name, &r)
 
 				if err != nil {
@@ -46,7 +46,7 @@ func TestRuleMaxPackageAge(t *testing.T) {
 				},
 			},
 			info: types.PackagesInfo{
-				"old-pkg": {
+				"npm:old-pkg": {
 					Time: map[string]time.Time{
 						"1.0.0": now.AddDate(0, -(DefaultMaxPackageAge + 6), 0), // 6 months older than max age
 					},
@@ -80,7 +80,7 @@ func TestRuleMaxPackageAge(t *testing.T) {
 				},
 			},
 			info: types.PackagesInfo{
-				"recent-pkg": {
+				"npm:recent-pkg": {
 					Time: map[string]time.Time{
 						"1.0.0": now.AddDate(0, -(DefaultMaxPackageAge - 1), 0), // 1 month newer than max age
 					},
@@ -118,7 +118,7 @@ func TestRuleMaxPackageAge(t *testing.T) {
 				},
 			},
 			info: types.PackagesInfo{
-				"test-pkg": {
+				"npm:test-pkg": {
 					Time: map[string]time.Time{
 						"1.0.0": now,
 					},
@@ -154,12 +154,12 @@ func TestRuleMaxPackageAge(t *testing.T) {
 				},
 			},
 			info: types.PackagesInfo{
-				"old-pkg": {
+				"npm:old-pkg": {
 					Time: map[string]time.Time{
 						"1.0.0": now.AddDate(0, -(DefaultMaxPackageAge + 1), 0), // 1 months older than max age
 					},
 				},
-				"new-pkg": {
+				"npm:new-pkg": {
 					Time: map[string]time.Time{
 						"1.0.0": now.AddDate(0, -(DefaultMaxPackageAge - 1), 0), // 1 month newer than max age
 					},
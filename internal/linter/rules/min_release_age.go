@@ -0,0 +1,110 @@
+package rules
+
+import (
+	"slices"
+	"time"
+
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+const DefaultMinReleaseAge = 7
+
+type RuleMinReleaseAge struct {
+	name      string
+	level     types.Level
+	supported []types.ManagerType
+	value     int
+}
+
+func NewRuleMinReleaseAge() *RuleMinReleaseAge {
+	return &RuleMinReleaseAge{
+		name:  "min-release-age",
+		level: types.LevelWarning,
+		// Container tags like node:20 are published again with every patch release, so they are never old enough
+		supported: []types.ManagerType{types.Npm, types.Go, types.Cargo, types.Pip, types.Hex, types.Pyproject, types.Maven, types.Pipfile, types.Bundler, types.Composer, types.NuGet, types.Terraform, types.Pub, types.Helm, types.Conda, types.Deno},
+		value:     DefaultMinReleaseAge,
+	}
+}
+
+func (r RuleMinReleaseAge) GetMessage() string {
+	return `Disallow the use of any package version that was published recently (in days).`
+}
+
+func (r RuleMinReleaseAge) GetName() string {
+	return r.name
+}
+
+func (r RuleMinReleaseAge) GetLevel() types.Level {
+	return r.level
+}
+
+func (r *RuleMinReleaseAge) SetLevel(level types.Level) {
+	r.level = level
+}
+
+func (r *RuleMinReleaseAge) SetValue(value any) error {
+	if v, ok := value.(int); ok {
+		r.value = v
+		return nil
+	}
+	return types.ErrInvalidRuleValue
+}
+
+func (r RuleMinReleaseAge) IsSupported(t types.ManagerType) bool {
+	return slices.Contains(r.supported, t)
+}
+
+func (r *RuleMinReleaseAge) Reset() {
+	*r = *NewRuleMinReleaseAge()
+}
+
+// InCooldown reports whether a version was published less than the minimum
+// release age ago. Versions without a publish date are never in the cooldown.
+// Anything proposing updates should skip these versions.
+func (r RuleMinReleaseAge) InCooldown(pkg types.Package, version string) bool {
+	t, ok := pkg.Time[version]
+	if !ok || t.IsZero() {
+		return false
+	}
+
+	return t.After(time.Now().AddDate(0, 0, -r.value))
+}
+
+func (r RuleMinReleaseAge) Check(manifests []types.Manifest, info types.PackagesInfo, c types.Config) ([]types.Mistake, error) {
+	mistakes := []types.Mistake{}
+
+	for _, manifest := range manifests {
+		if !r.IsSupported(manifest.Manager) {
+			continue
+		}
+
+		for _, dep := range manifest.Dependencies {
+			if pkg, ok := info.Get(dep.Manager, dep.Name); ok {
+				err := c.Apply(manifest.Path, dep.Name, &r)
+
+				if err != nil {
+					return nil, err
+				}
+
+				// Manifests with a version range install the version of the lockfile
+				version := dep.Version
+				if manifest.Graph != nil {
+					if node, ok := manifest.Graph.Root(dep); ok {
+						version = node.Version
+					}
+				}
+
+				if r.InCooldown(pkg, version) {
+					mistakes = append(mistakes, types.Mistake{
+						Rule: r,
+						Definitions: []types.Definition{
+							dep.Definition,
+						},
+					})
+				}
+			}
+		}
+	}
+
+	return mistakes, nil
+}
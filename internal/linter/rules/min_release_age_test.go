@@ -0,0 +1,175 @@
+package rules
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/depshubhq/depshub/internal/config"
+	"github.com/depshubhq/depshub/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuleMinReleaseAge(t *testing.T) {
+	rule := NewRuleMinReleaseAge()
+
+	// Test rule metadata
+	t.Run("metadata", func(t *testing.T) {
+		assert.Equal(t, "min-release-age", rule.GetName())
+		assert.Equal(t, types.LevelWarning, rule.GetLevel())
+		assert.Equal(t, "Disallow the use of any package version that was published recently (in days).", rule.GetMessage())
+	})
+
+	now := time.Now()
+	mistakes := make([]types.Mistake, 0)
+
+	info := types.PackagesInfo{
+		"npm:new-pkg": {
+			Time: map[string]time.Time{
+				"1.0.0": now.AddDate(0, 0, -30),
+				"1.0.1": now.AddDate(0, 0, -(DefaultMinReleaseAge - 2)), // 2 days inside the cooldown
+			},
+		},
+		"npm:old-pkg": {
+			Time: map[string]time.Time{
+				"2.0.0": now.AddDate(0, 0, -(DefaultMinReleaseAge + 1)), // 1 day after the cooldown
+			},
+		},
+	}
+
+	// npm manifests have the lower bound of the range, like 1.0.0 for ^1.0.0
+	ranged := []types.Dependency{{Name: "new-pkg", Version: "1.0.0", Definition: types.Definition{Line: 1}}}
+	locked := []types.LockedPackage{{Name: "new-pkg", Version: "1.0.1", Direct: true}}
+
+	// Test scenarios
+	tests := []struct {
+		name      string
+		manifests []types.Manifest
+		want      []types.Mistake
+	}{
+		{
+			name: "version published recently",
+			manifests: []types.Manifest{
+				{
+					Dependencies: []types.Dependency{
+						{Name: "new-pkg", Version: "1.0.1", Definition: types.Definition{Line: 1}},
+						{Name: "old-pkg", Version: "2.0.0", Definition: types.Definition{Line: 2}},
+					},
+				},
+			},
+			want: []types.Mistake{
+				{
+					Rule:        *rule,
+					Definitions: []types.Definition{{Line: 1}},
+				},
+			},
+		},
+		{
+			name: "older version of a recently published package",
+			manifests: []types.Manifest{
+				{
+					Dependencies: []types.Dependency{
+						{Name: "new-pkg", Version: "1.0.0", Definition: types.Definition{Line: 1}},
+					},
+				},
+			},
+			want: mistakes,
+		},
+		{
+			name: "version range locked to a version published recently",
+			manifests: []types.Manifest{
+				{
+					Dependencies: ranged,
+					Graph:        types.NewGraph(ranged, locked),
+				},
+			},
+			want: []types.Mistake{
+				{
+					Rule:        *rule,
+					Definitions: []types.Definition{{Line: 1}},
+				},
+			},
+		},
+		{
+			name: "version without publish date",
+			manifests: []types.Manifest{
+				{
+					Dependencies: []types.Dependency{
+						{Name: "new-pkg", Version: "1.1.0"},
+						{Name: "missing-pkg", Version: "1.0.0"},
+					},
+				},
+			},
+			want: mistakes,
+		},
+		{
+			name: "container images aren't supported",
+			manifests: []types.Manifest{
+				{
+					Manager: types.Docker,
+					Dependencies: []types.Dependency{
+						{Manager: types.Docker, Name: "new-pkg", Version: "1.0.1"},
+					},
+				},
+			},
+			want: mistakes,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := rule.Check(tt.manifests, info, config.Config{})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("exempted package", func(t *testing.T) {
+		dir := t.TempDir()
+		err := os.WriteFile(filepath.Join(dir, "depshub.yaml"), []byte(`
+version: 1
+manifest_files:
+  - filter: "**"
+    packages: ["new-pkg"]
+    rules:
+      - name: "min-release-age"
+        disabled: true
+`), 0644)
+		require.NoError(t, err)
+
+		c, err := config.New(filepath.Join(dir, "depshub.yaml"))
+		require.NoError(t, err)
+
+		got, err := rule.Check([]types.Manifest{
+			{
+				Path: "package.json",
+				Dependencies: []types.Dependency{
+					{Name: "new-pkg", Version: "1.0.1", Definition: types.Definition{Line: 1}},
+				},
+			},
+		}, info, c)
+		assert.NoError(t, err)
+
+		// Mistakes of disabled rules are hidden by the lint command
+		assert.Len(t, got, 1)
+		assert.Equal(t, types.LevelDisabled, got[0].Rule.GetLevel())
+	})
+}
+
+func TestRuleMinReleaseAge_InCooldown(t *testing.T) {
+	rule := NewRuleMinReleaseAge()
+	require.NoError(t, rule.SetValue(3))
+
+	pkg := types.Package{
+		Time: map[string]time.Time{
+			"1.0.0": time.Now().AddDate(0, 0, -5),
+			"1.1.0": time.Now().AddDate(0, 0, -1),
+		},
+	}
+
+	assert.False(t, rule.InCooldown(pkg, "1.0.0"))
+	assert.True(t, rule.InCooldown(pkg, "1.1.0"))
+	assert.False(t, rule.InCooldown(pkg, "2.0.0"))
+}
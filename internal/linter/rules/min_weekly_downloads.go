@@ -19,7 +19,7 @@ func NewRuleMinWeeklyDownloads() *RuleMinWeeklyDownloads {
 	return &RuleMinWeeklyDownloads{
 		name:      "min-weekly-downloads",
 		level:     types.LevelError,
-		supported: []types.ManagerType{types.Npm, types.Cargo, types.Hex},
+		supported: []types.ManagerType{types.Npm, types.Cargo, types.Hex, types.Composer, types.Pub},
 		value:     DefaultMinWeeklyDownloads,
 	}
 }
@@ -65,7 +65,7 @@ func (r RuleMinWeeklyDownloads) Check(manifests []types.Manifest, info types.Pac
 		}
 
 		for _, dep := range manifest.Dependencies {
-			if pkg, ok := info[dep.Name]; ok {
+			if pkg, ok := info.Get(dep.Manager, dep.Name); ok {
 				err := c.Apply(manifest.Path, dep.Name, &r)
 
 				if err != nil {
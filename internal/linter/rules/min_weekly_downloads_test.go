@@ -42,7 +42,7 @@ func TestRuleMinWeeklyDownloads(t *testing.T) {
 				},
 			},
 			info: types.PackagesInfo{
-				"popular-pkg": types.Package{
+				"npm:popular-pkg": types.Package{
 					Downloads: []types.Download{
 						{Downloads: 600},
 						{Downloads: 500}, // Total: 1100 > MinWeeklyDownloads
@@ -69,7 +69,7 @@ func TestRuleMinWeeklyDownloads(t *testing.T) {
 				},
 			},
 			info: types.PackagesInfo{
-				"unpopular-pkg": types.Package{
+				"npm:unpopular-pkg": types.Package{
 					Downloads: []types.Download{
 						{Downloads: 400},
 						{Downloads: 300}, // Total: 700 < MinWeeklyDownloads
@@ -115,13 +115,13 @@ func TestRuleMinWeeklyDownloads(t *testing.T) {
 				},
 			},
 			info: types.PackagesInfo{
-				"pkg1": types.Package{
+				"npm:pkg1": types.Package{
 					Downloads: []types.Download{
 						{Downloads: 800},
 						{Downloads: 300}, // Total: 1100 > MinWeeklyDownloads
 					},
 				},
-				"pkg2": types.Package{
+				"npm:pkg2": types.Package{
 					Downloads: []types.Download{
 						{Downloads: 400},
 						{Downloads: 200}, // Total: 600 < MinWeeklyDownloads
@@ -16,7 +16,7 @@ func NewRuleNoAnyTag() *RuleNoAnyTag {
 	return &RuleNoAnyTag{
 		name:      "no-any-tag",
 		level:     types.LevelWarning,
-		supported: []types.ManagerType{types.Npm, types.Go, types.Cargo, types.Pip, types.Hex, types.Pyproject, types.Maven},
+		supported: []types.ManagerType{types.Npm, types.Go, types.Cargo, types.Pip, types.Hex, types.Pyproject, types.Maven, types.Pipfile, types.Bundler, types.Composer, types.NuGet, types.Docker, types.Terraform, types.Pub, types.SwiftPM, types.Helm, types.Conda, types.Deno},
 	}
 }
 
@@ -61,6 +61,11 @@ func (r RuleNoAnyTag) Check(manifests []types.Manifest, info types.PackagesInfo,
 				return nil, err
 			}
 
+			// Digests pin the exact content whatever the tag is
+			if dep.Digest != "" {
+				continue
+			}
+
 			if dep.Version == "*" || dep.Version == "latest" || dep.Version == "" {
 				mistakes = append(mistakes, types.Mistake{
 					Rule:        r,
@@ -114,6 +114,34 @@ func TestRuleNoAnyTag_Check(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "image pinned to a digest",
+			manifests: []types.Manifest{
+				{
+					Manager: types.Docker,
+					Dependencies: []types.Dependency{
+						{
+							Definition: types.Definition{Path: "dep1"},
+							Version:    "latest",
+							Digest:     "sha256:0b7d2e3bbb8d6f1e5f6c5a4c1c7e5bd5e1e8f0dc3b0e0b7d2e3bbb8d6f1e5f6c",
+						},
+						{
+							Definition: types.Definition{Path: "dep2"},
+							Version:    "latest",
+						},
+					},
+				},
+			},
+			want: []types.Mistake{
+				{
+					Rule: *NewRuleNoAnyTag(),
+					Definitions: []types.Definition{
+						{Path: "dep2"},
+					},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "multiple manifests with mixed version tags",
 			manifests: []types.Manifest{
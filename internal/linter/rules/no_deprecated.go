@@ -10,18 +10,22 @@ type RuleNoDeprecated struct {
 	name      string
 	level     types.Level
 	supported []types.ManagerType
+	// Check the transitive packages of the dependency graphs too
+	includeTransitive bool
+	// The transitive package of the mistake
+	transitive *types.Node
 }
 
 func NewRuleNoDeprecated() *RuleNoDeprecated {
 	return &RuleNoDeprecated{
 		name:      "no-deprecated",
 		level:     types.LevelError,
-		supported: []types.ManagerType{types.Npm, types.Go, types.Cargo, types.Pip, types.Hex, types.Pyproject, types.Maven},
+		supported: []types.ManagerType{types.Npm, types.Go, types.Cargo, types.Pip, types.Hex, types.Pyproject, types.Maven, types.Pipfile, types.Bundler, types.Composer, types.NuGet, types.Terraform, types.Pub, types.Helm, types.Deno},
 	}
 }
 
 func (r RuleNoDeprecated) GetMessage() string {
-	return "Disallow the use of deprecated package versions"
+	return transitiveMessage("Disallow the use of deprecated package versions", r.transitive)
 }
 
 func (r RuleNoDeprecated) GetName() string {
@@ -40,6 +44,10 @@ func (r *RuleNoDeprecated) SetValue(value any) error {
 	return nil
 }
 
+func (r *RuleNoDeprecated) SetIncludeTransitive(include bool) {
+	r.includeTransitive = include
+}
+
 func (r RuleNoDeprecated) IsSupported(t types.ManagerType) bool {
 	return slices.Contains(r.supported, t)
 }
@@ -57,26 +65,61 @@ func (r RuleNoDeprecated) Check(manifests []types.Manifest, info types.PackagesI
 		}
 
 		for _, dep := range manifest.Dependencies {
-			if pkg, ok := info[dep.Name]; ok {
+			if pkg, ok := info.Get(dep.Manager, dep.Name); ok {
 				err := c.Apply(manifest.Path, dep.Name, &r)
 
 				if err != nil {
 					return nil, err
 				}
 
-				for _, version := range pkg.Versions {
-					if version.Version == dep.Version && version.Deprecated != "" {
-						mistakes = append(mistakes, types.Mistake{
-							Rule: r,
-							Definitions: []types.Definition{
-								dep.Definition,
-							},
-						})
-					}
+				if deprecated(pkg, dep.Version) {
+					mistakes = append(mistakes, types.Mistake{
+						Rule: r,
+						Definitions: []types.Definition{
+							dep.Definition,
+						},
+					})
 				}
 			}
 		}
+
+		if manifest.Graph == nil {
+			continue
+		}
+
+		for _, node := range manifest.Graph.Transitive() {
+			pkg, ok := info.Get(node.Manager, node.Name)
+			if !ok {
+				continue
+			}
+
+			if err := c.Apply(manifest.Path, node.Name, &r); err != nil {
+				return nil, err
+			}
+
+			if r.includeTransitive && deprecated(pkg, node.Version) {
+				mistake := r
+				mistake.transitive = node
+
+				mistakes = append(mistakes, types.Mistake{
+					Rule: mistake,
+					Definitions: []types.Definition{
+						node.IntroducedBy.Definition,
+					},
+				})
+			}
+		}
 	}
 
 	return mistakes, nil
 }
+
+func deprecated(pkg types.Package, version string) bool {
+	for _, v := range pkg.Versions {
+		if v.Version == version && v.Deprecated != "" {
+			return true
+		}
+	}
+
+	return false
+}
@@ -44,7 +44,7 @@ func TestRuleNoDeprecated(t *testing.T) {
 				},
 			},
 			info: types.PackagesInfo{
-				"test-pkg": {
+				"npm:test-pkg": {
 					Versions: map[string]types.PackageVersion{
 						"1.0.0": {
 							Version:    "1.0.0",
@@ -65,6 +65,36 @@ func TestRuleNoDeprecated(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "packages with the same name in other ecosystems",
+			manifests: []types.Manifest{
+				{
+					Manager: types.Docker,
+					Dependencies: []types.Dependency{
+						{
+							Manager:    types.Docker,
+							Name:       "redis",
+							Version:    "4.7.0",
+							Definition: types.Definition{Path: "Dockerfile", Line: 1},
+						},
+					},
+				},
+			},
+			info: types.PackagesInfo{
+				"npm:redis": {
+					Versions: map[string]types.PackageVersion{
+						"4.7.0": {Version: "4.7.0", Deprecated: "This version is deprecated"},
+					},
+				},
+				"docker:redis": {
+					Versions: map[string]types.PackageVersion{
+						"4.7.0": {Version: "4.7.0"},
+					},
+				},
+			},
+			want:    []types.Mistake{},
+			wantErr: false,
+		},
 		{
 			name: "non-deprecated package version",
 			manifests: []types.Manifest{
@@ -78,7 +108,7 @@ func TestRuleNoDeprecated(t *testing.T) {
 				},
 			},
 			info: types.PackagesInfo{
-				"test-pkg": {
+				"npm:test-pkg": {
 					Versions: map[string]types.PackageVersion{
 						"1.0.0": {
 							Version:    "1.0.0",
@@ -119,7 +149,7 @@ func TestRuleNoDeprecated(t *testing.T) {
 				},
 			},
 			info: types.PackagesInfo{
-				"test-pkg": {
+				"npm:test-pkg": {
 					Versions: map[string]types.PackageVersion{
 						"1.0.0": {
 							Version:    "1.0.0",
@@ -154,7 +184,7 @@ func TestRuleNoDeprecated(t *testing.T) {
 				},
 			},
 			info: types.PackagesInfo{
-				"pkg1": {
+				"npm:pkg1": {
 					Versions: map[string]types.PackageVersion{
 						"1.0.0": {
 							Version:    "1.0.0",
@@ -162,7 +192,7 @@ func TestRuleNoDeprecated(t *testing.T) {
 						},
 					},
 				},
-				"pkg2": {
+				"npm:pkg2": {
 					Versions: map[string]types.PackageVersion{
 						"2.0.0": {
 							Version:    "2.0.0",
@@ -197,3 +227,59 @@ func TestRuleNoDeprecated(t *testing.T) {
 		})
 	}
 }
+
+// transitiveConfig sets the value of the rules and checks the transitive packages.
+type transitiveConfig struct {
+	value any
+}
+
+func (c transitiveConfig) Apply(manifestPath string, packageName string, rule types.Rule) error {
+	rule.Reset()
+	rule.(types.TransitiveRule).SetIncludeTransitive(true)
+
+	if c.value == nil {
+		return nil
+	}
+	return rule.SetValue(c.value)
+}
+
+// transitiveManifest uses express, which depends on debug, which depends on ms.
+func transitiveManifest() types.Manifest {
+	express := types.Dependency{Manager: types.Npm, Name: "express", Version: "^4.21.0", Definition: types.Definition{Line: 3}}
+
+	return types.Manifest{
+		Manager:      types.Npm,
+		Dependencies: []types.Dependency{express},
+		Graph: types.NewGraph([]types.Dependency{express}, []types.LockedPackage{
+			{Manager: types.Npm, Name: "express", Version: "4.21.1", Dependencies: []string{"debug@2.6.9"}, Direct: true},
+			{Manager: types.Npm, Name: "debug", Version: "2.6.9", Dependencies: []string{"ms@2.0.0"}},
+			{Manager: types.Npm, Name: "ms", Version: "2.0.0"},
+		}),
+	}
+}
+
+func TestRuleNoDeprecated_Transitive(t *testing.T) {
+	rule := NewRuleNoDeprecated()
+	manifests := []types.Manifest{transitiveManifest()}
+
+	info := types.PackagesInfo{
+		"npm:ms": {
+			Versions: map[string]types.PackageVersion{
+				"2.0.0": {Version: "2.0.0", Deprecated: "Use ms@2.1.3"},
+			},
+		},
+	}
+
+	// Transitive packages aren't checked by default
+	mistakes, err := rule.Check(manifests, info, config.Config{})
+	assert.NoError(t, err)
+	assert.Empty(t, mistakes)
+
+	mistakes, err = rule.Check(manifests, info, transitiveConfig{})
+	assert.NoError(t, err)
+	assert.Len(t, mistakes, 1)
+
+	// The mistake is reported on the direct dependency introducing the package
+	assert.Equal(t, 3, mistakes[0].Definitions[0].Line)
+	assert.Equal(t, "Disallow the use of deprecated package versions. Introduced by express@4.21.1 > debug@2.6.9 > ms@2.0.0.", mistakes[0].Rule.GetMessage())
+}
@@ -16,7 +16,7 @@ func NewRuleNoDuplicates() *RuleNoDuplicates {
 	return &RuleNoDuplicates{
 		name:      "no-duplicates",
 		level:     types.LevelError,
-		supported: []types.ManagerType{types.Npm, types.Go, types.Cargo, types.Pip, types.Hex, types.Pyproject, types.Maven},
+		supported: []types.ManagerType{types.Npm, types.Go, types.Cargo, types.Pip, types.Hex, types.Pyproject, types.Maven, types.Pipfile, types.Bundler, types.Composer, types.NuGet, types.Terraform, types.SwiftPM, types.Helm, types.Conda, types.Deno},
 	}
 }
 
@@ -64,7 +64,8 @@ func (r RuleNoDuplicates) Check(manifests []types.Manifest, info types.PackagesI
 					return nil, err
 				}
 
-				if deps[i].Name == deps[j].Name {
+				// Groups like the extras of pyproject.toml can repeat a package
+				if deps[i].Name == deps[j].Name && deps[i].Group == deps[j].Group {
 					mistakes = append(mistakes, types.Mistake{
 						Rule:        r,
 						Definitions: []types.Definition{deps[i].Definition},
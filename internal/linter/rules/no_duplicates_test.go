@@ -52,6 +52,26 @@ func TestRuleNoDuplicates_Check(t *testing.T) {
 			want:    nil,
 			wantErr: false,
 		},
+		{
+			name: "packages repeated in groups",
+			manifests: []types.Manifest{
+				{
+					Dependencies: []types.Dependency{
+						{
+							Name:       "tokio",
+							Definition: types.Definition{Path: "path/tokio"},
+						},
+						{
+							Name:       "tokio",
+							Group:      "target.cfg(windows).dependencies",
+							Definition: types.Definition{Path: "path/tokio-windows"},
+						},
+					},
+				},
+			},
+			want:    nil,
+			wantErr: false,
+		},
 		{
 			name: "manifest with single duplicate",
 			manifests: []types.Manifest{
@@ -16,7 +16,7 @@ func NewRuleNoMultipleVersions() *RuleNoMultipleVersions {
 	return &RuleNoMultipleVersions{
 		name:      "no-multiple-versions",
 		level:     types.LevelError,
-		supported: []types.ManagerType{types.Npm, types.Go, types.Cargo, types.Pip, types.Hex, types.Pyproject, types.Maven},
+		supported: []types.ManagerType{types.Npm, types.Go, types.Cargo, types.Pip, types.Hex, types.Pyproject, types.Maven, types.Pipfile, types.Bundler, types.Composer, types.NuGet, types.Terraform, types.Pub, types.SwiftPM, types.Helm, types.Conda, types.Deno},
 	}
 }
 
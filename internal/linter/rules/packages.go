@@ -0,0 +1,73 @@
+package rules
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+// packagePattern matches packages by name, using glob patterns like
+// "@types/*" or "org.apache.logging.log4j:*".
+type packagePattern struct {
+	Name string
+	// The package URL type of the packages, like npm or maven. Empty matches all of them.
+	Ecosystem string
+	// A version constraint like "<2.0.0" or "1.x". Empty matches all the versions.
+	Version string
+}
+
+func (p packagePattern) matches(dep types.Dependency) bool {
+	if p.Ecosystem != "" && !strings.EqualFold(p.Ecosystem, dep.Manager.Ecosystem()) {
+		return false
+	}
+
+	if matched, err := doublestar.Match(p.Name, dep.Name); err != nil || !matched {
+		return false
+	}
+
+	return p.Version == "" || matchVersion(dep.Version, p.Version)
+}
+
+// parsePackageEntries reads the value of the rules listing packages. Entries are
+// package names or maps with a name and other fields like ecosystem and version.
+func parsePackageEntries(value any, fields ...string) ([]map[string]string, error) {
+	v, ok := value.([]any)
+	if !ok {
+		return nil, types.ErrInvalidRuleValue
+	}
+
+	entries := []map[string]string{}
+
+	for _, i := range v {
+		entry := map[string]string{}
+
+		switch val := i.(type) {
+		case string:
+			entry["name"] = val
+		case map[string]any:
+			for key, field := range val {
+				s, ok := field.(string)
+				if !ok || (key != "name" && !slices.Contains(fields, key)) {
+					return nil, types.ErrInvalidRuleValue
+				}
+				entry[key] = s
+			}
+		default:
+			return nil, types.ErrInvalidRuleValue
+		}
+
+		if entry["name"] == "" {
+			return nil, types.ErrInvalidRuleValue
+		}
+
+		if _, err := doublestar.Match(entry["name"], ""); err != nil {
+			return nil, types.ErrInvalidRuleValue
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,103 @@
+package rules
+
+import (
+	"regexp"
+	"slices"
+
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+var commitSHAPattern = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
+type RulePinnedActions struct {
+	name      string
+	level     types.Level
+	supported []types.ManagerType
+	// Accept tags and only report branches
+	allowTags bool
+}
+
+func NewRulePinnedActions() *RulePinnedActions {
+	return &RulePinnedActions{
+		name:      "pinned-actions",
+		level:     types.LevelError,
+		supported: []types.ManagerType{types.GitHubActions},
+		allowTags: false,
+	}
+}
+
+func (r RulePinnedActions) GetMessage() string {
+	if r.allowTags {
+		return "Actions must be pinned to a tag or a full commit SHA, not a branch"
+	}
+	return "Actions must be pinned to a full commit SHA"
+}
+
+func (r RulePinnedActions) GetName() string {
+	return r.name
+}
+
+func (r RulePinnedActions) GetLevel() types.Level {
+	return r.level
+}
+
+func (r *RulePinnedActions) SetLevel(level types.Level) {
+	r.level = level
+}
+
+func (r *RulePinnedActions) SetValue(value any) error {
+	if v, ok := value.(bool); ok {
+		r.allowTags = v
+		return nil
+	}
+	return types.ErrInvalidRuleValue
+}
+
+func (r RulePinnedActions) IsSupported(t types.ManagerType) bool {
+	return slices.Contains(r.supported, t)
+}
+
+func (r *RulePinnedActions) Reset() {
+	*r = *NewRulePinnedActions()
+}
+
+func (r RulePinnedActions) Check(manifests []types.Manifest, info types.PackagesInfo, c types.Config) ([]types.Mistake, error) {
+	mistakes := []types.Mistake{}
+
+	for _, manifest := range manifests {
+		if !r.IsSupported(manifest.Manager) {
+			continue
+		}
+
+		for _, dep := range manifest.Dependencies {
+			err := c.Apply(manifest.Path, dep.Name, &r)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if commitSHAPattern.MatchString(dep.Version) {
+				continue
+			}
+
+			if r.allowTags {
+				pkg, ok := info.Get(dep.Manager, dep.Name)
+				// Refs can only be identified as branches when the tags of the action are known
+				if !ok {
+					continue
+				}
+
+				if _, isTag := pkg.Versions[dep.Version]; isTag {
+					continue
+				}
+			}
+
+			mistakes = append(mistakes, types.Mistake{
+				Rule:        r,
+				Definitions: []types.Definition{dep.Definition},
+			})
+		}
+	}
+
+	return mistakes, nil
+}
@@ -0,0 +1,120 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/depshubhq/depshub/internal/config"
+	"github.com/depshubhq/depshub/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// valueConfig applies the same value to every rule, like a depshub.yaml with a single rule.
+type valueConfig struct {
+	value any
+}
+
+func (c valueConfig) Apply(manifestPath string, packageName string, rule types.Rule) error {
+	rule.Reset()
+	return rule.SetValue(c.value)
+}
+
+func TestRulePinnedActions(t *testing.T) {
+	rule := NewRulePinnedActions()
+
+	t.Run("metadata", func(t *testing.T) {
+		assert.Equal(t, "pinned-actions", rule.GetName())
+		assert.Equal(t, types.LevelError, rule.GetLevel())
+		assert.Equal(t, "Actions must be pinned to a full commit SHA", rule.GetMessage())
+		assert.True(t, rule.IsSupported(types.GitHubActions))
+		assert.False(t, rule.IsSupported(types.Npm))
+	})
+
+	manifests := []types.Manifest{
+		{
+			Manager: types.GitHubActions,
+			Dependencies: []types.Dependency{
+				{
+					Manager:    types.GitHubActions,
+					Name:       "actions/checkout",
+					Version:    "11bd71901bbe5b1630ceea73d27597364c9af683",
+					Definition: types.Definition{Line: 1},
+				},
+				{
+					Manager:    types.GitHubActions,
+					Name:       "actions/setup-go",
+					Version:    "v5",
+					Definition: types.Definition{Line: 2},
+				},
+				{
+					Manager:    types.GitHubActions,
+					Name:       "actions/cache",
+					Version:    "main",
+					Definition: types.Definition{Line: 3},
+				},
+				{
+					Manager:    types.GitHubActions,
+					Name:       "actions/upload-artifact",
+					Version:    "11bd719",
+					Definition: types.Definition{Line: 4},
+				},
+			},
+		},
+	}
+
+	info := types.PackagesInfo{
+		"github:actions/setup-go": {
+			Versions: map[string]types.PackageVersion{"v5": {Version: "v5"}},
+		},
+		"github:actions/cache": {
+			Versions: map[string]types.PackageVersion{"v4": {Version: "v4"}},
+		},
+		"github:actions/upload-artifact": {
+			Versions: map[string]types.PackageVersion{"v4": {Version: "v4"}},
+		},
+	}
+
+	tagsAllowed := NewRulePinnedActions()
+	tagsAllowed.allowTags = true
+
+	tests := []struct {
+		name   string
+		config types.Config
+		want   []types.Mistake
+	}{
+		{
+			name:   "full commit SHA required",
+			config: config.Config{},
+			want: []types.Mistake{
+				{Rule: *rule, Definitions: []types.Definition{{Line: 2}}},
+				{Rule: *rule, Definitions: []types.Definition{{Line: 3}}},
+				{Rule: *rule, Definitions: []types.Definition{{Line: 4}}},
+			},
+		},
+		{
+			name:   "tags allowed",
+			config: valueConfig{value: true},
+			want: []types.Mistake{
+				{Rule: *tagsAllowed, Definitions: []types.Definition{{Line: 3}}},
+				{Rule: *tagsAllowed, Definitions: []types.Definition{{Line: 4}}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := rule.Check(manifests, info, tt.config)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("unknown action with tags allowed", func(t *testing.T) {
+		got, err := rule.Check(manifests, types.PackagesInfo{}, valueConfig{value: true})
+		assert.NoError(t, err)
+		assert.Empty(t, got)
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		assert.ErrorIs(t, rule.SetValue("yes"), types.ErrInvalidRuleValue)
+	})
+}
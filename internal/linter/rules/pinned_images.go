@@ -0,0 +1,76 @@
+package rules
+
+import (
+	"slices"
+
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+type RulePinnedImages struct {
+	name      string
+	level     types.Level
+	supported []types.ManagerType
+}
+
+func NewRulePinnedImages() *RulePinnedImages {
+	return &RulePinnedImages{
+		name:      "pinned-images",
+		level:     types.LevelWarning,
+		supported: []types.ManagerType{types.Docker},
+	}
+}
+
+func (r RulePinnedImages) GetMessage() string {
+	return "Container images must be pinned to a digest"
+}
+
+func (r RulePinnedImages) GetName() string {
+	return r.name
+}
+
+func (r RulePinnedImages) GetLevel() types.Level {
+	return r.level
+}
+
+func (r *RulePinnedImages) SetLevel(level types.Level) {
+	r.level = level
+}
+
+func (r *RulePinnedImages) SetValue(value any) error {
+	return nil
+}
+
+func (r RulePinnedImages) IsSupported(t types.ManagerType) bool {
+	return slices.Contains(r.supported, t)
+}
+
+func (r *RulePinnedImages) Reset() {
+	*r = *NewRulePinnedImages()
+}
+
+func (r RulePinnedImages) Check(manifests []types.Manifest, info types.PackagesInfo, c types.Config) ([]types.Mistake, error) {
+	mistakes := []types.Mistake{}
+
+	for _, manifest := range manifests {
+		if !r.IsSupported(manifest.Manager) {
+			continue
+		}
+
+		for _, dep := range manifest.Dependencies {
+			err := c.Apply(manifest.Path, dep.Name, &r)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if dep.Digest == "" {
+				mistakes = append(mistakes, types.Mistake{
+					Rule:        r,
+					Definitions: []types.Definition{dep.Definition},
+				})
+			}
+		}
+	}
+
+	return mistakes, nil
+}
@@ -0,0 +1,70 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/depshubhq/depshub/internal/config"
+	"github.com/depshubhq/depshub/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRulePinnedImages(t *testing.T) {
+	rule := NewRulePinnedImages()
+
+	t.Run("metadata", func(t *testing.T) {
+		assert.Equal(t, "pinned-images", rule.GetName())
+		assert.Equal(t, types.LevelWarning, rule.GetLevel())
+		assert.Equal(t, "Container images must be pinned to a digest", rule.GetMessage())
+	})
+
+	tests := []struct {
+		name      string
+		manifests []types.Manifest
+		want      []types.Mistake
+	}{
+		{
+			name: "images with and without digest",
+			manifests: []types.Manifest{
+				{
+					Manager: types.Docker,
+					Dependencies: []types.Dependency{
+						{
+							Name:       "node",
+							Version:    "20",
+							Digest:     "sha256:0b7d2e3bbb8d6f1e5f6c5a4c1c7e5bd5e1e8f0dc3b0e0b7d2e3bbb8d6f1e5f6c",
+							Definition: types.Definition{Line: 1},
+						},
+						{
+							Name:       "postgres",
+							Version:    "16.4",
+							Definition: types.Definition{Line: 2},
+						},
+					},
+				},
+			},
+			want: []types.Mistake{
+				{Rule: *rule, Definitions: []types.Definition{{Line: 2}}},
+			},
+		},
+		{
+			name: "unsupported manager",
+			manifests: []types.Manifest{
+				{
+					Manager: types.Npm,
+					Dependencies: []types.Dependency{
+						{Name: "react", Version: "18.3.1"},
+					},
+				},
+			},
+			want: []types.Mistake{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := rule.Check(tt.manifests, types.PackagesInfo{}, config.Config{})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
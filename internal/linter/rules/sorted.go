@@ -16,7 +16,7 @@ func NewRuleSorted() *RuleSorted {
 	return &RuleSorted{
 		name:      "sorted",
 		level:     types.LevelError,
-		supported: []types.ManagerType{types.Npm, types.Go, types.Cargo, types.Pip, types.Hex, types.Pyproject, types.Maven},
+		supported: []types.ManagerType{types.Npm, types.Go, types.Cargo, types.Pip, types.Hex, types.Pyproject, types.Maven, types.Pipfile, types.Bundler, types.Composer, types.NuGet, types.Pub, types.SwiftPM, types.Deno},
 	}
 }
 
@@ -65,8 +65,9 @@ func (r RuleSorted) Check(manifests []types.Manifest, info types.PackagesInfo, c
 			}
 
 			if deps[i].Name > deps[i+1].Name {
-				// Make sure that we don't compare dependencies and devDependencies
-				if deps[i].Dev != deps[i+1].Dev {
+				// Make sure that we don't compare dependencies and devDependencies,
+				// or the groups of a manifest
+				if deps[i].Dev != deps[i+1].Dev || deps[i].Group != deps[i+1].Group {
 					continue
 				}
 
@@ -149,6 +149,37 @@ func TestRuleSorted(t *testing.T) {
 			want:    1,
 			wantErr: false,
 		},
+		{
+			name: "sorted dependency groups",
+			manifests: []types.Manifest{
+				{
+					Dependencies: []types.Dependency{
+						{
+							Name:  "ruff",
+							Dev:   true,
+							Group: "dependency-groups.lint",
+							Definition: types.Definition{
+								Path:    "pyproject.toml",
+								RawLine: `lint = ["ruff==0.6.1"]`,
+								Line:    1,
+							},
+						},
+						{
+							Name:  "mypy",
+							Dev:   true,
+							Group: "tool.uv.dev-dependencies",
+							Definition: types.Definition{
+								Path:    "pyproject.toml",
+								RawLine: `"mypy~=1.11",`,
+								Line:    2,
+							},
+						},
+					},
+				},
+			},
+			want:    0,
+			wantErr: false,
+		},
 		{
 			name: "multiple manifest files",
 			manifests: []types.Manifest{
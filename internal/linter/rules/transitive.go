@@ -0,0 +1,34 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+// transitiveDependency returns a transitive package of the dependency graph as
+// a dependency, defined where the direct dependency introducing it is.
+func transitiveDependency(node *types.Node) types.Dependency {
+	return types.Dependency{
+		Manager:    node.Manager,
+		Name:       node.Name,
+		Version:    node.Version,
+		Dev:        node.Dev,
+		Definition: node.IntroducedBy.Definition,
+	}
+}
+
+// transitiveMessage adds the path from the direct dependency to a transitive
+// package to the message of a mistake.
+func transitiveMessage(message string, node *types.Node) string {
+	if node == nil {
+		return message
+	}
+
+	if !strings.HasSuffix(message, ".") {
+		message += "."
+	}
+
+	return fmt.Sprintf("%s Introduced by %s.", message, strings.Join(node.Path, " > "))
+}
@@ -2,8 +2,11 @@ package rules
 
 import (
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
+
+	"github.com/depshubhq/depshub/pkg/types"
 )
 
 func parseVersion(version string) (major, minor, patch int) {
@@ -43,3 +46,209 @@ func parseVersion(version string) (major, minor, patch int) {
 
 	return major, minor, patch
 }
+
+func compareVersions(a, b string) int {
+	aMajor, aMinor, aPatch := parseVersion(a)
+	bMajor, bMinor, bPatch := parseVersion(b)
+
+	for _, diff := range []int{aMajor - bMajor, aMinor - bMinor, aPatch - bPatch} {
+		if diff != 0 {
+			return diff
+		}
+	}
+
+	return 0
+}
+
+// matchVersion checks a version against a constraint like "<2.0.0", ">=1.0, <2",
+// "1.x" or "1.2.*". Constraints separated by "||" are alternatives. Partial
+// versions like "1" match all the versions they are a prefix of.
+func matchVersion(version string, constraint string) bool {
+	if version == "" {
+		return false
+	}
+
+	for _, alternative := range strings.Split(constraint, "||") {
+		matched := true
+
+		for _, c := range strings.FieldsFunc(alternative, func(r rune) bool { return r == ',' || r == ' ' }) {
+			if !matchConstraint(version, c) {
+				matched = false
+				break
+			}
+		}
+
+		if matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchConstraint(version string, constraint string) bool {
+	for _, op := range []string{"<=", ">=", "!=", "<", ">", "="} {
+		if v, ok := strings.CutPrefix(constraint, op); ok {
+			switch op {
+			case "<=":
+				return compareVersions(version, v) <= 0
+			case ">=":
+				return compareVersions(version, v) >= 0
+			case "<":
+				return compareVersions(version, v) < 0
+			case ">":
+				return compareVersions(version, v) > 0
+			case "!=":
+				return !matchConstraint(version, v)
+			}
+			constraint = v
+		}
+	}
+
+	// Compare the parts of the constraint before any wildcard
+	parts := strings.Split(strings.TrimPrefix(strings.TrimPrefix(constraint, "v"), "V"), ".")
+	versionParts := strings.Split(strings.TrimPrefix(strings.TrimPrefix(version, "v"), "V"), ".")
+
+	for i, part := range parts {
+		if part == "x" || part == "X" || part == "*" {
+			return true
+		}
+
+		if i >= len(versionParts) || versionParts[i] != part {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Matches npm hyphen ranges like "1.2.3 - 2.3.4"
+var hyphenRangePattern = regexp.MustCompile(`^\s*(\S+)\s+-\s+(\S+)\s*$`)
+
+// matchRange checks a version against the version requirement of a manifest,
+// in the syntax of its manager: caret and tilde ranges of npm, Cargo and
+// Poetry, "~>" of mix and "~=" of PEP 440. Requirements that aren't versions,
+// like git URLs, match all the versions.
+func matchRange(manager types.ManagerType, version string, requirement string) bool {
+	requirement = strings.TrimSpace(requirement)
+
+	switch requirement {
+	case "", "*", "x", "X", "latest":
+		return true
+	}
+
+	if strings.ContainsAny(requirement, ":/") {
+		return true
+	}
+
+	var alternatives []string
+
+	for _, alternative := range strings.Split(strings.ReplaceAll(requirement, " or ", "||"), "||") {
+		if matches := hyphenRangePattern.FindStringSubmatch(alternative); matches != nil {
+			alternatives = append(alternatives, ">="+matches[1]+", <="+matches[2])
+			continue
+		}
+
+		var constraints []string
+
+		for _, c := range splitConstraints(strings.ReplaceAll(alternative, " and ", ",")) {
+			constraints = append(constraints, rangeConstraints(manager, c)...)
+		}
+
+		alternatives = append(alternatives, strings.Join(constraints, ", "))
+	}
+
+	return matchVersion(version, strings.Join(alternatives, " || "))
+}
+
+// splitConstraints splits the constraints of a requirement, keeping the
+// operators separated by a space from their version like "~> 1.7".
+func splitConstraints(requirement string) []string {
+	var constraints []string
+	operator := ""
+
+	for _, field := range strings.FieldsFunc(requirement, func(r rune) bool { return r == ',' || r == ' ' }) {
+		if strings.Trim(field, "<>=!~^") == "" {
+			operator += field
+			continue
+		}
+
+		constraints = append(constraints, operator+field)
+		operator = ""
+	}
+
+	return constraints
+}
+
+// rangeConstraints returns the comparisons of a constraint, like ">=1.2.0" and
+// "<2.0.0" for "^1.2.0".
+func rangeConstraints(manager types.ManagerType, constraint string) []string {
+	switch {
+	case strings.HasPrefix(constraint, "^"):
+		return boundedRange(constraint[1:], caretIndex)
+	case strings.HasPrefix(constraint, "~>"), strings.HasPrefix(constraint, "~="):
+		return boundedRange(constraint[2:], func(parts []int) int { return max(len(parts)-2, 0) })
+	case strings.HasPrefix(constraint, "~"):
+		return boundedRange(constraint[1:], func(parts []int) int { return min(1, len(parts)-1) })
+	case strings.HasPrefix(constraint, "==="):
+		return []string{"=" + constraint[3:]}
+	case strings.HasPrefix(constraint, "=="):
+		return []string{"=" + constraint[2:]}
+	case strings.TrimLeft(constraint, "<>=!") != constraint:
+		return []string{constraint}
+	}
+
+	// Cargo requirements without an operator are caret ones
+	if manager == types.Cargo {
+		return boundedRange(constraint, caretIndex)
+	}
+
+	return []string{constraint}
+}
+
+// boundedRange returns the comparisons from a version to the next version
+// incrementing one of its parts, chosen by the index function.
+func boundedRange(version string, index func(parts []int) int) []string {
+	version = strings.TrimSpace(version)
+
+	// Pre-releases and build metadata don't change the upper bound
+	core, _, _ := strings.Cut(strings.TrimPrefix(version, "v"), "-")
+	core, _, _ = strings.Cut(core, "+")
+
+	var parts []int
+	for _, part := range strings.Split(core, ".") {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			// Wildcards like 1.x end the version
+			break
+		}
+		parts = append(parts, n)
+	}
+
+	if len(parts) == 0 {
+		return nil
+	}
+
+	i := index(parts)
+	upper := slices.Clone(parts[:i+1])
+	upper[i]++
+
+	var bound []string
+	for _, part := range upper {
+		bound = append(bound, strconv.Itoa(part))
+	}
+
+	return []string{">=" + version, "<" + strings.Join(bound, ".")}
+}
+
+// caretIndex returns the part incremented by caret ranges, which is the first
+// one that isn't zero: ^1.2.3 is <2.0.0, ^0.2.3 is <0.3.0 and ^0.0.3 is <0.0.4.
+func caretIndex(parts []int) int {
+	for i, part := range parts {
+		if part != 0 {
+			return i
+		}
+	}
+
+	return len(parts) - 1
+}
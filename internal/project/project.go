@@ -0,0 +1,102 @@
+// Package project scans a repository for manifests and fetches the information
+// of their packages, which is shared by the commands.
+package project
+
+import (
+	"fmt"
+
+	"github.com/depshubhq/depshub/internal/config"
+	"github.com/depshubhq/depshub/internal/sbom"
+	"github.com/depshubhq/depshub/pkg/manager"
+	"github.com/depshubhq/depshub/pkg/sources"
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+type Project struct {
+	Config    config.Config
+	Manifests []types.Manifest
+	// The information of the packages, available after Fetch
+	Packages types.PackagesInfo
+}
+
+// Scan finds the manifests of a path using the config file of configPath.
+func Scan(path string, configPath string) (Project, error) {
+	config, err := config.New(configPath)
+
+	if err != nil {
+		return Project{}, fmt.Errorf("failed to load config: %w", err)
+	}
+
+	manifests, err := manager.New(config).Scan(path)
+	if err != nil {
+		return Project{}, fmt.Errorf("failed to scan manifests: %w", err)
+	}
+
+	return Project{Config: config, Manifests: manifests}, nil
+}
+
+// FromSBOM reads the packages of a CycloneDX or SPDX document, using the config
+// file of configPath.
+func FromSBOM(sbomPath string, configPath string) (Project, error) {
+	config, err := config.New(configPath)
+
+	if err != nil {
+		return Project{}, fmt.Errorf("failed to load config: %w", err)
+	}
+
+	manifests, err := sbom.Read(sbomPath)
+	if err != nil {
+		return Project{}, fmt.Errorf("failed to read SBOM: %w", err)
+	}
+
+	return Project{Config: config, Manifests: manifests}, nil
+}
+
+// Fetch gets the information of the packages of the manifests from their
+// registries. The transitive packages are fetched too when a rule checks them.
+// Packages that can't be fetched are missing from Packages.
+func (p *Project) Fetch() error {
+	scanner := manager.New(p.Config)
+	dependencies := scanner.UniqueDependencies(p.Manifests)
+
+	if p.Config.IncludesTransitive() {
+		dependencies = appendTransitive(dependencies, p.Manifests)
+	}
+
+	packages, err := sources.NewFetcher().Fetch(dependencies)
+	p.Packages = packages
+
+	return err
+}
+
+// appendTransitive adds the packages of the dependency graphs that aren't
+// dependencies of the manifests, once per package and version.
+func appendTransitive(dependencies []types.Dependency, manifests []types.Manifest) []types.Dependency {
+	keys := make(map[string]bool)
+	for _, dep := range dependencies {
+		keys[types.PackageKey(dep.Manager, dep.Name)+"@"+dep.Version] = true
+	}
+
+	for _, manifest := range manifests {
+		if manifest.Graph == nil {
+			continue
+		}
+
+		for _, node := range manifest.Graph.Transitive() {
+			key := types.PackageKey(node.Manager, node.Name) + "@" + node.Version
+			if keys[key] {
+				continue
+			}
+			keys[key] = true
+
+			dependencies = append(dependencies, types.Dependency{
+				Manager: node.Manager,
+				Name:    node.Name,
+				Version: node.Version,
+				Dev:     node.Dev,
+			})
+		}
+	}
+
+	return dependencies
+}
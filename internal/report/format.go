@@ -0,0 +1,102 @@
+package report
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Formats are the output formats of the license report.
+var Formats = []string{"text", "csv", "json", "markdown"}
+
+// Write writes the groups in one of the Formats.
+func Write(w io.Writer, format string, groups []Group) error {
+	switch format {
+	case "text":
+		return writeText(w, groups)
+	case "csv":
+		return writeCSV(w, groups)
+	case "json":
+		return writeJSON(w, groups)
+	case "markdown":
+		return writeMarkdown(w, groups)
+	}
+
+	return fmt.Errorf("unknown format %q, use one of %s", format, strings.Join(Formats, ", "))
+}
+
+func writeText(w io.Writer, groups []Group) error {
+	for i, group := range groups {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+
+		fmt.Fprintf(w, "%s (%d)\n", group.License, len(group.Packages))
+
+		for _, entry := range group.Packages {
+			fmt.Fprintf(w, "  - %s %s (%s)\n", entry.Name, version(entry), entry.Ecosystem)
+		}
+	}
+
+	return nil
+}
+
+func writeCSV(w io.Writer, groups []Group) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write([]string{"license", "ecosystem", "name", "version", "dev"}); err != nil {
+		return err
+	}
+
+	for _, group := range groups {
+		for _, entry := range group.Packages {
+			if err := writer.Write([]string{group.License, entry.Ecosystem, entry.Name, entry.Version, strconv.FormatBool(entry.Dev)}); err != nil {
+				return err
+			}
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func writeJSON(w io.Writer, groups []Group) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	if groups == nil {
+		groups = []Group{}
+	}
+
+	return encoder.Encode(groups)
+}
+
+func writeMarkdown(w io.Writer, groups []Group) error {
+	fmt.Fprintln(w, "# Licenses")
+
+	for _, group := range groups {
+		fmt.Fprintf(w, "\n## %s\n\n", group.License)
+		fmt.Fprintln(w, "| Package | Version | Ecosystem |")
+		fmt.Fprintln(w, "| ------- | ------- | --------- |")
+
+		for _, entry := range group.Packages {
+			fmt.Fprintf(w, "| %s | %s | %s |\n", escapeMarkdown(entry.Name), escapeMarkdown(version(entry)), entry.Ecosystem)
+		}
+	}
+
+	return nil
+}
+
+func version(entry Entry) string {
+	if entry.Version == "" {
+		return "(any)"
+	}
+	return entry.Version
+}
+
+func escapeMarkdown(s string) string {
+	return strings.ReplaceAll(s, "|", "\\|")
+}
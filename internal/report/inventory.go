@@ -0,0 +1,145 @@
+// Package report lists the packages used by a project with their licenses, and
+// the dependency paths to them.
+package report
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/depshubhq/depshub/pkg/licenses"
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+// UnknownLicense groups the packages without license information.
+const UnknownLicense = "Unknown"
+
+// Entry is a package used by the project, with the license of the used version.
+type Entry struct {
+	Ecosystem string `json:"ecosystem"`
+	Name      string `json:"name"`
+	Version   string `json:"version"`
+	License   string `json:"license"`
+	// Dev is true when the package is only used for development
+	Dev bool `json:"dev"`
+	// The manifests using the package
+	Manifests []string `json:"manifests"`
+
+	manager types.ManagerType
+	// The license text returned by the registry instead of an identifier
+	text string
+}
+
+// Group is a license and the packages using it.
+type Group struct {
+	License  string  `json:"license"`
+	Packages []Entry `json:"packages"`
+}
+
+// Inventory lists the packages of the manifests once per ecosystem, name and version.
+func Inventory(manifests []types.Manifest, packages types.PackagesInfo) []Entry {
+	var entries []Entry
+	index := make(map[string]int)
+
+	for _, manifest := range manifests {
+		for _, dep := range manifest.Dependencies {
+			key := dep.Manager.Ecosystem() + ":" + dep.Name + "@" + dep.Version
+
+			if i, ok := index[key]; ok {
+				entries[i].Dev = entries[i].Dev && dep.Dev
+				if !slices.Contains(entries[i].Manifests, manifest.Path) {
+					entries[i].Manifests = append(entries[i].Manifests, manifest.Path)
+				}
+				continue
+			}
+
+			license, text := UnknownLicense, ""
+			if pkg, ok := packages.Get(dep.Manager, dep.Name); ok {
+				license, text = normalizeLicense(pkg.LicenseOf(dep.Version))
+			}
+
+			index[key] = len(entries)
+			entries = append(entries, Entry{
+				Ecosystem: dep.Manager.Ecosystem(),
+				Name:      dep.Name,
+				Version:   dep.Version,
+				License:   license,
+				Dev:       dep.Dev,
+				Manifests: []string{manifest.Path},
+				manager:   dep.Manager,
+				text:      text,
+			})
+		}
+	}
+
+	slices.SortFunc(entries, func(a, b Entry) int {
+		if c := strings.Compare(a.Ecosystem, b.Ecosystem); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.Name, b.Name); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Version, b.Version)
+	})
+
+	return entries
+}
+
+// normalizeLicense returns the SPDX expression of a license, or the license
+// as it is when it isn't one. Some registries return the whole license text,
+// which is returned too and named after its first line, like "MIT License".
+func normalizeLicense(license string) (string, string) {
+	license = strings.TrimSpace(license)
+	if license == "" {
+		return UnknownLicense, ""
+	}
+
+	if title, _, ok := strings.Cut(license, "\n"); ok {
+		if id, ok := licenses.Normalize(title); ok {
+			return id, license
+		}
+		return UnknownLicense, license
+	}
+
+	return licenses.ParseOrUnknown(license).String(), ""
+}
+
+// GroupByLicense groups the entries by license, the packages without a license are last.
+func GroupByLicense(entries []Entry) []Group {
+	var groups []Group
+
+	for _, entry := range entries {
+		i := slices.IndexFunc(groups, func(g Group) bool { return g.License == entry.License })
+		if i == -1 {
+			groups = append(groups, Group{License: entry.License})
+			i = len(groups) - 1
+		}
+
+		groups[i].Packages = append(groups[i].Packages, entry)
+	}
+
+	slices.SortFunc(groups, func(a, b Group) int {
+		if (a.License == UnknownLicense) != (b.License == UnknownLicense) {
+			if a.License == UnknownLicense {
+				return 1
+			}
+			return -1
+		}
+		return strings.Compare(strings.ToLower(a.License), strings.ToLower(b.License))
+	})
+
+	return groups
+}
+
+// dirs returns the directories of the manifests using the package.
+func (e Entry) dirs() []string {
+	var dirs []string
+
+	for _, manifest := range e.Manifests {
+		if dir := filepath.Dir(manifest); !slices.Contains(dirs, dir) {
+			dirs = append(dirs, dir)
+		}
+	}
+
+	return dirs
+}
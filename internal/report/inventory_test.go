@@ -0,0 +1,180 @@
+package report
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/depshubhq/depshub/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func testManifests() ([]types.Manifest, types.PackagesInfo) {
+	manifests := []types.Manifest{
+		{
+			Manager: types.Npm,
+			Path:    "web/package.json",
+			Dependencies: []types.Dependency{
+				{Manager: types.Npm, Name: "react", Version: "18.3.1"},
+				{Manager: types.Npm, Name: "left-pad", Version: "1.3.0", Dev: true},
+				{Manager: types.Npm, Name: "mystery", Version: "0.1.0"},
+			},
+		},
+		{
+			Manager: types.Npm,
+			Path:    "api/package.json",
+			Dependencies: []types.Dependency{
+				{Manager: types.Npm, Name: "left-pad", Version: "1.3.0"},
+			},
+		},
+		{
+			Manager: types.Go,
+			Path:    "go.mod",
+			Dependencies: []types.Dependency{
+				{Manager: types.Go, Name: "github.com/pkg/errors", Version: "v0.9.1"},
+			},
+		},
+	}
+
+	packages := types.PackagesInfo{
+		"npm:react":                    {License: "MIT"},
+		"npm:left-pad":                 {License: "WTFPL", Versions: map[string]types.PackageVersion{"1.3.0": {License: "MIT OR Apache 2.0"}}},
+		"golang:github.com/pkg/errors": {License: "BSD-2-Clause"},
+	}
+
+	return manifests, packages
+}
+
+func TestInventory(t *testing.T) {
+	manifests, packages := testManifests()
+
+	entries := Inventory(manifests, packages)
+
+	assert.Equal(t, []Entry{
+		{Ecosystem: "golang", Name: "github.com/pkg/errors", Version: "v0.9.1", License: "BSD-2-Clause", Manifests: []string{"go.mod"}, manager: types.Go},
+		{Ecosystem: "npm", Name: "left-pad", Version: "1.3.0", License: "MIT OR Apache-2.0", Manifests: []string{"web/package.json", "api/package.json"}, manager: types.Npm},
+		{Ecosystem: "npm", Name: "mystery", Version: "0.1.0", License: UnknownLicense, Manifests: []string{"web/package.json"}, manager: types.Npm},
+		{Ecosystem: "npm", Name: "react", Version: "18.3.1", License: "MIT", Manifests: []string{"web/package.json"}, manager: types.Npm},
+	}, entries)
+
+	groups := GroupByLicense(entries)
+
+	licenses := []string{}
+	for _, group := range groups {
+		licenses = append(licenses, group.License)
+	}
+	assert.Equal(t, []string{"BSD-2-Clause", "MIT", "MIT OR Apache-2.0", UnknownLicense}, licenses)
+}
+
+func TestNormalizeLicense(t *testing.T) {
+	tests := []struct {
+		license  string
+		expected string
+		text     string
+	}{
+		{license: " Apache 2.0 ", expected: "Apache-2.0"},
+		{license: "", expected: UnknownLicense},
+		// License texts are named after their first line when it's a license name
+		{license: "MIT License\n\nCopyright (c) Custom", expected: "MIT", text: "MIT License\n\nCopyright (c) Custom"},
+		{license: "Copyright (c) Custom\n\nAll rights reserved.", expected: UnknownLicense, text: "Copyright (c) Custom\n\nAll rights reserved."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.license, func(t *testing.T) {
+			license, text := normalizeLicense(tt.license)
+			assert.Equal(t, tt.expected, license)
+			assert.Equal(t, tt.text, text)
+		})
+	}
+}
+
+func TestWrite(t *testing.T) {
+	manifests, packages := testManifests()
+	groups := GroupByLicense(Inventory(manifests, packages))
+
+	tests := []struct {
+		format   string
+		expected string
+	}{
+		{
+			format: "text",
+			expected: `BSD-2-Clause (1)
+  - github.com/pkg/errors v0.9.1 (golang)
+
+MIT (1)
+  - react 18.3.1 (npm)
+
+MIT OR Apache-2.0 (1)
+  - left-pad 1.3.0 (npm)
+
+Unknown (1)
+  - mystery 0.1.0 (npm)
+`,
+		},
+		{
+			format: "csv",
+			expected: `license,ecosystem,name,version,dev
+BSD-2-Clause,golang,github.com/pkg/errors,v0.9.1,false
+MIT,npm,react,18.3.1,false
+MIT OR Apache-2.0,npm,left-pad,1.3.0,false
+Unknown,npm,mystery,0.1.0,false
+`,
+		},
+		{
+			format: "markdown",
+			expected: `# Licenses
+
+## BSD-2-Clause
+
+| Package | Version | Ecosystem |
+| ------- | ------- | --------- |
+| github.com/pkg/errors | v0.9.1 | golang |
+
+## MIT
+
+| Package | Version | Ecosystem |
+| ------- | ------- | --------- |
+| react | 18.3.1 | npm |
+
+## MIT OR Apache-2.0
+
+| Package | Version | Ecosystem |
+| ------- | ------- | --------- |
+| left-pad | 1.3.0 | npm |
+
+## Unknown
+
+| Package | Version | Ecosystem |
+| ------- | ------- | --------- |
+| mystery | 0.1.0 | npm |
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			var buf bytes.Buffer
+			assert.NoError(t, Write(&buf, tt.format, groups))
+			assert.Equal(t, tt.expected, buf.String())
+		})
+	}
+
+	t.Run("json", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, Write(&buf, "json", groups[:1]))
+		assert.JSONEq(t, `[{
+			"license": "BSD-2-Clause",
+			"packages": [{
+				"ecosystem": "golang",
+				"name": "github.com/pkg/errors",
+				"version": "v0.9.1",
+				"license": "BSD-2-Clause",
+				"dev": false,
+				"manifests": ["go.mod"]
+			}]
+		}]`, buf.String())
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		assert.Error(t, Write(&bytes.Buffer{}, "xml", groups))
+	})
+}
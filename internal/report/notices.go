@@ -0,0 +1,216 @@
+package report
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/depshubhq/depshub/pkg/licenses"
+	"github.com/depshubhq/depshub/pkg/types"
+	"golang.org/x/mod/module"
+)
+
+const separator = "================================================================================"
+
+// WriteNotices writes the third-party notices of the entries, with the license
+// texts found in the installed packages. The packages that aren't installed
+// use the license text of the registry or of the SPDX license list, otherwise
+// the SPDX page of the license is linked.
+func WriteNotices(w io.Writer, entries []Entry) error {
+	fmt.Fprintln(w, "THIRD-PARTY SOFTWARE NOTICES")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "This project uses the following third-party packages. Their licenses are listed below.")
+
+	for _, entry := range entries {
+		fmt.Fprintf(w, "\n%s\n%s %s (%s)\nLicense: %s\n%s\n\n", separator, entry.Name, version(entry), entry.Ecosystem, entry.License, separator)
+
+		if text := LicenseText(entry); text != "" {
+			fmt.Fprintln(w, strings.TrimSpace(text))
+			continue
+		}
+
+		if entry.text != "" {
+			fmt.Fprintln(w, entry.text)
+			continue
+		}
+
+		if text := spdxText(entry.License); text != "" {
+			fmt.Fprintln(w, text)
+			continue
+		}
+
+		if entry.License == UnknownLicense {
+			fmt.Fprintln(w, "The license of this package is unknown.")
+			continue
+		}
+
+		fmt.Fprintf(w, "The license text wasn't found. See %s.\n", licenseURL(entry.License))
+	}
+
+	return nil
+}
+
+// spdxText returns the texts of the licenses of an expression, when they are
+// all embedded.
+func spdxText(license string) string {
+	if license == UnknownLicense {
+		return ""
+	}
+
+	var texts []string
+	for _, l := range licenses.ParseOrUnknown(license).Licenses() {
+		text, ok := licenses.Text(l.ID)
+		if !l.Known || !ok {
+			return ""
+		}
+
+		if text = strings.TrimSpace(text); !slices.Contains(texts, text) {
+			texts = append(texts, text)
+		}
+	}
+
+	return strings.Join(texts, "\n\n")
+}
+
+// licenseURL links the SPDX page of single licenses, and the list for expressions.
+func licenseURL(license string) string {
+	if strings.ContainsAny(license, " ()") {
+		return "https://spdx.org/licenses/"
+	}
+	return fmt.Sprintf("https://spdx.org/licenses/%s.html", license)
+}
+
+// LicenseText returns the license files of an installed package, from
+// node_modules, vendor directories or the module caches of Go and Cargo.
+func LicenseText(entry Entry) string {
+	for _, dir := range packageDirs(entry) {
+		if text := readLicenseFiles(dir); text != "" {
+			return text
+		}
+	}
+
+	return ""
+}
+
+// packageDirs returns the directories the package could be installed in.
+func packageDirs(entry Entry) []string {
+	var dirs []string
+
+	for _, dir := range entry.dirs() {
+		// Packages are installed next to the manifest or in a parent directory, like in workspaces
+		for _, parent := range parents(dir) {
+			switch entry.manager {
+			case types.Npm:
+				dirs = append(dirs, filepath.Join(parent, "node_modules", filepath.FromSlash(entry.Name)))
+			case types.Go, types.Composer:
+				dirs = append(dirs, filepath.Join(parent, "vendor", filepath.FromSlash(entry.Name)))
+			case types.Bundler:
+				dirs = append(dirs, glob(filepath.Join(parent, "vendor", "bundle", "ruby", "*", "gems", entry.Name+"-"+entry.Version))...)
+			case types.Pip, types.Pyproject, types.Pipfile:
+				for _, venv := range []string{".venv", "venv"} {
+					distInfo := glob(filepath.Join(parent, venv, "lib", "*", "site-packages", pythonDistName(entry.Name)+"-"+entry.Version+".dist-info"))
+					for _, d := range distInfo {
+						dirs = append(dirs, filepath.Join(d, "licenses"), d)
+					}
+				}
+			}
+		}
+	}
+
+	switch entry.manager {
+	case types.Go:
+		if path, err := module.EscapePath(entry.Name); err == nil {
+			if version, err := module.EscapeVersion(entry.Version); err == nil {
+				dirs = append(dirs, filepath.Join(goModCache(), path+"@"+version))
+			}
+		}
+	case types.Cargo:
+		dirs = append(dirs, glob(filepath.Join(cargoHome(), "registry", "src", "*", entry.Name+"-"+entry.Version))...)
+	}
+
+	return dirs
+}
+
+func parents(dir string) []string {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil
+	}
+
+	var dirs []string
+	for {
+		dirs = append(dirs, dir)
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return dirs
+		}
+		dir = parent
+	}
+}
+
+func glob(pattern string) []string {
+	matches, _ := filepath.Glob(pattern)
+	return matches
+}
+
+// pythonDistName returns the name of the dist-info directories, which use underscores.
+func pythonDistName(name string) string {
+	return strings.NewReplacer("-", "_", ".", "_").Replace(name)
+}
+
+func goModCache() string {
+	if dir := os.Getenv("GOMODCACHE"); dir != "" {
+		return dir
+	}
+
+	if gopath := os.Getenv("GOPATH"); gopath != "" {
+		return filepath.Join(filepath.SplitList(gopath)[0], "pkg", "mod")
+	}
+
+	home, _ := os.UserHomeDir()
+	return filepath.Join(home, "go", "pkg", "mod")
+}
+
+func cargoHome() string {
+	if dir := os.Getenv("CARGO_HOME"); dir != "" {
+		return dir
+	}
+
+	home, _ := os.UserHomeDir()
+	return filepath.Join(home, ".cargo")
+}
+
+// readLicenseFiles reads the files like LICENSE, LICENSE-MIT or COPYING.md of a directory.
+func readLicenseFiles(dir string) string {
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return ""
+	}
+
+	var names []string
+	for _, file := range files {
+		name := strings.ToLower(file.Name())
+
+		if file.Type().IsRegular() && (strings.HasPrefix(name, "license") || strings.HasPrefix(name, "licence") || strings.HasPrefix(name, "copying")) {
+			names = append(names, file.Name())
+		}
+	}
+
+	slices.Sort(names)
+
+	var texts []string
+	for _, name := range names {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			continue
+		}
+
+		texts = append(texts, strings.TrimSpace(string(data)))
+	}
+
+	return strings.Join(texts, "\n\n")
+}
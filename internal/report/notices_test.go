@@ -0,0 +1,95 @@
+package report
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/depshubhq/depshub/pkg/licenses"
+	"github.com/depshubhq/depshub/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLicenseText(t *testing.T) {
+	// Packages are found in the node_modules of parent directories
+	manifest := filepath.Join("testdata", "app", "packages", "ui", "package.json")
+
+	scoped := Entry{Name: "@scope/pkg", Version: "1.0.0", Manifests: []string{manifest}, manager: types.Npm}
+	assert.Equal(t, "MIT License\n\nCopyright (c) Scope", LicenseText(scoped))
+
+	// Multiple license files are joined
+	dual := Entry{Name: "left-pad", Version: "1.3.0", Manifests: []string{manifest}, manager: types.Npm}
+	assert.Equal(t, "Apache License\nVersion 2.0\n\nCopyright (c) Left Pad", LicenseText(dual))
+
+	vendored := Entry{Name: "github.com/pkg/errors", Version: "v0.9.1", Manifests: []string{filepath.Join("testdata", "go.mod")}, manager: types.Go}
+	assert.Equal(t, "BSD 2-Clause License", LicenseText(vendored))
+
+	t.Setenv("GOMODCACHE", t.TempDir())
+	missing := Entry{Name: "github.com/pkg/missing", Version: "v1.0.0", Manifests: []string{filepath.Join("testdata", "go.mod")}, manager: types.Go}
+	assert.Empty(t, LicenseText(missing))
+}
+
+func TestSPDXText(t *testing.T) {
+	mit, _ := licenses.Text("MIT")
+	apache, _ := licenses.Text("Apache-2.0")
+
+	assert.Equal(t, strings.TrimSpace(mit), spdxText("MIT"))
+	assert.Equal(t, strings.TrimSpace(mit)+"\n\n"+strings.TrimSpace(apache), spdxText("MIT OR Apache-2.0"))
+
+	// Expressions are only written when all their texts are known
+	assert.Empty(t, spdxText("MIT OR CPAL-1.0"))
+	assert.Empty(t, spdxText("Custom terms"))
+	assert.Empty(t, spdxText(UnknownLicense))
+}
+
+func TestWriteNotices(t *testing.T) {
+	manifest := filepath.Join("testdata", "app", "package.json")
+
+	entries := []Entry{
+		{Ecosystem: "npm", Name: "@scope/pkg", Version: "1.0.0", License: "MIT", Manifests: []string{manifest}, manager: types.Npm},
+		{Ecosystem: "npm", Name: "react", Version: "18.3.1", License: "CPAL-1.0", Manifests: []string{manifest}, manager: types.Npm},
+		{Ecosystem: "pypi", Name: "attrs", Version: "24.2.0", License: "MIT", Manifests: []string{manifest}, manager: types.Pip, text: "MIT License\n\nCopyright (c) 2015 Hynek Schlawack"},
+		{Ecosystem: "npm", Name: "mystery", Version: "0.1.0", License: UnknownLicense, Manifests: []string{manifest}, manager: types.Npm},
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, WriteNotices(&buf, entries))
+
+	assert.Equal(t, `THIRD-PARTY SOFTWARE NOTICES
+
+This project uses the following third-party packages. Their licenses are listed below.
+
+================================================================================
+@scope/pkg 1.0.0 (npm)
+License: MIT
+================================================================================
+
+MIT License
+
+Copyright (c) Scope
+
+================================================================================
+react 18.3.1 (npm)
+License: CPAL-1.0
+================================================================================
+
+The license text wasn't found. See https://spdx.org/licenses/CPAL-1.0.html.
+
+================================================================================
+attrs 24.2.0 (pypi)
+License: MIT
+================================================================================
+
+MIT License
+
+Copyright (c) 2015 Hynek Schlawack
+
+================================================================================
+mystery 0.1.0 (npm)
+License: Unknown
+================================================================================
+
+The license of this package is unknown.
+`, buf.String())
+}
@@ -0,0 +1,179 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+// WhyFormats are the output formats of the dependency paths.
+var WhyFormats = []string{"text", "json"}
+
+// PathPackage is a package of a dependency path.
+type PathPackage struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// DependencyPath is a path from a direct dependency of a manifest to a package.
+type DependencyPath struct {
+	Manifest  string `json:"manifest"`
+	Ecosystem string `json:"ecosystem"`
+	// Dev is true when the direct dependency is only used for development
+	Dev bool `json:"dev"`
+	// The packages from the direct dependency to the package
+	Packages []PathPackage `json:"path"`
+}
+
+// ParseQuery splits a name[@version] query. Scoped npm packages start with @.
+func ParseQuery(query string) (name string, version string) {
+	if i := strings.LastIndex(query, "@"); i > 0 {
+		return query[:i], query[i+1:]
+	}
+
+	return query, ""
+}
+
+// MaxPaths is the number of paths listed per package, since it grows
+// exponentially with the packages sharing dependencies.
+const MaxPaths = 1000
+
+// Why returns every path from a direct dependency to the packages with the
+// name, and the version when it isn't empty. Manifests without a dependency
+// graph only have paths to their direct dependencies. The ecosystem is a
+// package URL type like npm, or empty for all the ecosystems. Only the first
+// MaxPaths paths to a package are returned, and the IDs of the packages with
+// more paths are returned as truncated.
+func Why(manifests []types.Manifest, name string, version string, ecosystem string) (paths []DependencyPath, truncated []string) {
+	matches := func(manager types.ManagerType, n string, v string) bool {
+		if ecosystem != "" && !strings.EqualFold(ecosystem, manager.Ecosystem()) {
+			return false
+		}
+
+		return strings.EqualFold(n, name) && (version == "" || v == version)
+	}
+
+	for _, manifest := range manifests {
+		roots := make(map[string]bool)
+
+		if manifest.Graph != nil {
+			for _, id := range manifest.Graph.Roots {
+				roots[strings.ToLower(manifest.Graph.Nodes[id].Name)] = true
+			}
+
+			for _, node := range sortedNodes(manifest.Graph) {
+				if !matches(node.Manager, node.Name, node.Version) {
+					continue
+				}
+
+				ids := manifest.Graph.Paths(node.ID(), MaxPaths+1)
+				if len(ids) > MaxPaths {
+					ids = ids[:MaxPaths]
+					if !slices.Contains(truncated, node.ID()) {
+						truncated = append(truncated, node.ID())
+					}
+				}
+
+				for _, ids := range ids {
+					path := DependencyPath{
+						Manifest:  manifest.Path,
+						Ecosystem: node.Manager.Ecosystem(),
+						Dev:       manifest.Graph.Nodes[ids[0]].IntroducedBy.Dev,
+					}
+
+					for _, id := range ids {
+						pkg := manifest.Graph.Nodes[id]
+						path.Packages = append(path.Packages, PathPackage{Name: pkg.Name, Version: pkg.Version})
+					}
+
+					paths = append(paths, path)
+				}
+			}
+		}
+
+		// Direct dependencies missing from the lockfile
+		for _, dep := range manifest.Dependencies {
+			if roots[strings.ToLower(dep.Name)] || !matches(dep.Manager, dep.Name, dep.Version) {
+				continue
+			}
+
+			paths = append(paths, DependencyPath{
+				Manifest:  manifest.Path,
+				Ecosystem: dep.Manager.Ecosystem(),
+				Dev:       dep.Dev,
+				Packages:  []PathPackage{{Name: dep.Name, Version: dep.Version}},
+			})
+		}
+	}
+
+	return paths, truncated
+}
+
+// WriteWhy writes the dependency paths of the package in one of the WhyFormats.
+func WriteWhy(w io.Writer, format string, query string, paths []DependencyPath) error {
+	switch format {
+	case "text":
+		return writeWhyText(w, query, paths)
+	case "json":
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+
+		if paths == nil {
+			paths = []DependencyPath{}
+		}
+
+		return encoder.Encode(paths)
+	}
+
+	return fmt.Errorf("unknown format %q, use one of %s", format, strings.Join(WhyFormats, ", "))
+}
+
+func writeWhyText(w io.Writer, query string, paths []DependencyPath) error {
+	if len(paths) == 0 {
+		fmt.Fprintf(w, "%s isn't used by the project\n", query)
+		return nil
+	}
+
+	var previous DependencyPath
+
+	for i, path := range paths {
+		// Paths are grouped by manifest and ecosystem, which differ for Deno
+		if i == 0 || path.Manifest != previous.Manifest || path.Ecosystem != previous.Ecosystem {
+			if i > 0 {
+				fmt.Fprintln(w)
+			}
+			fmt.Fprintf(w, "%s (%s)\n", path.Manifest, path.Ecosystem)
+		}
+		previous = path
+
+		var packages []string
+		for _, pkg := range path.Packages {
+			packages = append(packages, pkg.Name+"@"+pkg.Version)
+		}
+
+		line := "  " + strings.Join(packages, " > ")
+		if path.Dev {
+			line += " (dev)"
+		}
+
+		fmt.Fprintln(w, line)
+	}
+
+	return nil
+}
+
+// sortedNodes returns the packages of the graph by depth and ID, so the paths
+// to the closest packages come first.
+func sortedNodes(g *types.Graph) []*types.Node {
+	var nodes []*types.Node
+
+	for _, id := range g.Roots {
+		nodes = append(nodes, g.Nodes[id])
+	}
+
+	return append(nodes, g.Transitive()...)
+}
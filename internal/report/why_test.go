@@ -0,0 +1,189 @@
+package report
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/depshubhq/depshub/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseQuery(t *testing.T) {
+	tests := []struct {
+		query   string
+		name    string
+		version string
+	}{
+		{"react", "react", ""},
+		{"react@18.3.1", "react", "18.3.1"},
+		{"@babel/core", "@babel/core", ""},
+		{"@babel/core@7.26.0", "@babel/core", "7.26.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			name, version := ParseQuery(tt.query)
+			assert.Equal(t, tt.name, name)
+			assert.Equal(t, tt.version, version)
+		})
+	}
+}
+
+func whyManifests() []types.Manifest {
+	react := types.Dependency{Manager: types.Npm, Name: "react", Version: "18.3.1"}
+	eslint := types.Dependency{Manager: types.Npm, Name: "eslint", Version: "9.0.0", Dev: true}
+
+	return []types.Manifest{
+		{
+			Manager:      types.Npm,
+			Path:         "package.json",
+			Dependencies: []types.Dependency{react, eslint},
+			Graph: types.NewGraph([]types.Dependency{react, eslint}, []types.LockedPackage{
+				{Manager: types.Npm, Name: "react", Version: "18.3.1", Dependencies: []string{"loose-envify@1.4.0"}},
+				{Manager: types.Npm, Name: "loose-envify", Version: "1.4.0", Dependencies: []string{"js-tokens@4.0.0"}},
+				{Manager: types.Npm, Name: "js-tokens", Version: "4.0.0"},
+				{Manager: types.Npm, Name: "js-tokens", Version: "3.0.2"},
+				{Manager: types.Npm, Name: "eslint", Version: "9.0.0", Dependencies: []string{"js-tokens@3.0.2"}},
+			}),
+		},
+		// Without a lockfile, only the direct dependencies are known
+		{
+			Manager:      types.Npm,
+			Path:         "tools/package.json",
+			Dependencies: []types.Dependency{{Manager: types.Npm, Name: "js-tokens", Version: "4.0.0"}},
+		},
+		{
+			Manager:      types.Cargo,
+			Path:         "Cargo.toml",
+			Dependencies: []types.Dependency{{Manager: types.Cargo, Name: "js-tokens", Version: "0.1.0"}},
+		},
+	}
+}
+
+func TestWhy(t *testing.T) {
+	manifests := whyManifests()
+
+	paths, truncated := Why(manifests, "js-tokens", "", "npm")
+	assert.Empty(t, truncated)
+	assert.Equal(t, []DependencyPath{
+		{
+			Manifest:  "package.json",
+			Ecosystem: "npm",
+			Dev:       true,
+			Packages:  []PathPackage{{"eslint", "9.0.0"}, {"js-tokens", "3.0.2"}},
+		},
+		{
+			Manifest:  "package.json",
+			Ecosystem: "npm",
+			Packages:  []PathPackage{{"react", "18.3.1"}, {"loose-envify", "1.4.0"}, {"js-tokens", "4.0.0"}},
+		},
+		{
+			Manifest:  "tools/package.json",
+			Ecosystem: "npm",
+			Packages:  []PathPackage{{"js-tokens", "4.0.0"}},
+		},
+	}, paths)
+
+	paths, _ = Why(manifests, "js-tokens", "3.0.2", "")
+	assert.Len(t, paths, 1)
+	paths, _ = Why(manifests, "js-tokens", "", "")
+	assert.Len(t, paths, 4)
+	paths, _ = Why(manifests, "react", "", "")
+	assert.Len(t, paths, 1)
+	paths, _ = Why(manifests, "left-pad", "", "")
+	assert.Empty(t, paths)
+}
+
+func TestWhy_AllPaths(t *testing.T) {
+	app := types.Dependency{Manager: types.Npm, Name: "app", Version: "1.0.0"}
+
+	// Both the paths through left and right lead to shared
+	manifests := []types.Manifest{
+		{
+			Manager:      types.Npm,
+			Path:         "package.json",
+			Dependencies: []types.Dependency{app},
+			Graph: types.NewGraph([]types.Dependency{app}, []types.LockedPackage{
+				{Manager: types.Npm, Name: "app", Version: "1.0.0", Dependencies: []string{"left@1.0.0", "right@1.0.0"}},
+				{Manager: types.Npm, Name: "left", Version: "1.0.0", Dependencies: []string{"shared@1.0.0"}},
+				{Manager: types.Npm, Name: "right", Version: "1.0.0", Dependencies: []string{"shared@1.0.0"}},
+				{Manager: types.Npm, Name: "shared", Version: "1.0.0"},
+			}),
+		},
+	}
+
+	paths, truncated := Why(manifests, "shared", "", "")
+	assert.Equal(t, []DependencyPath{
+		{
+			Manifest:  "package.json",
+			Ecosystem: "npm",
+			Packages:  []PathPackage{{"app", "1.0.0"}, {"left", "1.0.0"}, {"shared", "1.0.0"}},
+		},
+		{
+			Manifest:  "package.json",
+			Ecosystem: "npm",
+			Packages:  []PathPackage{{"app", "1.0.0"}, {"right", "1.0.0"}, {"shared", "1.0.0"}},
+		},
+	}, paths)
+	assert.Empty(t, truncated)
+}
+
+func TestWhy_MaxPaths(t *testing.T) {
+	// 10 diamonds in a row, with 1024 paths from app to the last package
+	packages := []types.LockedPackage{{Manager: types.Npm, Name: "app", Version: "1.0.0", Dependencies: []string{"top0@1.0.0"}}}
+	for i := 0; i < 10; i++ {
+		next := fmt.Sprintf("top%d@1.0.0", i+1)
+		packages = append(packages,
+			types.LockedPackage{Manager: types.Npm, Name: fmt.Sprintf("top%d", i), Version: "1.0.0", Dependencies: []string{fmt.Sprintf("left%d@1.0.0", i), fmt.Sprintf("right%d@1.0.0", i)}},
+			types.LockedPackage{Manager: types.Npm, Name: fmt.Sprintf("left%d", i), Version: "1.0.0", Dependencies: []string{next}},
+			types.LockedPackage{Manager: types.Npm, Name: fmt.Sprintf("right%d", i), Version: "1.0.0", Dependencies: []string{next}},
+		)
+	}
+	packages = append(packages, types.LockedPackage{Manager: types.Npm, Name: "top10", Version: "1.0.0"})
+
+	app := types.Dependency{Manager: types.Npm, Name: "app", Version: "1.0.0"}
+	manifests := []types.Manifest{
+		{
+			Manager:      types.Npm,
+			Path:         "package.json",
+			Dependencies: []types.Dependency{app},
+			Graph:        types.NewGraph([]types.Dependency{app}, packages),
+		},
+	}
+
+	paths, truncated := Why(manifests, "top10", "", "")
+	assert.Len(t, paths, MaxPaths)
+	assert.Equal(t, []string{"top10@1.0.0"}, truncated)
+
+	paths, truncated = Why(manifests, "top9", "", "")
+	assert.Len(t, paths, 512)
+	assert.Empty(t, truncated)
+}
+
+func TestWriteWhy(t *testing.T) {
+	paths, _ := Why(whyManifests(), "js-tokens", "", "")
+
+	var text bytes.Buffer
+	assert.NoError(t, WriteWhy(&text, "text", "js-tokens", paths))
+	assert.Equal(t, `package.json (npm)
+  eslint@9.0.0 > js-tokens@3.0.2 (dev)
+  react@18.3.1 > loose-envify@1.4.0 > js-tokens@4.0.0
+
+tools/package.json (npm)
+  js-tokens@4.0.0
+
+Cargo.toml (cargo)
+  js-tokens@0.1.0
+`, text.String())
+
+	var empty bytes.Buffer
+	assert.NoError(t, WriteWhy(&empty, "text", "left-pad", nil))
+	assert.Equal(t, "left-pad isn't used by the project\n", empty.String())
+
+	var json bytes.Buffer
+	assert.NoError(t, WriteWhy(&json, "json", "left-pad", nil))
+	assert.Equal(t, "[]\n", json.String())
+
+	assert.Error(t, WriteWhy(&json, "xml", "left-pad", nil))
+}
@@ -0,0 +1,149 @@
+package sbom
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+)
+
+// The bom-ref of the project in CycloneDX documents
+const cycloneDXRoot = "project"
+
+type cycloneDXDocument struct {
+	BOMFormat    string                `json:"bomFormat"`
+	SpecVersion  string                `json:"specVersion"`
+	SerialNumber string                `json:"serialNumber"`
+	Version      int                   `json:"version"`
+	Metadata     cycloneDXMetadata     `json:"metadata"`
+	Components   []cycloneDXComponent  `json:"components"`
+	Dependencies []cycloneDXDependency `json:"dependencies"`
+}
+
+type cycloneDXMetadata struct {
+	Timestamp string `json:"timestamp"`
+	Tools     struct {
+		Components []cycloneDXComponent `json:"components"`
+	} `json:"tools"`
+	Component cycloneDXComponent `json:"component"`
+}
+
+type cycloneDXComponent struct {
+	Type       string              `json:"type"`
+	BOMRef     string              `json:"bom-ref,omitempty"`
+	Name       string              `json:"name"`
+	Version    string              `json:"version,omitempty"`
+	Scope      string              `json:"scope,omitempty"`
+	Hashes     []cycloneDXHash     `json:"hashes,omitempty"`
+	Licenses   []cycloneDXLicense  `json:"licenses,omitempty"`
+	PURL       string              `json:"purl,omitempty"`
+	Properties []cycloneDXProperty `json:"properties,omitempty"`
+	Evidence   *cycloneDXEvidence  `json:"evidence,omitempty"`
+	// Nested components, like the packages of an image
+	Components []cycloneDXComponent `json:"components,omitempty"`
+}
+
+type cycloneDXHash struct {
+	Algorithm string `json:"alg"`
+	Content   string `json:"content"`
+}
+
+// cycloneDXLicense is either an SPDX expression or a license name.
+type cycloneDXLicense struct {
+	Expression string                `json:"expression,omitempty"`
+	License    *cycloneDXLicenseName `json:"license,omitempty"`
+}
+
+type cycloneDXLicenseName struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+type cycloneDXProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type cycloneDXEvidence struct {
+	Occurrences []cycloneDXOccurrence `json:"occurrences"`
+}
+
+type cycloneDXOccurrence struct {
+	Location string `json:"location"`
+}
+
+type cycloneDXDependency struct {
+	Ref       string   `json:"ref"`
+	DependsOn []string `json:"dependsOn"`
+}
+
+// writeCycloneDX writes the document in the CycloneDX 1.5 JSON format.
+// https://cyclonedx.org/docs/1.5/json/
+func writeCycloneDX(w io.Writer, doc Document) error {
+	result := cycloneDXDocument{
+		BOMFormat:    "CycloneDX",
+		SpecVersion:  "1.5",
+		SerialNumber: "urn:uuid:" + doc.Serial,
+		Version:      1,
+		Components:   []cycloneDXComponent{},
+		Dependencies: []cycloneDXDependency{{Ref: cycloneDXRoot, DependsOn: doc.Dependencies}},
+	}
+
+	result.Metadata.Timestamp = doc.Timestamp.Format(time.RFC3339)
+	result.Metadata.Tools.Components = []cycloneDXComponent{{Type: "application", Name: "depshub", Version: doc.ToolVersion}}
+	result.Metadata.Component = cycloneDXComponent{Type: "application", BOMRef: cycloneDXRoot, Name: doc.Name}
+
+	if result.Dependencies[0].DependsOn == nil {
+		result.Dependencies[0].DependsOn = []string{}
+	}
+
+	for _, c := range doc.Components {
+		component := cycloneDXComponent{
+			Type:    "library",
+			BOMRef:  c.PURL,
+			Name:    c.Name,
+			Version: c.Version,
+			Scope:   "required",
+			PURL:    c.PURL,
+		}
+
+		// Development dependencies aren't needed at runtime
+		if c.Dev {
+			component.Scope = "optional"
+			component.Properties = append(component.Properties, cycloneDXProperty{Name: "depshub:dev", Value: "true"})
+		}
+
+		if !c.Direct {
+			component.Properties = append(component.Properties, cycloneDXProperty{Name: "depshub:transitive", Value: "true"})
+		}
+
+		for _, hash := range c.Hashes {
+			component.Hashes = append(component.Hashes, cycloneDXHash{Algorithm: hash.Algorithm, Content: hash.Value})
+		}
+
+		if expression, ok := spdxExpression(c.License); ok {
+			component.Licenses = []cycloneDXLicense{{Expression: expression}}
+		} else if c.License != "" {
+			component.Licenses = []cycloneDXLicense{{License: &cycloneDXLicenseName{Name: c.License}}}
+		}
+
+		component.Evidence = &cycloneDXEvidence{}
+		for _, manifest := range c.Manifests {
+			component.Evidence.Occurrences = append(component.Evidence.Occurrences, cycloneDXOccurrence{Location: manifest})
+		}
+
+		dependsOn := c.Dependencies
+		if dependsOn == nil {
+			dependsOn = []string{}
+		}
+
+		result.Components = append(result.Components, component)
+		result.Dependencies = append(result.Dependencies, cycloneDXDependency{Ref: c.PURL, DependsOn: dependsOn})
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	// Keep the & of package URL qualifiers
+	encoder.SetEscapeHTML(false)
+
+	return encoder.Encode(result)
+}
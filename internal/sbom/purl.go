@@ -0,0 +1,82 @@
+package sbom
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+// PURL returns the package URL of a package, like pkg:npm/%40babel/core@7.26.0.
+// https://github.com/package-url/purl-spec/blob/main/PURL-SPECIFICATION.rst
+func PURL(manager types.ManagerType, name string, version string) string {
+	ecosystem := manager.Ecosystem()
+	qualifiers := map[string]string{}
+	subpath := ""
+
+	switch ecosystem {
+	case "maven":
+		name = strings.Replace(name, ":", "/", 1)
+	case "pypi":
+		name = strings.ReplaceAll(strings.ToLower(name), "_", "-")
+	case "conda":
+		// Conda packages are named after their channel, like conda-forge/numpy
+		if i := strings.LastIndex(name, "/"); i != -1 {
+			qualifiers["channel"] = name[:i]
+			name = name[i+1:]
+		}
+	case "docker":
+		// Images of other registries than Docker Hub, like ghcr.io/org/app
+		if registry, image, ok := strings.Cut(name, "/"); ok && strings.ContainsAny(registry, ".:") {
+			qualifiers["repository_url"] = registry
+			name = image
+		}
+	case "github":
+		// Actions in a subdirectory of a repository, like github/codeql-action/init
+		if parts := strings.SplitN(name, "/", 3); len(parts) == 3 {
+			name = parts[0] + "/" + parts[1]
+			subpath = parts[2]
+		}
+	}
+
+	var segments []string
+	for _, segment := range strings.Split(name, "/") {
+		if segment != "" {
+			segments = append(segments, escape(segment))
+		}
+	}
+
+	purl := "pkg:" + ecosystem + "/" + strings.Join(segments, "/")
+
+	if version != "" {
+		purl += "@" + escape(version)
+	}
+
+	if len(qualifiers) > 0 {
+		keys := make([]string, 0, len(qualifiers))
+		for key := range qualifiers {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		var pairs []string
+		for _, key := range keys {
+			pairs = append(pairs, key+"="+escape(qualifiers[key]))
+		}
+		purl += "?" + strings.Join(pairs, "&")
+	}
+
+	if subpath != "" {
+		purl += "#" + subpath
+	}
+
+	return purl
+}
+
+// escape percent-encodes a segment of a package URL.
+func escape(segment string) string {
+	segment = url.PathEscape(segment)
+	segment = strings.ReplaceAll(segment, "@", "%40")
+	return strings.ReplaceAll(segment, ":", "%3A")
+}
@@ -0,0 +1,331 @@
+package sbom
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/depshubhq/depshub/pkg/manager/conda"
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+var ErrUnknownFormat = errors.New("unknown SBOM format, only CycloneDX and SPDX JSON documents are supported")
+
+// The managers of the package URL types
+var purlManagers = map[string]types.ManagerType{
+	"npm":       types.Npm,
+	"golang":    types.Go,
+	"cargo":     types.Cargo,
+	"pypi":      types.Pip,
+	"hex":       types.Hex,
+	"maven":     types.Maven,
+	"gem":       types.Bundler,
+	"composer":  types.Composer,
+	"nuget":     types.NuGet,
+	"github":    types.GitHubActions,
+	"docker":    types.Docker,
+	"terraform": types.Terraform,
+	"pub":       types.Pub,
+	"swift":     types.SwiftPM,
+	"helm":      types.Helm,
+	"conda":     types.Conda,
+	"jsr":       types.Deno,
+}
+
+// sbomPackage is a package of an SBOM.
+type sbomPackage struct {
+	purl    string
+	version string
+	dev     bool
+}
+
+// purlLocation is where a package URL is written in an SBOM.
+type purlLocation struct {
+	value   string
+	line    int
+	rawLine string
+}
+
+// Read returns the packages of a CycloneDX or SPDX JSON document as manifests,
+// one for each manager. Packages are resolved like in lockfiles, so the
+// manifests use the SBOM as their lockfile. Packages without a package URL, or
+// with a type that isn't supported like deb or apk, are skipped.
+func Read(path string) ([]types.Manifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var header struct {
+		BOMFormat   string `json:"bomFormat"`
+		SPDXVersion string `json:"spdxVersion"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+
+	var packages []sbomPackage
+	var key string
+
+	switch {
+	case header.BOMFormat == "CycloneDX":
+		key = "purl"
+		packages, err = readCycloneDX(data)
+	case header.SPDXVersion != "":
+		key = "referenceLocator"
+		packages, err = readSPDX(data)
+	default:
+		return nil, ErrUnknownFormat
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+
+	locations, err := purlLocations(data, key)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+
+	var manifests []types.Manifest
+	next := 0
+
+	for _, pkg := range packages {
+		// Packages are read in the order of the document
+		definition := types.Definition{Path: path}
+		if i := slices.IndexFunc(locations[next:], func(l purlLocation) bool { return l.value == pkg.purl }); i != -1 {
+			location := locations[next+i]
+			definition.Line = location.line
+			definition.RawLine = location.rawLine
+			next += i + 1
+		}
+
+		dep, ok := ParsePURL(pkg.purl)
+		if !ok {
+			continue
+		}
+
+		if dep.Version == "" {
+			dep.Version = pkg.version
+		}
+		dep.Dev = pkg.dev
+		dep.Definition = definition
+
+		i := slices.IndexFunc(manifests, func(m types.Manifest) bool { return m.Manager == dep.Manager })
+		if i == -1 {
+			manifests = append(manifests, types.Manifest{
+				Manager:  dep.Manager,
+				Path:     path,
+				Lockfile: &types.Lockfile{Path: path},
+			})
+			i = len(manifests) - 1
+		}
+
+		duplicate := slices.ContainsFunc(manifests[i].Dependencies, func(d types.Dependency) bool {
+			return d.Name == dep.Name && d.Version == dep.Version
+		})
+		if !duplicate {
+			manifests[i].Dependencies = append(manifests[i].Dependencies, dep)
+		}
+	}
+
+	// Components aren't listed in a meaningful order
+	for _, manifest := range manifests {
+		slices.SortStableFunc(manifest.Dependencies, func(a, b types.Dependency) int {
+			return strings.Compare(a.Name, b.Name)
+		})
+	}
+
+	return manifests, nil
+}
+
+func readCycloneDX(data []byte) ([]sbomPackage, error) {
+	var doc cycloneDXDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	var packages []sbomPackage
+
+	var walk func(components []cycloneDXComponent)
+	walk = func(components []cycloneDXComponent) {
+		for _, c := range components {
+			if c.PURL != "" {
+				dev := c.Scope == "optional" || c.Scope == "excluded"
+				for _, property := range c.Properties {
+					dev = dev || (property.Name == "depshub:dev" && property.Value == "true")
+				}
+
+				packages = append(packages, sbomPackage{purl: c.PURL, version: c.Version, dev: dev})
+			}
+
+			walk(c.Components)
+		}
+	}
+	walk(doc.Components)
+
+	return packages, nil
+}
+
+func readSPDX(data []byte) ([]sbomPackage, error) {
+	var doc spdxDoc
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+
+	dev := make(map[string]bool)
+	for _, relationship := range doc.Relationships {
+		if relationship.Type == "DEV_DEPENDENCY_OF" {
+			dev[relationship.Element] = true
+		}
+	}
+
+	var packages []sbomPackage
+
+	for _, pkg := range doc.Packages {
+		for _, ref := range pkg.ExternalRefs {
+			if ref.Type != "purl" {
+				continue
+			}
+
+			packages = append(packages, sbomPackage{purl: ref.Locator, version: pkg.VersionInfo, dev: dev[pkg.SPDXID]})
+		}
+	}
+
+	return packages, nil
+}
+
+// purlLocations returns the values of the key in the document, which are the
+// package URLs, with their line.
+func purlLocations(data []byte, key string) ([]purlLocation, error) {
+	var locations []purlLocation
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	previous := ""
+
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		value, ok := token.(string)
+		if !ok {
+			previous = ""
+			continue
+		}
+
+		if previous == key {
+			offset := int(decoder.InputOffset())
+			start := bytes.LastIndexByte(data[:offset], '\n') + 1
+			end := offset + bytes.IndexByte(data[offset:], '\n')
+			if end < offset {
+				end = len(data)
+			}
+
+			rawLine := strings.TrimSpace(string(data[start:end]))
+			// Minified documents are written on a single line
+			if len(rawLine) > 200 {
+				rawLine = fmt.Sprintf("%q: %q", key, value)
+			}
+
+			locations = append(locations, purlLocation{
+				value:   value,
+				line:    bytes.Count(data[:offset], []byte("\n")) + 1,
+				rawLine: rawLine,
+			})
+			value = ""
+		}
+
+		previous = value
+	}
+
+	return locations, nil
+}
+
+// ParsePURL returns the dependency of a package URL. It returns false when the
+// package URL isn't valid or its type isn't supported.
+func ParsePURL(purl string) (types.Dependency, bool) {
+	rest, ok := strings.CutPrefix(purl, "pkg:")
+	if !ok {
+		return types.Dependency{}, false
+	}
+
+	rest, subpath, _ := strings.Cut(rest, "#")
+	rest, rawQualifiers, _ := strings.Cut(rest, "?")
+
+	version := ""
+	if i := strings.LastIndex(rest, "@"); i > strings.LastIndex(rest, "/") {
+		rest, version = rest[:i], rest[i+1:]
+	}
+
+	purlType, path, ok := strings.Cut(strings.TrimLeft(rest, "/"), "/")
+	if !ok {
+		return types.Dependency{}, false
+	}
+
+	manager, ok := purlManagers[strings.ToLower(purlType)]
+	if !ok {
+		return types.Dependency{}, false
+	}
+
+	var segments []string
+	for _, segment := range strings.Split(path, "/") {
+		if segment == "" {
+			continue
+		}
+		if unescaped, err := url.PathUnescape(segment); err == nil {
+			segment = unescaped
+		}
+		segments = append(segments, segment)
+	}
+
+	if len(segments) == 0 {
+		return types.Dependency{}, false
+	}
+
+	if unescaped, err := url.PathUnescape(version); err == nil {
+		version = unescaped
+	}
+
+	qualifiers, _ := url.ParseQuery(rawQualifiers)
+	name := strings.Join(segments, "/")
+	dep := types.Dependency{Manager: manager, Version: version}
+
+	switch manager {
+	case types.Maven:
+		name = strings.Join(segments[:len(segments)-1], ".") + ":" + segments[len(segments)-1]
+	case types.Conda:
+		channel := qualifiers.Get("channel")
+		if channel == "" {
+			channel = conda.DefaultChannel
+		}
+		name = conda.PackageName(channel, name)
+	case types.Docker:
+		if registry := qualifiers.Get("repository_url"); registry != "" {
+			name = registry + "/" + name
+		}
+		// Images pinned to a digest have their tag in a qualifier
+		if strings.HasPrefix(version, "sha256:") {
+			dep.Digest = version
+			dep.Version = qualifiers.Get("tag")
+		}
+	case types.GitHubActions:
+		if subpath != "" {
+			name += "/" + strings.Trim(subpath, "/")
+		}
+	}
+
+	dep.Name = name
+
+	return dep, true
+}
@@ -0,0 +1,163 @@
+package sbom
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/depshubhq/depshub/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePURL(t *testing.T) {
+	tests := []struct {
+		purl     string
+		expected types.Dependency
+		ok       bool
+	}{
+		{"pkg:npm/%40babel/core@7.26.0", types.Dependency{Manager: types.Npm, Name: "@babel/core", Version: "7.26.0"}, true},
+		{"pkg:npm/@babel/core@7.26.0", types.Dependency{Manager: types.Npm, Name: "@babel/core", Version: "7.26.0"}, true},
+		{"pkg:maven/org.apache.logging.log4j/log4j-core@2.24.1?type=jar", types.Dependency{Manager: types.Maven, Name: "org.apache.logging.log4j:log4j-core", Version: "2.24.1"}, true},
+		{"pkg:pypi/requests", types.Dependency{Manager: types.Pip, Name: "requests"}, true},
+		{"pkg:conda/numpy@1.26.4?channel=conda-forge", types.Dependency{Manager: types.Conda, Name: "conda-forge/numpy", Version: "1.26.4"}, true},
+		{"pkg:conda/numpy@1.26.4", types.Dependency{Manager: types.Conda, Name: "defaults/numpy", Version: "1.26.4"}, true},
+		{
+			"pkg:docker/org/app@sha256%3Aabc?repository_url=ghcr.io&tag=1.0",
+			types.Dependency{Manager: types.Docker, Name: "ghcr.io/org/app", Version: "1.0", Digest: "sha256:abc"},
+			true,
+		},
+		{"pkg:github/github/codeql-action@v3#init", types.Dependency{Manager: types.GitHubActions, Name: "github/codeql-action/init", Version: "v3"}, true},
+		{"pkg:deb/debian/libc6@2.36-9", types.Dependency{}, false},
+		{"cpe:2.3:a:python:requests", types.Dependency{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.purl, func(t *testing.T) {
+			dep, ok := ParsePURL(tt.purl)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, dep)
+		})
+	}
+
+	// Package URLs are parsed back to the dependency they were built from
+	for _, dep := range []types.Dependency{
+		{Manager: types.Npm, Name: "@babel/core", Version: "7.26.0"},
+		{Manager: types.Maven, Name: "org.apache.logging.log4j:log4j-core", Version: "2.24.1"},
+		{Manager: types.Conda, Name: "https://conda.example.com/internal/numpy", Version: "1.26.4"},
+		{Manager: types.Go, Name: "github.com/pkg/errors", Version: "v0.9.1"},
+	} {
+		parsed, ok := ParsePURL(PURL(dep.Manager, dep.Name, dep.Version))
+		assert.True(t, ok)
+		assert.Equal(t, dep, parsed)
+	}
+}
+
+func TestRead(t *testing.T) {
+	t.Run("cyclonedx", func(t *testing.T) {
+		path := filepath.Join("testdata", "cyclonedx.json")
+
+		manifests, err := Read(path)
+		assert.NoError(t, err)
+
+		lockfile := &types.Lockfile{Path: path}
+
+		// Packages of other types, like deb, are skipped
+		assert.Equal(t, []types.Manifest{
+			{
+				Manager:  types.Npm,
+				Path:     path,
+				Lockfile: lockfile,
+				Dependencies: []types.Dependency{
+					{
+						Manager:    types.Npm,
+						Name:       "@babel/core",
+						Version:    "7.26.0",
+						Dev:        true,
+						Definition: types.Definition{Path: path, Line: 31, RawLine: `"purl": "pkg:npm/%40babel/core@7.26.0"`},
+					},
+					{
+						Manager:    types.Npm,
+						Name:       "lodash",
+						Version:    "4.17.21",
+						Definition: types.Definition{Path: path, Line: 50, RawLine: `"purl": "pkg:npm/lodash@4.17.21"`},
+					},
+					{
+						Manager:    types.Npm,
+						Name:       "react",
+						Version:    "18.3.1",
+						Definition: types.Definition{Path: path, Line: 23, RawLine: `"purl": "pkg:npm/react@18.3.1"`},
+					},
+				},
+			},
+			{
+				Manager:  types.Maven,
+				Path:     path,
+				Lockfile: lockfile,
+				Dependencies: []types.Dependency{
+					{
+						Manager:    types.Maven,
+						Name:       "org.apache.logging.log4j:log4j-core",
+						Version:    "2.14.1",
+						Definition: types.Definition{Path: path, Line: 42, RawLine: `"purl": "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1"`},
+					},
+				},
+			},
+		}, manifests)
+	})
+
+	t.Run("spdx", func(t *testing.T) {
+		path := filepath.Join("testdata", "spdx.json")
+
+		manifests, err := Read(path)
+		assert.NoError(t, err)
+
+		assert.Len(t, manifests, 2)
+		assert.Equal(t, types.Pip, manifests[0].Manager)
+		assert.Equal(t, []types.Dependency{
+			{
+				Manager:    types.Pip,
+				Name:       "pytest",
+				Version:    "8.3.3",
+				Dev:        true,
+				Definition: types.Definition{Path: path, Line: 32, RawLine: `"referenceLocator": "pkg:pypi/pytest"`},
+			},
+			{
+				Manager:    types.Pip,
+				Name:       "requests",
+				Version:    "2.32.3",
+				Definition: types.Definition{Path: path, Line: 20, RawLine: `"referenceLocator": "pkg:pypi/requests@2.32.3"`},
+			},
+		}, manifests[0].Dependencies)
+		assert.Equal(t, "github.com/pkg/errors", manifests[1].Dependencies[0].Name)
+	})
+
+	t.Run("written by depshub", func(t *testing.T) {
+		for _, format := range Formats {
+			path := filepath.Join(t.TempDir(), "sbom.json")
+
+			file, err := os.Create(path)
+			assert.NoError(t, err)
+			assert.NoError(t, Write(file, format, testDocument()))
+			assert.NoError(t, file.Close())
+
+			manifests, err := Read(path)
+			assert.NoError(t, err)
+			assert.Len(t, manifests, 1)
+
+			var names []string
+			for _, dep := range manifests[0].Dependencies {
+				names = append(names, dep.Name)
+			}
+			assert.Equal(t, []string{"eslint", "js-tokens", "react"}, names, format)
+			assert.True(t, manifests[0].Dependencies[0].Dev, format)
+		}
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "sbom.json")
+		assert.NoError(t, os.WriteFile(path, []byte(`{"name": "app"}`), 0o644))
+
+		_, err := Read(path)
+		assert.ErrorIs(t, err, ErrUnknownFormat)
+	})
+}
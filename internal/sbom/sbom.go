@@ -0,0 +1,251 @@
+// Package sbom exports the packages of a project as a software bill of
+// materials in the CycloneDX and SPDX formats.
+package sbom
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/depshubhq/depshub/pkg/licenses"
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+const (
+	CycloneDXJSON = "cyclonedx-json"
+	SPDXJSON      = "spdx-json"
+)
+
+// Formats are the supported SBOM formats.
+var Formats = []string{CycloneDXJSON, SPDXJSON}
+
+// Metadata describes the SBOM document.
+type Metadata struct {
+	// The name of the project
+	Name string
+	// The version of DepsHub
+	ToolVersion string
+	Timestamp   time.Time
+	// The UUID identifying the document
+	Serial string
+}
+
+// NewMetadata returns the metadata of a new document created now.
+func NewMetadata(name string, toolVersion string) Metadata {
+	// Versions are set when releases are built
+	if toolVersion == "" {
+		toolVersion = "dev"
+	}
+
+	return Metadata{
+		Name:        name,
+		ToolVersion: toolVersion,
+		Timestamp:   time.Now().UTC().Truncate(time.Second),
+		Serial:      newUUID(),
+	}
+}
+
+// Component is a package used by the project.
+type Component struct {
+	Manager types.ManagerType
+	Name    string
+	Version string
+	PURL    string
+	// The license of the used version, as an SPDX expression when it is known
+	License string
+	// Dev is true when the package is only used for development
+	Dev bool
+	// Direct is false for the packages only found in lockfiles
+	Direct bool
+	Hashes []types.Hash
+	// The manifests using the package
+	Manifests []string
+	// The PURLs of the components this component depends on, known from lockfiles
+	Dependencies []string
+}
+
+// Document is the bill of materials of a project.
+type Document struct {
+	Metadata
+	Components []Component
+	// The PURLs of the direct dependencies of the project
+	Dependencies []string
+}
+
+// New builds the document of the manifests. The transitive packages, their
+// hashes and the dependency graph come from the lockfiles read by the managers.
+func New(metadata Metadata, manifests []types.Manifest, packages types.PackagesInfo) Document {
+	doc := Document{Metadata: metadata}
+	index := make(map[string]int)
+
+	add := func(manager types.ManagerType, name, version string, dev bool, manifest string) *Component {
+		purl := PURL(manager, name, version)
+
+		i, ok := index[purl]
+		if !ok {
+			i = len(doc.Components)
+			index[purl] = i
+			doc.Components = append(doc.Components, Component{
+				Manager: manager,
+				Name:    name,
+				Version: version,
+				PURL:    purl,
+				License: license(packages, manager, name, version),
+				Dev:     dev,
+			})
+		}
+
+		c := &doc.Components[i]
+		c.Dev = c.Dev && dev
+		if !slices.Contains(c.Manifests, manifest) {
+			c.Manifests = append(c.Manifests, manifest)
+		}
+
+		return c
+	}
+
+	for _, manifest := range manifests {
+		graph := manifest.Graph
+		if graph == nil && manifest.Lockfile != nil {
+			graph = types.NewGraph(manifest.Dependencies, manifest.Lockfile.Packages)
+		}
+
+		for _, dep := range manifest.Dependencies {
+			// Dependencies with a version range get the version of their locked package
+			var root *types.Node
+			version := dep.Version
+			if graph != nil {
+				if node, ok := graph.Root(dep); ok {
+					root = node
+					version = node.Version
+				}
+			}
+
+			c := add(dep.Manager, dep.Name, version, dep.Dev, manifest.Path)
+			c.Direct = true
+
+			if !slices.Contains(doc.Dependencies, c.PURL) {
+				doc.Dependencies = append(doc.Dependencies, c.PURL)
+			}
+
+			// Container images are pinned to the digest of their manifest
+			if algorithm, value, ok := strings.Cut(dep.Digest, ":"); ok && algorithm == "sha256" {
+				c.Hashes = appendHash(c.Hashes, types.Hash{Algorithm: "SHA-256", Value: value})
+			}
+
+			if root == nil {
+				continue
+			}
+
+			// Walk the packages of the lockfile used by the dependency
+			queue := []*types.Node{root}
+			visited := map[string]bool{root.ID(): true}
+
+			for len(queue) > 0 {
+				node := queue[0]
+				queue = queue[1:]
+
+				c := add(node.Manager, node.Name, node.Version, dep.Dev, manifest.Path)
+				for _, hash := range node.Hashes {
+					c.Hashes = appendHash(c.Hashes, hash)
+				}
+
+				for _, id := range graph.Edges[node.ID()] {
+					child := graph.Nodes[id]
+
+					purl := PURL(child.Manager, child.Name, child.Version)
+					if !slices.Contains(c.Dependencies, purl) {
+						c.Dependencies = append(c.Dependencies, purl)
+					}
+
+					if !visited[id] {
+						visited[id] = true
+						queue = append(queue, child)
+					}
+				}
+			}
+		}
+	}
+
+	slices.SortFunc(doc.Components, func(a, b Component) int {
+		return strings.Compare(a.PURL, b.PURL)
+	})
+
+	slices.Sort(doc.Dependencies)
+	for i := range doc.Components {
+		slices.Sort(doc.Components[i].Dependencies)
+	}
+
+	return doc
+}
+
+// Write writes the document in one of the Formats.
+func Write(w io.Writer, format string, doc Document) error {
+	switch format {
+	case CycloneDXJSON:
+		return writeCycloneDX(w, doc)
+	case SPDXJSON:
+		return writeSPDX(w, doc)
+	}
+
+	return fmt.Errorf("unknown format %q, use one of %s", format, strings.Join(Formats, ", "))
+}
+
+func appendHash(hashes []types.Hash, hash types.Hash) []types.Hash {
+	if slices.Contains(hashes, hash) {
+		return hashes
+	}
+	return append(hashes, hash)
+}
+
+// license returns the normalized license of a package version, or an empty
+// string when it isn't known.
+func license(packages types.PackagesInfo, manager types.ManagerType, name string, version string) string {
+	pkg, ok := packages.Get(manager, name)
+	if !ok {
+		return ""
+	}
+
+	license := strings.TrimSpace(pkg.LicenseOf(version))
+
+	// Some registries return the whole license text
+	if license == "" || strings.Contains(license, "\n") {
+		return ""
+	}
+
+	return licenses.ParseOrUnknown(license).String()
+}
+
+// spdxExpression returns the license when all its licenses are SPDX identifiers.
+func spdxExpression(license string) (string, bool) {
+	if license == "" {
+		return "", false
+	}
+
+	expression, err := licenses.Parse(license)
+	if err != nil {
+		return "", false
+	}
+
+	for _, l := range expression.Licenses() {
+		if !l.Listed() {
+			return "", false
+		}
+	}
+
+	return expression.String(), true
+}
+
+// newUUID returns a random version 4 UUID.
+func newUUID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
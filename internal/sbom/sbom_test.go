@@ -0,0 +1,304 @@
+package sbom
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/depshubhq/depshub/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPURL(t *testing.T) {
+	tests := []struct {
+		manager  types.ManagerType
+		name     string
+		version  string
+		expected string
+	}{
+		{types.Npm, "@babel/core", "7.26.0", "pkg:npm/%40babel/core@7.26.0"},
+		{types.Npm, "react", "", "pkg:npm/react"},
+		{types.Go, "github.com/pkg/errors", "v0.9.1", "pkg:golang/github.com/pkg/errors@v0.9.1"},
+		{types.Maven, "org.apache.logging.log4j:log4j-core", "2.24.1", "pkg:maven/org.apache.logging.log4j/log4j-core@2.24.1"},
+		{types.Pipfile, "Django_Filter", "24.3", "pkg:pypi/django-filter@24.3"},
+		{types.Conda, "conda-forge/numpy", "1.26.4", "pkg:conda/numpy@1.26.4?channel=conda-forge"},
+		{types.Docker, "ghcr.io/org/app", "1.0", "pkg:docker/org/app@1.0?repository_url=ghcr.io"},
+		{types.Docker, "library/node", "20", "pkg:docker/library/node@20"},
+		{types.GitHubActions, "github/codeql-action/init", "v3", "pkg:github/github/codeql-action@v3#init"},
+		{types.Deno, "@std/path", "1.0.8", "pkg:jsr/%40std/path@1.0.8"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			assert.Equal(t, tt.expected, PURL(tt.manager, tt.name, tt.version))
+		})
+	}
+}
+
+func testDocument() Document {
+	manifests := []types.Manifest{
+		{
+			Manager: types.Npm,
+			Path:    "package.json",
+			Dependencies: []types.Dependency{
+				{Manager: types.Npm, Name: "react", Version: "18.3.1"},
+				{Manager: types.Npm, Name: "eslint", Version: "9.0.0", Dev: true},
+			},
+			Lockfile: &types.Lockfile{
+				Path: "package-lock.json",
+				Packages: []types.LockedPackage{
+					{Manager: types.Npm, Name: "eslint", Version: "9.0.0", Dependencies: []string{"js-tokens@4.0.0"}},
+					{Manager: types.Npm, Name: "js-tokens", Version: "4.0.0"},
+					{
+						Manager:      types.Npm,
+						Name:         "react",
+						Version:      "18.3.1",
+						Hashes:       []types.Hash{{Algorithm: "SHA-512", Value: "abcdef"}},
+						Dependencies: []string{"js-tokens@4.0.0"},
+					},
+				},
+			},
+		},
+	}
+
+	packages := types.PackagesInfo{
+		"npm:react":  {License: "MIT"},
+		"npm:eslint": {License: "Custom License"},
+	}
+
+	metadata := Metadata{
+		Name:        "app",
+		ToolVersion: "1.0.0",
+		Timestamp:   time.Date(2024, 11, 5, 10, 0, 0, 0, time.UTC),
+		Serial:      "3e671687-395b-41f5-a30f-a58921a69b79",
+	}
+
+	return New(metadata, manifests, packages)
+}
+
+func TestNew(t *testing.T) {
+	doc := testDocument()
+
+	assert.Equal(t, []string{"pkg:npm/eslint@9.0.0", "pkg:npm/react@18.3.1"}, doc.Dependencies)
+	assert.Equal(t, []Component{
+		{
+			Manager:      types.Npm,
+			Name:         "eslint",
+			Version:      "9.0.0",
+			PURL:         "pkg:npm/eslint@9.0.0",
+			License:      "Custom License",
+			Dev:          true,
+			Direct:       true,
+			Manifests:    []string{"package.json"},
+			Dependencies: []string{"pkg:npm/js-tokens@4.0.0"},
+		},
+		{
+			// Transitive packages used by production dependencies aren't dev ones
+			Manager:   types.Npm,
+			Name:      "js-tokens",
+			Version:   "4.0.0",
+			PURL:      "pkg:npm/js-tokens@4.0.0",
+			Manifests: []string{"package.json"},
+		},
+		{
+			Manager:      types.Npm,
+			Name:         "react",
+			Version:      "18.3.1",
+			PURL:         "pkg:npm/react@18.3.1",
+			License:      "MIT",
+			Direct:       true,
+			Hashes:       []types.Hash{{Algorithm: "SHA-512", Value: "abcdef"}},
+			Manifests:    []string{"package.json"},
+			Dependencies: []string{"pkg:npm/js-tokens@4.0.0"},
+		},
+	}, doc.Components)
+}
+
+func TestNew_VersionRange(t *testing.T) {
+	manifests := []types.Manifest{
+		{
+			Manager:      types.Npm,
+			Path:         "package.json",
+			Dependencies: []types.Dependency{{Manager: types.Npm, Name: "react", Version: "^18.2.0"}},
+			Lockfile: &types.Lockfile{
+				Path: "package-lock.json",
+				Packages: []types.LockedPackage{
+					{
+						Manager:      types.Npm,
+						Name:         "react",
+						Version:      "18.3.1",
+						Hashes:       []types.Hash{{Algorithm: "SHA-512", Value: "abcdef"}},
+						Dependencies: []string{"loose-envify@1.4.0"},
+						Direct:       true,
+					},
+					{Manager: types.Npm, Name: "loose-envify", Version: "1.4.0", Dependencies: []string{"js-tokens@4.0.0"}},
+					{Manager: types.Npm, Name: "js-tokens", Version: "4.0.0"},
+				},
+			},
+		},
+	}
+
+	doc := New(Metadata{Name: "app"}, manifests, types.PackagesInfo{})
+
+	// Dependencies with a version range get the version of their locked package
+	assert.Equal(t, []string{"pkg:npm/react@18.3.1"}, doc.Dependencies)
+	assert.Equal(t, []Component{
+		{
+			Manager:   types.Npm,
+			Name:      "js-tokens",
+			Version:   "4.0.0",
+			PURL:      "pkg:npm/js-tokens@4.0.0",
+			Manifests: []string{"package.json"},
+		},
+		{
+			Manager:      types.Npm,
+			Name:         "loose-envify",
+			Version:      "1.4.0",
+			PURL:         "pkg:npm/loose-envify@1.4.0",
+			Manifests:    []string{"package.json"},
+			Dependencies: []string{"pkg:npm/js-tokens@4.0.0"},
+		},
+		{
+			Manager:      types.Npm,
+			Name:         "react",
+			Version:      "18.3.1",
+			PURL:         "pkg:npm/react@18.3.1",
+			Direct:       true,
+			Hashes:       []types.Hash{{Algorithm: "SHA-512", Value: "abcdef"}},
+			Manifests:    []string{"package.json"},
+			Dependencies: []string{"pkg:npm/loose-envify@1.4.0"},
+		},
+	}, doc.Components)
+}
+
+func TestWrite(t *testing.T) {
+	doc := testDocument()
+
+	t.Run("cyclonedx", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, Write(&buf, CycloneDXJSON, doc))
+		assert.JSONEq(t, `{
+			"bomFormat": "CycloneDX",
+			"specVersion": "1.5",
+			"serialNumber": "urn:uuid:3e671687-395b-41f5-a30f-a58921a69b79",
+			"version": 1,
+			"metadata": {
+				"timestamp": "2024-11-05T10:00:00Z",
+				"tools": {"components": [{"type": "application", "name": "depshub", "version": "1.0.0"}]},
+				"component": {"type": "application", "bom-ref": "project", "name": "app"}
+			},
+			"components": [
+				{
+					"type": "library",
+					"bom-ref": "pkg:npm/eslint@9.0.0",
+					"name": "eslint",
+					"version": "9.0.0",
+					"scope": "optional",
+					"licenses": [{"license": {"name": "Custom License"}}],
+					"purl": "pkg:npm/eslint@9.0.0",
+					"properties": [{"name": "depshub:dev", "value": "true"}],
+					"evidence": {"occurrences": [{"location": "package.json"}]}
+				},
+				{
+					"type": "library",
+					"bom-ref": "pkg:npm/js-tokens@4.0.0",
+					"name": "js-tokens",
+					"version": "4.0.0",
+					"scope": "required",
+					"purl": "pkg:npm/js-tokens@4.0.0",
+					"properties": [{"name": "depshub:transitive", "value": "true"}],
+					"evidence": {"occurrences": [{"location": "package.json"}]}
+				},
+				{
+					"type": "library",
+					"bom-ref": "pkg:npm/react@18.3.1",
+					"name": "react",
+					"version": "18.3.1",
+					"scope": "required",
+					"hashes": [{"alg": "SHA-512", "content": "abcdef"}],
+					"licenses": [{"expression": "MIT"}],
+					"purl": "pkg:npm/react@18.3.1",
+					"evidence": {"occurrences": [{"location": "package.json"}]}
+				}
+			],
+			"dependencies": [
+				{"ref": "project", "dependsOn": ["pkg:npm/eslint@9.0.0", "pkg:npm/react@18.3.1"]},
+				{"ref": "pkg:npm/eslint@9.0.0", "dependsOn": ["pkg:npm/js-tokens@4.0.0"]},
+				{"ref": "pkg:npm/js-tokens@4.0.0", "dependsOn": []},
+				{"ref": "pkg:npm/react@18.3.1", "dependsOn": ["pkg:npm/js-tokens@4.0.0"]}
+			]
+		}`, buf.String())
+	})
+
+	t.Run("spdx", func(t *testing.T) {
+		var buf bytes.Buffer
+		assert.NoError(t, Write(&buf, SPDXJSON, doc))
+		assert.JSONEq(t, `{
+			"spdxVersion": "SPDX-2.3",
+			"dataLicense": "CC0-1.0",
+			"SPDXID": "SPDXRef-DOCUMENT",
+			"name": "app",
+			"documentNamespace": "https://depshub.com/spdxdocs/app-3e671687-395b-41f5-a30f-a58921a69b79",
+			"creationInfo": {"created": "2024-11-05T10:00:00Z", "creators": ["Tool: depshub-1.0.0"]},
+			"packages": [
+				{
+					"SPDXID": "SPDXRef-Project",
+					"name": "app",
+					"downloadLocation": "NOASSERTION",
+					"filesAnalyzed": false,
+					"licenseConcluded": "NOASSERTION",
+					"licenseDeclared": "NOASSERTION",
+					"primaryPackagePurpose": "APPLICATION"
+				},
+				{
+					"SPDXID": "SPDXRef-Package-1",
+					"name": "eslint",
+					"versionInfo": "9.0.0",
+					"downloadLocation": "NOASSERTION",
+					"filesAnalyzed": false,
+					"licenseConcluded": "NOASSERTION",
+					"licenseDeclared": "NOASSERTION",
+					"externalRefs": [{"referenceCategory": "PACKAGE-MANAGER", "referenceType": "purl", "referenceLocator": "pkg:npm/eslint@9.0.0"}],
+					"sourceInfo": "Used by package.json",
+					"primaryPackagePurpose": "LIBRARY"
+				},
+				{
+					"SPDXID": "SPDXRef-Package-2",
+					"name": "js-tokens",
+					"versionInfo": "4.0.0",
+					"downloadLocation": "NOASSERTION",
+					"filesAnalyzed": false,
+					"licenseConcluded": "NOASSERTION",
+					"licenseDeclared": "NOASSERTION",
+					"externalRefs": [{"referenceCategory": "PACKAGE-MANAGER", "referenceType": "purl", "referenceLocator": "pkg:npm/js-tokens@4.0.0"}],
+					"sourceInfo": "Used by package.json",
+					"primaryPackagePurpose": "LIBRARY"
+				},
+				{
+					"SPDXID": "SPDXRef-Package-3",
+					"name": "react",
+					"versionInfo": "18.3.1",
+					"downloadLocation": "NOASSERTION",
+					"filesAnalyzed": false,
+					"licenseConcluded": "NOASSERTION",
+					"licenseDeclared": "MIT",
+					"checksums": [{"algorithm": "SHA512", "checksumValue": "abcdef"}],
+					"externalRefs": [{"referenceCategory": "PACKAGE-MANAGER", "referenceType": "purl", "referenceLocator": "pkg:npm/react@18.3.1"}],
+					"sourceInfo": "Used by package.json",
+					"primaryPackagePurpose": "LIBRARY"
+				}
+			],
+			"relationships": [
+				{"spdxElementId": "SPDXRef-DOCUMENT", "relationshipType": "DESCRIBES", "relatedSpdxElement": "SPDXRef-Project"},
+				{"spdxElementId": "SPDXRef-Package-1", "relationshipType": "DEPENDS_ON", "relatedSpdxElement": "SPDXRef-Package-2"},
+				{"spdxElementId": "SPDXRef-Package-3", "relationshipType": "DEPENDS_ON", "relatedSpdxElement": "SPDXRef-Package-2"},
+				{"spdxElementId": "SPDXRef-Package-1", "relationshipType": "DEV_DEPENDENCY_OF", "relatedSpdxElement": "SPDXRef-Project"},
+				{"spdxElementId": "SPDXRef-Project", "relationshipType": "DEPENDS_ON", "relatedSpdxElement": "SPDXRef-Package-3"}
+			]
+		}`, buf.String())
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		assert.Error(t, Write(&bytes.Buffer{}, "xml", doc))
+	})
+}
@@ -0,0 +1,151 @@
+package sbom
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	spdxDocument  = "SPDXRef-DOCUMENT"
+	spdxProject   = "SPDXRef-Project"
+	spdxNoAssert  = "NOASSERTION"
+	spdxNamespace = "https://depshub.com/spdxdocs/"
+)
+
+// Matches the characters that can't be used in SPDX identifiers and namespaces
+var spdxInvalid = regexp.MustCompile(`[^A-Za-z0-9.-]+`)
+
+type spdxDoc struct {
+	SPDXVersion       string             `json:"spdxVersion"`
+	DataLicense       string             `json:"dataLicense"`
+	SPDXID            string             `json:"SPDXID"`
+	Name              string             `json:"name"`
+	DocumentNamespace string             `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo   `json:"creationInfo"`
+	Packages          []spdxPackage      `json:"packages"`
+	Relationships     []spdxRelationship `json:"relationships"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxPackage struct {
+	SPDXID                string            `json:"SPDXID"`
+	Name                  string            `json:"name"`
+	VersionInfo           string            `json:"versionInfo,omitempty"`
+	DownloadLocation      string            `json:"downloadLocation"`
+	FilesAnalyzed         bool              `json:"filesAnalyzed"`
+	LicenseConcluded      string            `json:"licenseConcluded"`
+	LicenseDeclared       string            `json:"licenseDeclared"`
+	Checksums             []spdxChecksum    `json:"checksums,omitempty"`
+	ExternalRefs          []spdxExternalRef `json:"externalRefs,omitempty"`
+	SourceInfo            string            `json:"sourceInfo,omitempty"`
+	PrimaryPackagePurpose string            `json:"primaryPackagePurpose"`
+}
+
+type spdxChecksum struct {
+	Algorithm string `json:"algorithm"`
+	Value     string `json:"checksumValue"`
+}
+
+type spdxExternalRef struct {
+	Category string `json:"referenceCategory"`
+	Type     string `json:"referenceType"`
+	Locator  string `json:"referenceLocator"`
+}
+
+type spdxRelationship struct {
+	Element string `json:"spdxElementId"`
+	Type    string `json:"relationshipType"`
+	Related string `json:"relatedSpdxElement"`
+}
+
+// writeSPDX writes the document in the SPDX 2.3 JSON format.
+// https://spdx.github.io/spdx-spec/v2.3/
+func writeSPDX(w io.Writer, doc Document) error {
+	result := spdxDoc{
+		SPDXVersion:       "SPDX-2.3",
+		DataLicense:       "CC0-1.0",
+		SPDXID:            spdxDocument,
+		Name:              doc.Name,
+		DocumentNamespace: spdxNamespace + spdxInvalid.ReplaceAllString(doc.Name, "-") + "-" + doc.Serial,
+		CreationInfo: spdxCreationInfo{
+			Created:  doc.Timestamp.Format(time.RFC3339),
+			Creators: []string{"Tool: depshub-" + doc.ToolVersion},
+		},
+		Packages: []spdxPackage{{
+			SPDXID:                spdxProject,
+			Name:                  doc.Name,
+			DownloadLocation:      spdxNoAssert,
+			LicenseConcluded:      spdxNoAssert,
+			LicenseDeclared:       spdxNoAssert,
+			PrimaryPackagePurpose: "APPLICATION",
+		}},
+		Relationships: []spdxRelationship{{Element: spdxDocument, Type: "DESCRIBES", Related: spdxProject}},
+	}
+
+	// Packages are identified by their position, since PURLs contain invalid characters
+	ids := make(map[string]string)
+	for i, c := range doc.Components {
+		ids[c.PURL] = fmt.Sprintf("SPDXRef-Package-%d", i+1)
+	}
+
+	for _, c := range doc.Components {
+		pkg := spdxPackage{
+			SPDXID:                ids[c.PURL],
+			Name:                  c.Name,
+			VersionInfo:           c.Version,
+			DownloadLocation:      spdxNoAssert,
+			LicenseConcluded:      spdxNoAssert,
+			LicenseDeclared:       spdxNoAssert,
+			ExternalRefs:          []spdxExternalRef{{Category: "PACKAGE-MANAGER", Type: "purl", Locator: c.PURL}},
+			PrimaryPackagePurpose: "LIBRARY",
+		}
+
+		if expression, ok := spdxExpression(c.License); ok {
+			pkg.LicenseDeclared = expression
+		}
+
+		for _, hash := range c.Hashes {
+			pkg.Checksums = append(pkg.Checksums, spdxChecksum{
+				Algorithm: strings.ReplaceAll(hash.Algorithm, "-", ""),
+				Value:     hash.Value,
+			})
+		}
+
+		if len(c.Manifests) > 0 {
+			pkg.SourceInfo = "Used by " + strings.Join(c.Manifests, ", ")
+		}
+
+		result.Packages = append(result.Packages, pkg)
+
+		for _, dep := range c.Dependencies {
+			result.Relationships = append(result.Relationships, spdxRelationship{Element: ids[c.PURL], Type: "DEPENDS_ON", Related: ids[dep]})
+		}
+	}
+
+	for _, purl := range doc.Dependencies {
+		i := slices.IndexFunc(doc.Components, func(c Component) bool { return c.PURL == purl })
+
+		if i != -1 && doc.Components[i].Dev {
+			result.Relationships = append(result.Relationships, spdxRelationship{Element: ids[purl], Type: "DEV_DEPENDENCY_OF", Related: spdxProject})
+			continue
+		}
+
+		result.Relationships = append(result.Relationships, spdxRelationship{Element: spdxProject, Type: "DEPENDS_ON", Related: ids[purl]})
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	// Keep the & of package URL qualifiers
+	encoder.SetEscapeHTML(false)
+
+	return encoder.Encode(result)
+}
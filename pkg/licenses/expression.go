@@ -0,0 +1,291 @@
+package licenses
+
+import (
+	"errors"
+	"slices"
+	"strings"
+)
+
+var ErrInvalidExpression = errors.New("invalid license expression")
+
+// Expression is a parsed SPDX license expression.
+// https://spdx.github.io/spdx-spec/v2.3/SPDX-license-expressions/
+type Expression interface {
+	// Satisfies evaluates the expression, a license is accepted if the allowed function accepts it
+	Satisfies(allowed func(License) bool) bool
+	// Licenses returns all the licenses of the expression
+	Licenses() []License
+	String() string
+}
+
+// License is a single license of an expression, with an optional exception.
+type License struct {
+	// The SPDX identifier, or the original name when the license is unknown
+	ID        string
+	Exception string
+	// Known is false when the name couldn't be normalized to an SPDX identifier
+	Known bool
+}
+
+// Listed reports whether the license is in the SPDX license list, unlike the
+// identifiers of registries like UNLICENSED.
+func (l License) Listed() bool {
+	return l.Known && !slices.Contains(unlisted, l.ID)
+}
+
+func (l License) Satisfies(allowed func(License) bool) bool {
+	return allowed(l)
+}
+
+func (l License) Licenses() []License {
+	return []License{l}
+}
+
+func (l License) String() string {
+	if l.Exception != "" {
+		return l.ID + " WITH " + l.Exception
+	}
+	return l.ID
+}
+
+type and struct {
+	left, right Expression
+}
+
+func (e and) Satisfies(allowed func(License) bool) bool {
+	return e.left.Satisfies(allowed) && e.right.Satisfies(allowed)
+}
+
+func (e and) Licenses() []License {
+	return append(e.left.Licenses(), e.right.Licenses()...)
+}
+
+func (e and) String() string {
+	return parenthesize(e.left) + " AND " + parenthesize(e.right)
+}
+
+type or struct {
+	left, right Expression
+}
+
+func (e or) Satisfies(allowed func(License) bool) bool {
+	return e.left.Satisfies(allowed) || e.right.Satisfies(allowed)
+}
+
+func (e or) Licenses() []License {
+	return append(e.left.Licenses(), e.right.Licenses()...)
+}
+
+func (e or) String() string {
+	return e.left.String() + " OR " + e.right.String()
+}
+
+// parenthesize adds parentheses to OR expressions inside AND expressions
+func parenthesize(e Expression) string {
+	if _, ok := e.(or); ok {
+		return "(" + e.String() + ")"
+	}
+	return e.String()
+}
+
+// Parse parses a license expression like "(MIT OR Apache-2.0) AND BSD-3-Clause"
+// and normalizes its licenses. Names that aren't SPDX expressions, like
+// "Apache License, Version 2.0", are parsed as a single license.
+func Parse(expression string) (Expression, error) {
+	expression = strings.TrimSpace(expression)
+	if expression == "" {
+		return nil, ErrInvalidExpression
+	}
+
+	if id, ok := Normalize(expression); ok {
+		return License{ID: id, Known: true}, nil
+	}
+
+	p := parser{tokens: tokenize(expression)}
+
+	result, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+
+	if p.pos != len(p.tokens) {
+		return nil, ErrInvalidExpression
+	}
+
+	return result, nil
+}
+
+// ParseOrUnknown parses a license expression, or returns the whole text as an
+// unknown license when it isn't a valid expression.
+func ParseOrUnknown(expression string) Expression {
+	if result, err := Parse(expression); err == nil {
+		return result
+	}
+
+	return License{ID: strings.TrimSpace(expression)}
+}
+
+// tokenize splits an expression into parentheses, operators and license names.
+// The words of names with spaces, like "Apache 2.0", are joined.
+func tokenize(expression string) []string {
+	var tokens []string
+	var words []string
+
+	flush := func() {
+		if len(words) > 0 {
+			tokens = append(tokens, strings.Join(words, " "))
+			words = nil
+		}
+	}
+
+	expression = strings.NewReplacer("(", " ( ", ")", " ) ").Replace(expression)
+
+	for _, word := range strings.Fields(expression) {
+		switch {
+		case word == "(" || word == ")" || isOperator(word):
+			flush()
+			tokens = append(tokens, strings.ToUpper(word))
+		default:
+			words = append(words, word)
+		}
+	}
+
+	flush()
+
+	return tokens
+}
+
+func isOperator(word string) bool {
+	switch strings.ToUpper(word) {
+	case "AND", "OR", "WITH":
+		return true
+	}
+	return false
+}
+
+type parser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *parser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+// OR has a lower precedence than AND
+func (p *parser) or() (Expression, error) {
+	left, err := p.and()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek() == "OR" {
+		p.pos++
+
+		right, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+
+		left = or{left, right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) and() (Expression, error) {
+	left, err := p.with()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek() == "AND" {
+		p.pos++
+
+		right, err := p.with()
+		if err != nil {
+			return nil, err
+		}
+
+		left = and{left, right}
+	}
+
+	return left, nil
+}
+
+func (p *parser) with() (Expression, error) {
+	token := p.peek()
+
+	if token == "(" {
+		p.pos++
+
+		result, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+
+		if p.peek() != ")" {
+			return nil, ErrInvalidExpression
+		}
+		p.pos++
+
+		return result, nil
+	}
+
+	if token == "" || token == ")" || isOperator(token) {
+		return nil, ErrInvalidExpression
+	}
+	p.pos++
+
+	license := License{ID: token}
+	if id, ok := Normalize(token); ok {
+		license = License{ID: id, Known: true}
+	}
+
+	if p.peek() == "WITH" {
+		p.pos++
+
+		exception := p.peek()
+		if exception == "" || exception == "(" || exception == ")" || isOperator(exception) {
+			return nil, ErrInvalidExpression
+		}
+		p.pos++
+
+		license.Exception = exception
+	}
+
+	return license, nil
+}
+
+// Or joins the licenses a registry lists for a version, like the licenses of a
+// gem, into an expression where any of them can be chosen.
+func Or(licenses []string) string {
+	return join(licenses, " OR ")
+}
+
+// And joins licenses that all apply, like the license files found in a module.
+func And(licenses []string) string {
+	return join(licenses, " AND ")
+}
+
+func join(licenses []string, operator string) string {
+	var parts []string
+
+	for _, l := range licenses {
+		l = strings.TrimSpace(l)
+		if l == "" {
+			continue
+		}
+
+		if len(licenses) > 1 && strings.ContainsAny(l, " ") {
+			l = "(" + l + ")"
+		}
+
+		parts = append(parts, l)
+	}
+
+	return strings.Join(parts, operator)
+}
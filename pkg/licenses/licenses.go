@@ -0,0 +1,327 @@
+// Package licenses normalizes license names to SPDX identifiers and evaluates
+// SPDX license expressions like "MIT OR Apache-2.0".
+package licenses
+
+import (
+	"embed"
+	"regexp"
+	"strings"
+)
+
+type Category string
+
+const (
+	Permissive     Category = "permissive"
+	WeakCopyleft   Category = "weak-copyleft"
+	StrongCopyleft Category = "strong-copyleft"
+)
+
+// Categories are the license categories that can be used instead of identifiers.
+var Categories = []Category{Permissive, WeakCopyleft, StrongCopyleft}
+
+var categories = map[Category][]string{
+	Permissive: {
+		"0BSD", "AFL-3.0", "Apache-1.1", "Apache-2.0", "Artistic-2.0", "BlueOak-1.0.0", "BSD-1-Clause", "BSD-2-Clause",
+		"BSD-2-Clause-Patent", "BSD-3-Clause", "BSD-3-Clause-Clear", "BSD-4-Clause", "BSL-1.0", "bzip2-1.0.6", "CC-BY-3.0",
+		"CC-BY-4.0", "CC0-1.0", "curl", "HPND", "ICU", "ISC", "libpng-2.0", "MIT", "MIT-0", "MIT-CMU", "MulanPSL-2.0",
+		"NCSA", "OpenSSL", "PHP-3.01", "PostgreSQL", "PSF-2.0", "Python-2.0", "Ruby", "Unicode-3.0", "Unicode-DFS-2016",
+		"Unlicense", "UPL-1.0", "W3C", "WTFPL", "X11", "Zlib", "ZPL-2.1",
+	},
+	WeakCopyleft: {
+		"APSL-2.0", "CDDL-1.0", "CDDL-1.1", "CECILL-C", "CPL-1.0", "EPL-1.0", "EPL-2.0", "LGPL-2.0-only",
+		"LGPL-2.0-or-later", "LGPL-2.1-only", "LGPL-2.1-or-later", "LGPL-3.0-only", "LGPL-3.0-or-later", "MPL-1.1",
+		"MPL-2.0", "MPL-2.0-no-copyleft-exception", "MS-PL", "MS-RL",
+	},
+	StrongCopyleft: {
+		"AGPL-3.0-only", "AGPL-3.0-or-later", "CC-BY-SA-4.0", "CECILL-2.1", "EUPL-1.1", "EUPL-1.2", "GPL-2.0-only",
+		"GPL-2.0-or-later", "GPL-3.0-only", "GPL-3.0-or-later", "OSL-3.0", "RPL-1.5", "Sleepycat", "SSPL-1.0",
+	},
+}
+
+// The identifiers of the SPDX license list, most of them without a category
+//
+//go:embed spdx.txt
+var spdx string
+
+// The texts of the SPDX license list, for the licenses with a category
+//
+//go:embed texts/*.txt
+var texts embed.FS
+
+// Identifiers used by registries that aren't in the SPDX license list, like
+// UNLICENSED for the npm packages that can't be used
+var unlisted = []string{"UNLICENSED"}
+
+// Deprecated identifiers and the ones they were replaced with
+var deprecated = map[string]string{
+	"AGPL-3.0":  "AGPL-3.0-only",
+	"AGPL-3.0+": "AGPL-3.0-or-later",
+	"GPL-2.0":   "GPL-2.0-only",
+	"GPL-2.0+":  "GPL-2.0-or-later",
+	"GPL-3.0":   "GPL-3.0-only",
+	"GPL-3.0+":  "GPL-3.0-or-later",
+	"LGPL-2.0":  "LGPL-2.0-only",
+	"LGPL-2.0+": "LGPL-2.0-or-later",
+	"LGPL-2.1":  "LGPL-2.1-only",
+	"LGPL-2.1+": "LGPL-2.1-or-later",
+	"LGPL-3.0":  "LGPL-3.0-only",
+	"LGPL-3.0+": "LGPL-3.0-or-later",
+}
+
+// Common names of licenses, after they are simplified with aliasKey
+var aliases = map[string]string{
+	"mit":                                  "MIT",
+	"mit/x11":                              "MIT",
+	"expat":                                "MIT",
+	"x11":                                  "X11",
+	"apache":                               "Apache-2.0",
+	"apache 2":                             "Apache-2.0",
+	"apache 2.0":                           "Apache-2.0",
+	"apache-2":                             "Apache-2.0",
+	"apache2.0":                            "Apache-2.0",
+	"apache2":                              "Apache-2.0",
+	"apache software":                      "Apache-2.0",
+	"apache software 2.0":                  "Apache-2.0",
+	"asl 2.0":                              "Apache-2.0",
+	"al2":                                  "Apache-2.0",
+	"bsd":                                  "BSD-3-Clause",
+	"bsd 3-clause":                         "BSD-3-Clause",
+	"bsd-3":                                "BSD-3-Clause",
+	"3-clause bsd":                         "BSD-3-Clause",
+	"new bsd":                              "BSD-3-Clause",
+	"modified bsd":                         "BSD-3-Clause",
+	"revised bsd":                          "BSD-3-Clause",
+	"bsd 2-clause":                         "BSD-2-Clause",
+	"bsd-2":                                "BSD-2-Clause",
+	"2-clause bsd":                         "BSD-2-Clause",
+	"simplified bsd":                       "BSD-2-Clause",
+	"freebsd":                              "BSD-2-Clause",
+	"isc":                                  "ISC",
+	"zlib":                                 "Zlib",
+	"zlib/libpng":                          "Zlib",
+	"boost":                                "BSL-1.0",
+	"boost software":                       "BSL-1.0",
+	"boost software 1.0":                   "BSL-1.0",
+	"psf":                                  "PSF-2.0",
+	"psfl":                                 "PSF-2.0",
+	"python software foundation":           "PSF-2.0",
+	"cc0":                                  "CC0-1.0",
+	"cc0 1.0 universal":                    "CC0-1.0",
+	"unlicense":                            "Unlicense",
+	"wtfpl":                                "WTFPL",
+	"postgresql":                           "PostgreSQL",
+	"ruby":                                 "Ruby",
+	"artistic 2.0":                         "Artistic-2.0",
+	"mpl":                                  "MPL-2.0",
+	"mpl 2.0":                              "MPL-2.0",
+	"mpl2":                                 "MPL-2.0",
+	"mozilla public 2.0":                   "MPL-2.0",
+	"mpl 1.1":                              "MPL-1.1",
+	"mozilla public 1.1":                   "MPL-1.1",
+	"epl":                                  "EPL-2.0",
+	"epl 2.0":                              "EPL-2.0",
+	"eclipse public 2.0":                   "EPL-2.0",
+	"eclipse public - 2.0":                 "EPL-2.0",
+	"epl 1.0":                              "EPL-1.0",
+	"eclipse public 1.0":                   "EPL-1.0",
+	"eclipse public - 1.0":                 "EPL-1.0",
+	"cddl":                                 "CDDL-1.0",
+	"cddl 1.0":                             "CDDL-1.0",
+	"cddl 1.1":                             "CDDL-1.1",
+	"lgpl":                                 "LGPL-2.1-or-later",
+	"lgpl2":                                "LGPL-2.1-only",
+	"lgpl 2.1":                             "LGPL-2.1-only",
+	"lgpl2.1":                              "LGPL-2.1-only",
+	"lgpl 2.1+":                            "LGPL-2.1-or-later",
+	"lgpl3":                                "LGPL-3.0-only",
+	"lgpl 3":                               "LGPL-3.0-only",
+	"lgpl 3.0":                             "LGPL-3.0-only",
+	"lgpl3+":                               "LGPL-3.0-or-later",
+	"lgpl 3+":                              "LGPL-3.0-or-later",
+	"gnu lesser general public 2":          "LGPL-2.0-only",
+	"gnu lesser general public 2.1":        "LGPL-2.1-only",
+	"gnu lesser general public 3":          "LGPL-3.0-only",
+	"gnu lesser general public 3.0":        "LGPL-3.0-only",
+	"gnu library or lesser general public": "LGPL-2.0-or-later",
+	"gpl":                                  "GPL-2.0-or-later",
+	"gpl2":                                 "GPL-2.0-only",
+	"gpl 2":                                "GPL-2.0-only",
+	"gpl 2.0":                              "GPL-2.0-only",
+	"gpl2+":                                "GPL-2.0-or-later",
+	"gpl 2+":                               "GPL-2.0-or-later",
+	"gpl3":                                 "GPL-3.0-only",
+	"gpl 3":                                "GPL-3.0-only",
+	"gpl 3.0":                              "GPL-3.0-only",
+	"gpl3+":                                "GPL-3.0-or-later",
+	"gpl 3+":                               "GPL-3.0-or-later",
+	"gnu general public 2":                 "GPL-2.0-only",
+	"gnu general public 2.0":               "GPL-2.0-only",
+	"gnu general public 3":                 "GPL-3.0-only",
+	"gnu general public 3.0":               "GPL-3.0-only",
+	"agpl":                                 "AGPL-3.0-or-later",
+	"agpl3":                                "AGPL-3.0-only",
+	"agpl 3":                               "AGPL-3.0-only",
+	"agpl 3.0":                             "AGPL-3.0-only",
+	"agpl3+":                               "AGPL-3.0-or-later",
+	"gnu affero general public 3":          "AGPL-3.0-only",
+	"gnu affero general public 3.0":        "AGPL-3.0-only",
+	"eupl 1.2":                             "EUPL-1.2",
+	"european union public 1.2":            "EUPL-1.2",
+}
+
+var ids = map[string]string{}
+
+func init() {
+	for _, list := range categories {
+		for _, id := range list {
+			ids[strings.ToLower(id)] = id
+		}
+	}
+
+	for _, line := range strings.Split(spdx, "\n") {
+		id := strings.TrimSpace(line)
+		if id == "" || strings.HasPrefix(id, "#") {
+			continue
+		}
+
+		// Deprecated identifiers like GPL-2.0 are normalized to their replacement
+		if _, ok := deprecated[id]; !ok {
+			ids[strings.ToLower(id)] = id
+		}
+	}
+
+	for _, id := range unlisted {
+		ids[strings.ToLower(id)] = id
+	}
+}
+
+// Text returns the SPDX text of a license identifier, when it's embedded.
+func Text(id string) (string, bool) {
+	text, err := texts.ReadFile("texts/" + id + ".txt")
+	if err != nil {
+		return "", false
+	}
+
+	return string(text), true
+}
+
+// CategoryOf returns the category of a license identifier, or an empty string
+// when the license doesn't have one.
+func CategoryOf(id string) Category {
+	for category, list := range categories {
+		for _, l := range list {
+			if l == id {
+				return category
+			}
+		}
+	}
+
+	return ""
+}
+
+// ParseCategory checks if a value is the name of a category.
+func ParseCategory(value string) (Category, bool) {
+	for _, category := range Categories {
+		if strings.EqualFold(value, string(category)) {
+			return category, true
+		}
+	}
+
+	return "", false
+}
+
+var (
+	classifierPrefix = regexp.MustCompile(`(?i)^license\s*::\s*(osi approved\s*::\s*)?`)
+	versionWord      = regexp.MustCompile(`(?i)\b(version|ver\.?|v)\s*(\d)`)
+	versionSuffix    = regexp.MustCompile(`(?i)([a-z])v(\d)`)
+	orLater          = regexp.MustCompile(`(?i)\s*(or|and|&)\s*(any\s*)?later(\s*version)?\s*$`)
+	parenthesized    = regexp.MustCompile(`\s*\([^)]*\)\s*`)
+	licenseWord      = regexp.MustCompile(`(?i)\b(the|license[sd]?|licence[sd]?)\b`)
+	spaces           = regexp.MustCompile(`[\s,]+`)
+)
+
+// Normalize returns the SPDX identifier of a license, like Apache-2.0 for
+// "Apache License, Version 2.0" or MIT for "License :: OSI Approved :: MIT License".
+// Custom licenses like LicenseRef-Proprietary are kept.
+func Normalize(name string) (string, bool) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", false
+	}
+
+	if strings.HasPrefix(name, "LicenseRef-") || strings.HasPrefix(name, "DocumentRef-") {
+		return name, true
+	}
+
+	if id, ok := ids[strings.ToLower(name)]; ok {
+		return id, true
+	}
+
+	for old, id := range deprecated {
+		if strings.EqualFold(old, name) {
+			return id, true
+		}
+	}
+
+	key, later := aliasKey(name)
+
+	if id, ok := lookup(key, later); ok {
+		return id, true
+	}
+
+	// Names like "Apache 2.0" and "MPL 2.0" are identifiers with spaces
+	if id, ok := lookup(strings.ReplaceAll(key, " ", "-"), later); ok {
+		return id, true
+	}
+
+	return "", false
+}
+
+func lookup(key string, later bool) (string, bool) {
+	id, ok := aliases[key]
+	if !ok {
+		if id, ok = ids[key]; !ok {
+			for old, replacement := range deprecated {
+				if strings.EqualFold(old, key) {
+					id, ok = replacement, true
+				}
+			}
+		}
+	}
+
+	if !ok {
+		return "", false
+	}
+
+	if later {
+		if strings.HasSuffix(id, "-only") {
+			id = strings.TrimSuffix(id, "-only") + "-or-later"
+		}
+	}
+
+	return id, true
+}
+
+// aliasKey simplifies a license name to look it up in aliases, like "gpl 2" for
+// "GNU GPL Version 2". It also reports if the name ends with "or later".
+func aliasKey(name string) (string, bool) {
+	key := classifierPrefix.ReplaceAllString(name, "")
+
+	// Parenthesized parts like "GNU General Public License v3 (GPLv3)" repeat the name
+	key = parenthesized.ReplaceAllString(key, " ")
+
+	later := orLater.MatchString(key)
+	key = orLater.ReplaceAllString(key, "")
+
+	key = versionWord.ReplaceAllString(key, " $2")
+	key = versionSuffix.ReplaceAllString(key, "$1$2")
+	key = licenseWord.ReplaceAllString(key, " ")
+	key = spaces.ReplaceAllString(key, " ")
+	key = strings.ToLower(strings.Trim(key, " -:"))
+
+	if strings.HasPrefix(key, "gnu gpl") || strings.HasPrefix(key, "gnu lgpl") || strings.HasPrefix(key, "gnu agpl") {
+		key = strings.TrimPrefix(key, "gnu ")
+	}
+
+	return key, later
+}
@@ -0,0 +1,182 @@
+package licenses
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{name: "MIT", expected: "MIT"},
+		{name: "mit", expected: "MIT"},
+		{name: "MIT License", expected: "MIT"},
+		{name: "The MIT Licence", expected: "MIT"},
+		{name: "Apache-2.0", expected: "Apache-2.0"},
+		{name: "Apache 2.0", expected: "Apache-2.0"},
+		{name: "Apache-2", expected: "Apache-2.0"},
+		{name: "Apache License, Version 2.0", expected: "Apache-2.0"},
+		{name: "The Apache Software License, Version 2.0", expected: "Apache-2.0"},
+		{name: "License :: OSI Approved :: Apache Software License", expected: "Apache-2.0"},
+		{name: "BSD", expected: "BSD-3-Clause"},
+		{name: "BSD License", expected: "BSD-3-Clause"},
+		{name: "Simplified BSD License", expected: "BSD-2-Clause"},
+		{name: "bsd-3-clause", expected: "BSD-3-Clause"},
+		{name: "GPL-3.0", expected: "GPL-3.0-only"},
+		{name: "GPL-2.0+", expected: "GPL-2.0-or-later"},
+		{name: "GPLv3", expected: "GPL-3.0-only"},
+		{name: "GPL v2 or later", expected: "GPL-2.0-or-later"},
+		{name: "License :: OSI Approved :: GNU General Public License v3 (GPLv3)", expected: "GPL-3.0-only"},
+		{name: "GNU Lesser General Public License v2 or later (LGPLv2+)", expected: "LGPL-2.0-or-later"},
+		{name: "Eclipse Public License - v 2.0", expected: "EPL-2.0"},
+		{name: "Mozilla Public License 2.0 (MPL 2.0)", expected: "MPL-2.0"},
+		{name: "LicenseRef-Proprietary", expected: "LicenseRef-Proprietary"},
+		{name: "OFL-1.1", expected: "OFL-1.1"},
+		{name: "cc-by-sa-3.0", expected: "CC-BY-SA-3.0"},
+		{name: "GPL-1.0-or-later", expected: "GPL-1.0-or-later"},
+		{name: "UNLICENSED", expected: "UNLICENSED"},
+		{name: "Unlicense", expected: "Unlicense"},
+		{name: "Public Domain", expected: ""},
+		{name: "", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, ok := Normalize(tt.name)
+			assert.Equal(t, tt.expected, id)
+			assert.Equal(t, tt.expected != "", ok)
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		expression string
+		expected   string
+		licenses   []License
+	}{
+		{
+			expression: "MIT",
+			expected:   "MIT",
+			licenses:   []License{{ID: "MIT", Known: true}},
+		},
+		{
+			expression: "MIT OR Apache-2.0",
+			expected:   "MIT OR Apache-2.0",
+			licenses:   []License{{ID: "MIT", Known: true}, {ID: "Apache-2.0", Known: true}},
+		},
+		{
+			expression: "(MIT or Apache 2.0) AND BSD",
+			expected:   "(MIT OR Apache-2.0) AND BSD-3-Clause",
+			licenses:   []License{{ID: "MIT", Known: true}, {ID: "Apache-2.0", Known: true}, {ID: "BSD-3-Clause", Known: true}},
+		},
+		{
+			expression: "MIT AND Zlib OR GPL-2.0+",
+			expected:   "MIT AND Zlib OR GPL-2.0-or-later",
+			licenses:   []License{{ID: "MIT", Known: true}, {ID: "Zlib", Known: true}, {ID: "GPL-2.0-or-later", Known: true}},
+		},
+		{
+			expression: "GPL-2.0-only WITH Classpath-exception-2.0",
+			expected:   "GPL-2.0-only WITH Classpath-exception-2.0",
+			licenses:   []License{{ID: "GPL-2.0-only", Exception: "Classpath-exception-2.0", Known: true}},
+		},
+		{
+			expression: "Apache License, Version 2.0",
+			expected:   "Apache-2.0",
+			licenses:   []License{{ID: "Apache-2.0", Known: true}},
+		},
+		{
+			expression: "MIT OR Custom Terms",
+			expected:   "MIT OR Custom Terms",
+			licenses:   []License{{ID: "MIT", Known: true}, {ID: "Custom Terms"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			result, err := Parse(tt.expression)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result.String())
+			assert.Equal(t, tt.licenses, result.Licenses())
+		})
+	}
+
+	for _, expression := range []string{"", "(MIT", "MIT OR", "AND MIT", "MIT WITH", "MIT)"} {
+		t.Run("invalid "+expression, func(t *testing.T) {
+			_, err := Parse(expression)
+			assert.ErrorIs(t, err, ErrInvalidExpression)
+		})
+	}
+
+	assert.Equal(t, License{ID: "(MIT"}, ParseOrUnknown("(MIT"))
+}
+
+func TestExpression_Satisfies(t *testing.T) {
+	permissive := func(l License) bool {
+		return CategoryOf(l.ID) == Permissive
+	}
+
+	tests := []struct {
+		expression string
+		expected   bool
+	}{
+		{expression: "MIT", expected: true},
+		{expression: "GPL-3.0-only", expected: false},
+		{expression: "MIT OR GPL-3.0-only", expected: true},
+		{expression: "MIT AND GPL-3.0-only", expected: false},
+		{expression: "(MIT OR GPL-3.0-only) AND (ISC OR LGPL-2.1-only)", expected: true},
+		{expression: "(GPL-3.0-only OR LGPL-2.1-only) AND MIT", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			result, err := Parse(tt.expression)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result.Satisfies(permissive))
+		})
+	}
+}
+
+func TestLicense_Listed(t *testing.T) {
+	assert.True(t, ParseOrUnknown("OFL-1.1").(License).Listed())
+	assert.False(t, ParseOrUnknown("UNLICENSED").(License).Listed())
+	assert.False(t, ParseOrUnknown("Custom terms").(License).Listed())
+}
+
+func TestText(t *testing.T) {
+	for _, id := range []string{"Apache-2.0", "BSD-3-Clause", "GPL-3.0-or-later", "MPL-2.0"} {
+		_, ok := Text(id)
+		assert.True(t, ok, id)
+	}
+
+	text, ok := Text("MIT")
+	assert.True(t, ok)
+	assert.Contains(t, text, "Permission is hereby granted, free of charge")
+
+	_, ok = Text("../licenses")
+	assert.False(t, ok)
+}
+
+func TestCategoryOf(t *testing.T) {
+	assert.Equal(t, Permissive, CategoryOf("MIT"))
+	assert.Equal(t, WeakCopyleft, CategoryOf("MPL-2.0"))
+	assert.Equal(t, StrongCopyleft, CategoryOf("AGPL-3.0-only"))
+	assert.Equal(t, Category(""), CategoryOf("BUSL-1.1"))
+
+	category, ok := ParseCategory("Weak-Copyleft")
+	assert.True(t, ok)
+	assert.Equal(t, WeakCopyleft, category)
+
+	_, ok = ParseCategory("MIT")
+	assert.False(t, ok)
+}
+
+func TestJoin(t *testing.T) {
+	assert.Equal(t, "", Or(nil))
+	assert.Equal(t, "MIT", Or([]string{"MIT"}))
+	assert.Equal(t, "MIT OR GPL-2.0-only", Or([]string{"MIT", "", "GPL-2.0-only"}))
+	assert.Equal(t, "(MIT OR Apache-2.0) AND BSD-3-Clause", And([]string{"MIT OR Apache-2.0", "BSD-3-Clause"}))
+}
@@ -0,0 +1,95 @@
+package actions
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+// Matches steps and reusable workflows like `uses: actions/checkout@v4`
+var usesPattern = regexp.MustCompile(`^\s*(?:-\s+)?uses\s*:\s*["']?([^"'\s#]+)["']?`)
+
+type Actions struct{}
+
+func (Actions) GetType() types.ManagerType {
+	return types.GitHubActions
+}
+
+// Managed checks for workflows in .github/workflows and composite actions.
+func (Actions) Managed(path string) bool {
+	base := filepath.Base(path)
+	if base == "action.yml" || base == "action.yaml" {
+		return true
+	}
+
+	ext := filepath.Ext(path)
+	if ext != ".yml" && ext != ".yaml" {
+		return false
+	}
+
+	return strings.HasSuffix(filepath.ToSlash(filepath.Dir(path)), ".github/workflows")
+}
+
+func (Actions) Dependencies(path string) ([]types.Dependency, error) {
+	var dependencies []types.Dependency
+
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, line := range strings.Split(string(file), "\n") {
+		matches := usesPattern.FindStringSubmatch(line)
+		if matches == nil {
+			continue
+		}
+
+		name, ref, ok := parseUses(matches[1])
+		if !ok {
+			continue
+		}
+
+		dependencies = append(dependencies, types.Dependency{
+			Manager: types.GitHubActions,
+			Name:    name,
+			Version: ref,
+			Definition: types.Definition{
+				Path:    path,
+				RawLine: strings.TrimSpace(line),
+				Line:    i + 1,
+			},
+		})
+	}
+
+	return dependencies, nil
+}
+
+// parseUses splits `owner/repo/path@ref` into the repository and the ref.
+// Local actions and Docker images aren't hosted on GitHub, so they are skipped.
+func parseUses(uses string) (name string, ref string, ok bool) {
+	if strings.HasPrefix(uses, "./") || strings.HasPrefix(uses, "docker://") {
+		return "", "", false
+	}
+
+	action, ref, found := strings.Cut(uses, "@")
+	if !found {
+		return "", "", false
+	}
+
+	parts := strings.Split(action, "/")
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+
+	// Actions in subdirectories like github/codeql-action/init belong to the repository
+	return parts[0] + "/" + parts[1], ref, true
+}
+
+// LockfilePath always fails since workflows pin their actions directly.
+func (Actions) LockfilePath(path string) (string, error) {
+	return "", fmt.Errorf("GitHub Actions don't use a lockfile")
+}
@@ -0,0 +1,149 @@
+package actions
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/depshubhq/depshub/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActions_GetType(t *testing.T) {
+	manager := Actions{}
+	assert.Equal(t, types.GitHubActions, manager.GetType())
+}
+
+func TestActions_Managed(t *testing.T) {
+	manager := Actions{}
+	tests := []struct {
+		name     string
+		path     string
+		expected bool
+	}{
+		{
+			name:     "workflow",
+			path:     "repo/.github/workflows/ci.yml",
+			expected: true,
+		},
+		{
+			name:     "workflow with yaml extension",
+			path:     "repo/.github/workflows/release.yaml",
+			expected: true,
+		},
+		{
+			name:     "composite action",
+			path:     "repo/actions/setup/action.yml",
+			expected: true,
+		},
+		{
+			name:     "other github file",
+			path:     "repo/.github/dependabot.yml",
+			expected: false,
+		},
+		{
+			name:     "other yaml file",
+			path:     "repo/config/ci.yml",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := manager.Managed(tt.path)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestActions_Dependencies(t *testing.T) {
+	manager := Actions{}
+
+	tests := []struct {
+		name     string
+		path     string
+		expected func(path string) []types.Dependency
+	}{
+		{
+			name: "workflow",
+			path: filepath.Join("testdata", ".github", "workflows", "ci.yml"),
+			expected: func(path string) []types.Dependency {
+				return []types.Dependency{
+					{
+						Manager: types.GitHubActions,
+						Name:    "example/shared-workflows",
+						Version: "main",
+						Definition: types.Definition{
+							Path:    path,
+							RawLine: "uses: example/shared-workflows/.github/workflows/lint.yml@main",
+							Line:    9,
+						},
+					},
+					{
+						Manager: types.GitHubActions,
+						Name:    "actions/checkout",
+						Version: "11bd71901bbe5b1630ceea73d27597364c9af683",
+						Definition: types.Definition{
+							Path:    path,
+							RawLine: "- uses: actions/checkout@11bd71901bbe5b1630ceea73d27597364c9af683 # v4.2.2",
+							Line:    14,
+						},
+					},
+					{
+						Manager: types.GitHubActions,
+						Name:    "actions/setup-go",
+						Version: "v5",
+						Definition: types.Definition{
+							Path:    path,
+							RawLine: "uses: actions/setup-go@v5",
+							Line:    16,
+						},
+					},
+					{
+						Manager: types.GitHubActions,
+						Name:    "github/codeql-action",
+						Version: "v3",
+						Definition: types.Definition{
+							Path:    path,
+							RawLine: `- uses: "github/codeql-action/init@v3"`,
+							Line:    19,
+						},
+					},
+				}
+			},
+		},
+		{
+			name: "composite action",
+			path: filepath.Join("testdata", "setup", "action.yml"),
+			expected: func(path string) []types.Dependency {
+				return []types.Dependency{
+					{
+						Manager: types.GitHubActions,
+						Name:    "actions/cache",
+						Version: "main",
+						Definition: types.Definition{
+							Path:    path,
+							RawLine: "- uses: actions/cache@main",
+							Line:    6,
+						},
+					},
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dependencies, err := manager.Dependencies(tt.path)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected(tt.path), dependencies)
+		})
+	}
+}
+
+func TestActions_LockfilePath(t *testing.T) {
+	manager := Actions{}
+
+	lockfilePath, err := manager.LockfilePath(filepath.Join("testdata", ".github", "workflows", "ci.yml"))
+	assert.Error(t, err)
+	assert.Empty(t, lockfilePath)
+}
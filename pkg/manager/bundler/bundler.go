@@ -0,0 +1,95 @@
+package bundler
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+type Bundler struct{}
+
+func (Bundler) GetType() types.ManagerType {
+	return types.Bundler
+}
+
+func (Bundler) Managed(path string) bool {
+	base := filepath.Base(path)
+	return base == "Gemfile" || base == "gems.rb"
+}
+
+func (Bundler) Dependencies(path string) ([]types.Dependency, error) {
+	var dependencies []types.Dependency
+
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var lock map[string]LockEntry
+	if lockfilePath, err := (Bundler{}).LockfilePath(path); err == nil {
+		lock, err = ReadLockfile(lockfilePath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, dep := range parseGemfile(string(file)) {
+		version := cleanVersion(dep.requirement)
+
+		// Use the version from Gemfile.lock when there is one
+		entry, locked := lock[dep.name]
+		if locked {
+			version = entry.Version
+		}
+
+		dependencies = append(dependencies, types.Dependency{
+			Manager: types.Bundler,
+			Name:    dep.name,
+			Version: version,
+			Locked:  locked,
+			Dev:     dep.dev,
+			Group:   dep.group,
+			Definition: types.Definition{
+				Path:    path,
+				RawLine: dep.rawLine,
+				Line:    dep.line,
+			},
+		})
+	}
+
+	return dependencies, nil
+}
+
+// Returns the version without any prefix or suffix
+func cleanVersion(version string) string {
+	version = strings.TrimSpace(version)
+
+	// Remove constraints like >=, ~>, etc.
+	for _, prefix := range []string{"~>", ">=", "<=", "!=", "=", ">", "<"} {
+		if strings.HasPrefix(version, prefix) {
+			version = strings.TrimPrefix(version, prefix)
+			break
+		}
+	}
+
+	return strings.TrimSpace(version)
+}
+
+// LockfilePath returns Gemfile.lock, or gems.locked for projects using gems.rb.
+func (Bundler) LockfilePath(path string) (string, error) {
+	lockfile := "Gemfile.lock"
+	if filepath.Base(path) == "gems.rb" {
+		lockfile = "gems.locked"
+	}
+
+	lockfilePath := filepath.Join(filepath.Dir(path), lockfile)
+
+	if _, err := os.Stat(lockfilePath); os.IsNotExist(err) {
+		return "", fmt.Errorf("lockfile not found")
+	}
+
+	return lockfilePath, nil
+}
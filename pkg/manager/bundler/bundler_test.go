@@ -0,0 +1,254 @@
+package bundler
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/depshubhq/depshub/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBundler_GetType(t *testing.T) {
+	manager := Bundler{}
+	assert.Equal(t, types.Bundler, manager.GetType())
+}
+
+func TestBundler_Managed(t *testing.T) {
+	manager := Bundler{}
+	tests := []struct {
+		name     string
+		path     string
+		expected bool
+	}{
+		{
+			name:     "Gemfile",
+			path:     "path/to/Gemfile",
+			expected: true,
+		},
+		{
+			name:     "gems.rb",
+			path:     "path/to/gems.rb",
+			expected: true,
+		},
+		{
+			name:     "Gemfile.lock",
+			path:     "path/to/Gemfile.lock",
+			expected: false,
+		},
+		{
+			name:     "other ruby file",
+			path:     "path/to/app.rb",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := manager.Managed(tt.path)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestBundler_Dependencies(t *testing.T) {
+	manager := Bundler{}
+	testPath := filepath.Join("testdata", "Gemfile")
+
+	dependencies, err := manager.Dependencies(testPath)
+	assert.NoError(t, err)
+
+	dependency := func(name, version string, dev bool, group string, line int, rawLine string) types.Dependency {
+		return types.Dependency{
+			Manager: types.Bundler,
+			Name:    name,
+			Version: version,
+			Locked:  true,
+			Dev:     dev,
+			Group:   group,
+			Definition: types.Definition{
+				Path:    testPath,
+				RawLine: rawLine,
+				Line:    line,
+			},
+		}
+	}
+
+	expected := []types.Dependency{
+		dependency("rails", "7.1.4", false, "", 5, `gem "rails", "~> 7.1.3"`),
+		dependency("pg", "1.5.8", false, "", 6, `gem "pg", ">= 1.1", "< 2.0" # database`),
+		dependency("puma", "6.4.3", false, "", 7, `gem "puma", require: false`),
+		dependency("nokogiri", "1.16.7", false, "", 8, `gem "nokogiri"`),
+		dependency("debug", "1.9.2", true, "", 12, `gem "debug", platforms: %i[ mri windows ], group: :development`),
+		dependency("bootsnap", "1.18.4", false, "", 13, `gem "bootsnap", :require => false, :groups => [:development, :production]`),
+		dependency("rspec-rails", "6.1.5", true, "development, test", 16, `gem "rspec-rails", "~> 6.1"`),
+		dependency("byebug", "11.1.3", true, "development, test", 19, `gem "byebug"`),
+		dependency("capybara", "3.40.0", true, "test", 24, `gem "capybara"`),
+		dependency("sidekiq", "7.2.4", false, "", 31, `gem "sidekiq", "7.2.4"`),
+	}
+
+	assert.Equal(t, expected, dependencies)
+}
+
+func TestBundler_DependenciesWithoutLockfile(t *testing.T) {
+	manager := Bundler{}
+	testPath := filepath.Join("testdata", "nolock", "gems.rb")
+
+	dependencies, err := manager.Dependencies(testPath)
+	assert.NoError(t, err)
+
+	expected := []types.Dependency{
+		{
+			Manager: types.Bundler,
+			Name:    "rack",
+			Version: "3.0",
+			Definition: types.Definition{
+				Path:    testPath,
+				RawLine: `gem "rack", "~> 3.0"`,
+				Line:    3,
+			},
+		},
+	}
+
+	assert.Equal(t, expected, dependencies)
+}
+
+func TestBundler_DependenciesGroups(t *testing.T) {
+	manager := Bundler{}
+	testPath := filepath.Join("testdata", "groups", "Gemfile")
+
+	dependencies, err := manager.Dependencies(testPath)
+	assert.NoError(t, err)
+
+	var groups []string
+	for _, dep := range dependencies {
+		groups = append(groups, dep.Name+": "+dep.Group)
+	}
+
+	// Gems get the groups of their block, not the ones of their options
+	assert.Equal(t, []string{
+		"rails: ",
+		"propshaft: ",
+		"puma: ",
+		"bootsnap: ",
+		"debug: development, test",
+		"brakeman: development, test",
+		"rubocop-rails-omakase: development, test",
+		"web-console: development",
+		"capybara: test",
+		"selenium-webdriver: test",
+		"rack-mini-profiler: ",
+	}, groups)
+}
+
+func TestReadLockfile(t *testing.T) {
+	entries, err := ReadLockfile(filepath.Join("testdata", "Gemfile.lock"))
+	assert.NoError(t, err)
+
+	// Gems from GIT and PATH sections aren't published on RubyGems
+	assert.NotContains(t, entries, "rubocop-internal")
+	assert.NotContains(t, entries, "shared")
+
+	assert.Equal(t, LockEntry{
+		Name:         "nokogiri",
+		Version:      "1.16.7",
+		Platform:     "arm64-darwin",
+		Checksum:     "4b4d1bc9e94d5f0a8d7bd40b2f9a1fe4fa3a4d16b2e51b8c1ea0cbb1e5f2c4ab",
+		Dependencies: []string{"racc"},
+	}, entries["nokogiri"])
+
+	assert.Equal(t, LockEntry{
+		Name:         "rails",
+		Version:      "7.1.4",
+		Checksum:     "1d4ab2e8fc8a4be1b2e2b4d6c7b5d3d0ab7e3f5c9f1c5ab4e6f8b0ad2f3c1d4e",
+		Dependencies: []string{"actionpack", "railties"},
+	}, entries["rails"])
+}
+
+func TestBundler_LockedPackages(t *testing.T) {
+	packages, err := Bundler{}.LockedPackages(filepath.Join("testdata", "Gemfile.lock"))
+	assert.NoError(t, err)
+
+	assert.Contains(t, packages, types.LockedPackage{
+		Manager:      types.Bundler,
+		Name:         "bootsnap",
+		Version:      "1.18.4",
+		Hashes:       []types.Hash{{Algorithm: "SHA-256", Value: "1a1a0bfc2a1dd4b03ae9a4fcbd6c0b5e6a39cd5ff8b8c5fca1e4a1bc31bdd227"}},
+		Dependencies: []string{"msgpack@1.7.2"},
+	})
+
+	// Dependencies missing from the lockfile are skipped
+	assert.Contains(t, packages, types.LockedPackage{
+		Manager: types.Bundler,
+		Name:    "puma",
+		Version: "6.4.3",
+	})
+}
+
+func TestBundler_LockfilePath(t *testing.T) {
+	manager := Bundler{}
+	tests := []struct {
+		name        string
+		inputPath   string
+		expectError bool
+	}{
+		{
+			name:        "existing lockfile",
+			inputPath:   "testdata/Gemfile",
+			expectError: false,
+		},
+		{
+			name:        "missing lockfile",
+			inputPath:   "testdata/nolock/gems.rb",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lockfilePath, err := manager.LockfilePath(tt.inputPath)
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Empty(t, lockfilePath)
+			} else {
+				assert.NoError(t, err)
+				assert.NotEmpty(t, lockfilePath)
+			}
+		})
+	}
+}
+
+func TestCleanVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  string
+		expected string
+	}{
+		{
+			name:     "exact version",
+			version:  "7.2.4",
+			expected: "7.2.4",
+		},
+		{
+			name:     "pessimistic constraint",
+			version:  "~> 7.1.3",
+			expected: "7.1.3",
+		},
+		{
+			name:     "minimum version",
+			version:  ">= 1.1",
+			expected: "1.1",
+		},
+		{
+			name:     "any version",
+			version:  "",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := cleanVersion(tt.version)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,243 @@
+package bundler
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Groups that are only installed during development
+var devGroups = map[string]bool{"development": true, "test": true}
+
+// Options and blocks that point a gem to something else than RubyGems
+var sourceOptions = map[string]bool{"git": true, "github": true, "gitlab": true, "bitbucket": true, "path": true}
+
+var (
+	gemPattern   = regexp.MustCompile(`^gem[\s(]`)
+	blockPattern = regexp.MustCompile(`^(\w+)\b.*\bdo(\s*\|[^|]*\|)?$`)
+	// Ruby keywords that open a block closed by `end`
+	keywordPattern = regexp.MustCompile(`^(if|unless|case|while|until|begin|def|class|module)\b`)
+)
+
+type gemDependency struct {
+	name        string
+	requirement string
+	dev         bool
+	// The groups of the enclosing `group ... do` blocks, like "development, test"
+	group   string
+	line    int
+	rawLine string
+}
+
+// block is a `do ... end` block of the Gemfile, like `group :test do`.
+type block struct {
+	groups []string
+	// Gems declared in git, github and path blocks aren't published on RubyGems
+	external bool
+}
+
+// parseGemfile reads the gems declared in a Gemfile, skipping git and path gems.
+func parseGemfile(source string) []gemDependency {
+	var dependencies []gemDependency
+	var blocks []block
+
+	for i, rawLine := range strings.Split(source, "\n") {
+		line := strings.TrimSpace(stripComment(rawLine))
+		if line == "" {
+			continue
+		}
+
+		if line == "end" || strings.HasPrefix(line, "end ") || strings.HasPrefix(line, "end.") {
+			if len(blocks) > 0 {
+				blocks = blocks[:len(blocks)-1]
+			}
+			continue
+		}
+
+		if gemPattern.MatchString(line) {
+			if dep, ok := parseGem(line, blocks); ok {
+				dep.line = i + 1
+				dep.rawLine = strings.TrimSpace(rawLine)
+				dependencies = append(dependencies, dep)
+			}
+			continue
+		}
+
+		if matches := blockPattern.FindStringSubmatch(line); matches != nil {
+			b := block{}
+			rest := strings.TrimSpace(strings.TrimSuffix(line, matches[2]))
+			rest = strings.TrimSpace(strings.TrimSuffix(strings.TrimPrefix(rest, matches[1]), "do"))
+			args := splitArgs(strings.TrimSuffix(strings.TrimPrefix(rest, "("), ")"))
+
+			switch matches[1] {
+			case "group":
+				for _, arg := range args {
+					if _, _, ok := option(arg); !ok {
+						b.groups = append(b.groups, symbol(arg))
+					}
+				}
+			case "git", "github", "path":
+				b.external = true
+			}
+
+			blocks = append(blocks, b)
+			continue
+		}
+
+		if keywordPattern.MatchString(line) {
+			blocks = append(blocks, block{})
+		}
+	}
+
+	return dependencies
+}
+
+// parseGem parses a line like `gem "rails", "~> 7.1", require: false`.
+func parseGem(line string, blocks []block) (gemDependency, bool) {
+	line = strings.TrimSpace(strings.TrimPrefix(line, "gem"))
+	line = strings.TrimSuffix(strings.TrimPrefix(line, "("), ")")
+
+	args := splitArgs(line)
+	if len(args) == 0 || !isString(args[0]) {
+		return gemDependency{}, false
+	}
+
+	dep := gemDependency{name: unquote(args[0])}
+
+	var groups []string
+	for _, b := range blocks {
+		if b.external {
+			return gemDependency{}, false
+		}
+		groups = append(groups, b.groups...)
+	}
+
+	dep.group = strings.Join(groups, ", ")
+
+	for _, arg := range args[1:] {
+		if key, value, ok := option(arg); ok {
+			if sourceOptions[key] {
+				return gemDependency{}, false
+			}
+
+			if key == "group" || key == "groups" {
+				for _, group := range splitArgs(strings.Trim(value, "[]")) {
+					groups = append(groups, symbol(group))
+				}
+			}
+			continue
+		}
+
+		// Only the first requirement is used, like ">= 1.1" in ">= 1.1", "< 2.0"
+		if isString(arg) && dep.requirement == "" {
+			dep.requirement = unquote(arg)
+		}
+	}
+
+	dep.dev = isDevOnly(groups)
+
+	return dep, true
+}
+
+// isDevOnly checks if all the groups of a gem are only installed during development.
+func isDevOnly(groups []string) bool {
+	if len(groups) == 0 {
+		return false
+	}
+
+	for _, group := range groups {
+		if !devGroups[group] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// option splits `key: value` and `:key => value` arguments.
+func option(arg string) (key, value string, ok bool) {
+	if isString(arg) {
+		return "", "", false
+	}
+
+	if strings.HasPrefix(arg, ":") {
+		if k, v, found := strings.Cut(arg[1:], "=>"); found {
+			return strings.TrimSpace(k), strings.TrimSpace(v), true
+		}
+		return "", "", false
+	}
+
+	if k, v, found := strings.Cut(arg, ":"); found && !strings.HasPrefix(v, ":") {
+		return strings.TrimSpace(k), strings.TrimSpace(v), true
+	}
+
+	return "", "", false
+}
+
+// splitArgs splits the arguments of a method call on the commas outside of strings and brackets.
+func splitArgs(s string) []string {
+	var args []string
+	var quote rune
+	depth := 0
+	start := 0
+
+	for i, c := range s {
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '[' || c == '(' || c == '{':
+			depth++
+		case c == ']' || c == ')' || c == '}':
+			depth--
+		case c == ',' && depth == 0:
+			args = append(args, strings.TrimSpace(s[start:i]))
+			start = i + 1
+		}
+	}
+
+	if last := strings.TrimSpace(s[start:]); last != "" {
+		args = append(args, last)
+	}
+
+	return args
+}
+
+// stripComment removes a trailing comment that isn't part of a string.
+func stripComment(line string) string {
+	var quote rune
+
+	for i, c := range line {
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '#':
+			return line[:i]
+		}
+	}
+
+	return line
+}
+
+func isString(s string) bool {
+	return len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0]
+}
+
+func unquote(s string) string {
+	return s[1 : len(s)-1]
+}
+
+// symbol returns the name of a symbol or a string like :test or "test".
+func symbol(s string) string {
+	s = strings.TrimSpace(s)
+	if isString(s) {
+		return unquote(s)
+	}
+	return strings.TrimPrefix(s, ":")
+}
@@ -0,0 +1,157 @@
+package bundler
+
+import (
+	"bufio"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+// LockEntry is a gem resolved from RubyGems in Gemfile.lock.
+type LockEntry struct {
+	Name    string
+	Version string
+	// The platform of native gems like x86_64-linux, empty for pure Ruby gems
+	Platform string
+	// The SHA-256 of the gem from the CHECKSUMS section, written by Bundler 2.5 and later
+	Checksum string
+	// The names of the gems this gem depends on
+	Dependencies []string
+}
+
+// ReadLockfile parses the GEM sections of Gemfile.lock and returns the gems keyed by name.
+// Gems from GIT and PATH sections are skipped since they aren't published on RubyGems.
+func ReadLockfile(path string) (map[string]LockEntry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	entries := make(map[string]LockEntry)
+
+	var section string
+	var current string
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if line == "" {
+			continue
+		}
+
+		// Sections start at the beginning of the line
+		if !strings.HasPrefix(line, " ") {
+			section = strings.TrimSpace(line)
+			current = ""
+			continue
+		}
+
+		if section == "CHECKSUMS" {
+			// A gem checksum: "  nokogiri (1.16.7-arm64-darwin) sha256=..."
+			spec, checksum, ok := strings.Cut(strings.TrimSpace(line), " sha256=")
+			name, version := parseSpec(spec)
+
+			if entry, found := entries[name]; ok && found && entry.fullVersion() == version {
+				entry.Checksum = checksum
+				entries[name] = entry
+			}
+			continue
+		}
+
+		if section != "GEM" {
+			continue
+		}
+
+		indent := len(line) - len(strings.TrimLeft(line, " "))
+		name, version := parseSpec(strings.TrimSpace(line))
+
+		switch indent {
+		case 4:
+			// A resolved gem: "    rails (7.1.3)"
+			if version == "" {
+				continue
+			}
+
+			entry := LockEntry{Name: name, Version: version}
+			if idx := strings.Index(version, "-"); idx != -1 {
+				// Ruby versions can't contain dashes, so what follows is the platform
+				entry.Version = version[:idx]
+				entry.Platform = version[idx+1:]
+			}
+
+			// Native gems appear once per platform, keep the first one
+			if _, ok := entries[name]; !ok {
+				entries[name] = entry
+			}
+			current = name
+		case 6:
+			// A dependency of the current gem: "      actionpack (= 7.1.3)"
+			if entry, ok := entries[current]; ok && current != "" {
+				if !slices.Contains(entry.Dependencies, name) {
+					entry.Dependencies = append(entry.Dependencies, name)
+					entries[current] = entry
+				}
+			}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// fullVersion returns the version with the platform, like 1.16.7-x86_64-linux.
+func (e LockEntry) fullVersion() string {
+	if e.Platform == "" {
+		return e.Version
+	}
+	return e.Version + "-" + e.Platform
+}
+
+// LockedPackages returns the gems of Gemfile.lock.
+func (Bundler) LockedPackages(lockfilePath string) ([]types.LockedPackage, error) {
+	lock, err := ReadLockfile(lockfilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var packages []types.LockedPackage
+
+	for _, entry := range lock {
+		pkg := types.LockedPackage{
+			Manager: types.Bundler,
+			Name:    entry.Name,
+			Version: entry.Version,
+		}
+
+		if entry.Checksum != "" {
+			pkg.Hashes = []types.Hash{{Algorithm: "SHA-256", Value: entry.Checksum}}
+		}
+
+		for _, name := range entry.Dependencies {
+			if dep, ok := lock[name]; ok {
+				pkg.Dependencies = append(pkg.Dependencies, types.LockedID(dep.Name, dep.Version))
+			}
+		}
+
+		packages = append(packages, pkg)
+	}
+
+	return packages, nil
+}
+
+// parseSpec splits "name (version)" into its parts.
+func parseSpec(spec string) (name, version string) {
+	name, rest, ok := strings.Cut(spec, " (")
+	if !ok {
+		return strings.TrimSuffix(spec, "!"), ""
+	}
+
+	return name, strings.TrimSuffix(rest, ")")
+}
@@ -0,0 +1,3 @@
+source "https://rubygems.org"
+
+gem "rack", "~> 3.0"
@@ -16,6 +16,9 @@ import (
 type DependencyValue struct {
 	Version string
 	Path    string
+	// The name of the package when the dependency is renamed, like
+	// `log2 = { package = "log", version = "0.4" }`
+	Package string
 }
 
 // UnmarshalTOML implements the interface for handling both string and table TOML values
@@ -30,18 +33,28 @@ func (d *DependencyValue) UnmarshalTOML(v interface{}) error {
 		if path, ok := val["path"].(string); ok {
 			d.Path = path
 		}
+		if pkg, ok := val["package"].(string); ok {
+			d.Package = pkg
+		}
 	}
 	return nil
 }
 
 type Cargo struct{}
 
-type CargoTOML struct {
+// DependencyTables are the dependency tables of Cargo.toml, also used per target
+type DependencyTables struct {
 	Dependencies      map[string]DependencyValue `toml:"dependencies"`
 	DevDependencies   map[string]DependencyValue `toml:"dev-dependencies"`
 	BuildDependencies map[string]DependencyValue `toml:"build-dependencies"`
 }
 
+type CargoTOML struct {
+	DependencyTables
+	// The platform specific dependencies, keyed by target like cfg(windows)
+	Target map[string]DependencyTables `toml:"target"`
+}
+
 func (Cargo) GetType() types.ManagerType {
 	return types.Cargo
 }
@@ -64,54 +77,42 @@ func (Cargo) Dependencies(path string) ([]types.Dependency, error) {
 		return nil, err
 	}
 
-	// Add regular dependencies
-	for name, version := range cargoTOML.Dependencies {
-		line, rawLine := findLineInfo(file, name)
-		dependencies = append(dependencies, types.Dependency{
-			Manager: types.Cargo,
-			Name:    name,
-			//  TODO We should use the version from the lockfile instead
-			Version: cleanVersion(version.Version),
-			Dev:     false,
-			Definition: types.Definition{
-				Path:    path,
-				RawLine: rawLine,
-				Line:    line,
-			},
-		})
+	add := func(packages map[string]DependencyValue, group string, dev bool) {
+		for key, value := range packages {
+			// Renamed dependencies are declared with their key
+			name := key
+			if value.Package != "" {
+				name = value.Package
+			}
+
+			line, rawLine := findLineInfo(file, key)
+			dependencies = append(dependencies, types.Dependency{
+				Manager: types.Cargo,
+				Name:    name,
+				//  TODO We should use the version from the lockfile instead
+				Version:    cleanVersion(value.Version),
+				Constraint: value.Version,
+				Dev:        dev,
+				Group:      group,
+				Definition: types.Definition{
+					Path:    path,
+					RawLine: rawLine,
+					Line:    line,
+				},
+			})
+		}
 	}
 
-	// Add dev dependencies
-	for name, version := range cargoTOML.DevDependencies {
-		line, rawLine := findLineInfo(file, name)
-		dependencies = append(dependencies, types.Dependency{
-			Manager: types.Cargo,
-			Name:    name,
-			//  TODO We should use the version from the lockfile instead
-			Version: cleanVersion(version.Version),
-			Dev:     true,
-			Definition: types.Definition{
-				Path:    path,
-				RawLine: rawLine,
-				Line:    line,
-			},
-		})
-	}
+	add(cargoTOML.Dependencies, "", false)
+	add(cargoTOML.DevDependencies, "", true)
+	add(cargoTOML.BuildDependencies, "", true)
 
-	for name, version := range cargoTOML.BuildDependencies {
-		line, rawLine := findLineInfo(file, name)
-		dependencies = append(dependencies, types.Dependency{
-			Manager: types.Cargo,
-			Name:    name,
-			//  TODO We should use the version from the lockfile instead
-			Version: cleanVersion(version.Version),
-			Dev:     true,
-			Definition: types.Definition{
-				Path:    path,
-				RawLine: rawLine,
-				Line:    line,
-			},
-		})
+	// The dependencies of each platform are a group, like target.cfg(windows).dependencies
+	for target, tables := range cargoTOML.Target {
+		prefix := "target." + target + "."
+		add(tables.Dependencies, prefix+"dependencies", false)
+		add(tables.DevDependencies, prefix+"dev-dependencies", true)
+		add(tables.BuildDependencies, prefix+"build-dependencies", true)
 	}
 
 	// Some of the rules require the original order of dependencies
@@ -124,7 +125,7 @@ func (Cargo) Dependencies(path string) ([]types.Dependency, error) {
 }
 
 func (Cargo) LockfilePath(path string) (string, error) {
-	lockfilePath := filepath.Join(filepath.Dir(path), "package-lock.json")
+	lockfilePath := filepath.Join(filepath.Dir(path), "Cargo.lock")
 
 	if _, err := os.Stat(lockfilePath); os.IsNotExist(err) {
 		return "", fmt.Errorf("lockfile not found")
@@ -2,6 +2,7 @@ package cargo
 
 import (
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 
@@ -54,6 +55,7 @@ func TestCargo_Dependencies(t *testing.T) {
 		dep           string
 		version       string
 		isDev         bool
+		group         string
 		line          int
 		containsInRaw string // String that should be present in RawLine
 	}{
@@ -73,6 +75,32 @@ func TestCargo_Dependencies(t *testing.T) {
 			isDev:         false,
 			containsInRaw: `serde = "1.0"`,
 		},
+		{
+			name:          "renamed dependency",
+			dep:           "log",
+			line:          26,
+			version:       "0.4",
+			isDev:         false,
+			containsInRaw: `log2 = { package = "log"`,
+		},
+		{
+			name:          "platform specific dependency",
+			dep:           "winapi",
+			line:          29,
+			version:       "0.3.9",
+			isDev:         false,
+			group:         "target.cfg(windows).dependencies",
+			containsInRaw: `winapi = { version = "0.3.9"`,
+		},
+		{
+			name:          "platform specific dev dependency",
+			dep:           "nix",
+			line:          32,
+			version:       "0.29",
+			isDev:         true,
+			group:         "target.cfg(unix).dev-dependencies",
+			containsInRaw: `nix = "0.29"`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -90,6 +118,9 @@ func TestCargo_Dependencies(t *testing.T) {
 					if dep.Dev != tt.isDev {
 						t.Errorf("Expected Dev=%v for %s, got %v", tt.isDev, tt.dep, dep.Dev)
 					}
+					if dep.Group != tt.group {
+						t.Errorf("Expected Group=%q for %s, got %q", tt.group, tt.dep, dep.Group)
+					}
 					if !strings.Contains(dep.RawLine, tt.containsInRaw) {
 						t.Errorf("Expected RawLine to contain '%s', got '%s'", tt.containsInRaw, dep.RawLine)
 					}
@@ -159,6 +190,14 @@ func TestCargo_LockfilePath(t *testing.T) {
 	if err == nil {
 		t.Error("Expected error for non-existent lockfile, got nil")
 	}
+
+	lockfilePath, err := cargo.LockfilePath(filepath.Join("testdata", "workspace", "Cargo.toml"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if want := filepath.Join("testdata", "workspace", "Cargo.lock"); lockfilePath != want {
+		t.Errorf("Cargo.LockfilePath() = %v, want %v", lockfilePath, want)
+	}
 }
 
 func TestCleanVersion(t *testing.T) {
@@ -180,3 +219,46 @@ func TestCleanVersion(t *testing.T) {
 		})
 	}
 }
+
+func TestCargo_LockedPackages(t *testing.T) {
+	packages, err := Cargo{}.LockedPackages(filepath.Join("testdata", "workspace", "Cargo.lock"))
+	if err != nil {
+		t.Fatalf("Failed to parse lockfile: %v", err)
+	}
+
+	if len(packages) != 8 {
+		t.Fatalf("Expected 8 packages, got %d", len(packages))
+	}
+
+	// Only the dependencies of the package of Cargo.toml are direct
+	expected := map[string]types.LockedPackage{
+		"rand@0.8.5": {
+			Manager:      types.Cargo,
+			Name:         "rand",
+			Version:      "0.8.5",
+			Hashes:       []types.Hash{{Algorithm: "SHA-256", Value: "1c1c65e8f2de96f1f1dd8a3b574871477a13cc8fbd46b591e988206170735238"}},
+			Dependencies: []string{"getrandom@0.2.15", "libc@0.2.159"},
+			Direct:       true,
+		},
+		"rand@0.7.3": {
+			Manager:      types.Cargo,
+			Name:         "rand",
+			Version:      "0.7.3",
+			Hashes:       []types.Hash{{Algorithm: "SHA-256", Value: "0c328bc5d1c671f6d3bbd36cee4daea4d7227ef429b7863c51f62211090406a8"}},
+			Dependencies: []string{"libc@0.2.159"},
+		},
+		"legacy@0.1.0": {
+			Manager:      types.Cargo,
+			Name:         "legacy",
+			Version:      "0.1.0",
+			Dependencies: []string{"rand@0.7.3"},
+			Direct:       true,
+		},
+	}
+
+	for _, pkg := range packages {
+		if want, ok := expected[pkg.ID()]; ok && !reflect.DeepEqual(pkg, want) {
+			t.Errorf("Expected %+v, got %+v", want, pkg)
+		}
+	}
+}
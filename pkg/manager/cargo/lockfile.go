@@ -0,0 +1,128 @@
+package cargo
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+// LockPackage is a package resolved in Cargo.lock.
+type LockPackage struct {
+	Name    string `toml:"name"`
+	Version string `toml:"version"`
+	// Source is empty for the packages of the workspace and path dependencies
+	Source string `toml:"source"`
+	// The SHA-256 of the crate, for registry packages
+	Checksum string `toml:"checksum"`
+	// The dependencies as "name", or "name version (source)" when several
+	// versions of the package are used
+	Dependencies []string `toml:"dependencies"`
+}
+
+type cargoLock struct {
+	Packages []LockPackage `toml:"package"`
+}
+
+// LockedPackages returns the packages of Cargo.lock. The dependencies of the
+// package of the Cargo.toml next to it are recorded as direct, or the ones of
+// all the packages of the workspace for virtual manifests.
+func (Cargo) LockedPackages(lockfilePath string) ([]types.LockedPackage, error) {
+	file, err := os.ReadFile(lockfilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var lock cargoLock
+	if err := toml.Unmarshal(file, &lock); err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", lockfilePath, err)
+	}
+
+	versions := make(map[string][]string)
+	for _, entry := range lock.Packages {
+		versions[entry.Name] = append(versions[entry.Name], entry.Version)
+	}
+
+	root := rootPackage(filepath.Join(filepath.Dir(lockfilePath), "Cargo.toml"))
+	if !slices.ContainsFunc(lock.Packages, func(p LockPackage) bool { return p.Source == "" && p.Name == root }) {
+		root = ""
+	}
+
+	direct := make(map[string]bool)
+	for _, entry := range lock.Packages {
+		if entry.Source != "" || (root != "" && entry.Name != root) {
+			continue
+		}
+
+		for _, dep := range entry.Dependencies {
+			if id, ok := resolveLocked(dep, versions); ok {
+				direct[id] = true
+			}
+		}
+	}
+
+	var packages []types.LockedPackage
+
+	for _, entry := range lock.Packages {
+		pkg := types.LockedPackage{
+			Manager: types.Cargo,
+			Name:    entry.Name,
+			Version: entry.Version,
+			Direct:  direct[types.LockedID(entry.Name, entry.Version)],
+		}
+
+		if entry.Checksum != "" {
+			pkg.Hashes = []types.Hash{{Algorithm: "SHA-256", Value: entry.Checksum}}
+		}
+
+		for _, dep := range entry.Dependencies {
+			if id, ok := resolveLocked(dep, versions); ok {
+				pkg.Dependencies = append(pkg.Dependencies, id)
+			}
+		}
+
+		packages = append(packages, pkg)
+	}
+
+	return packages, nil
+}
+
+// rootPackage returns the name of the package of a Cargo.toml, or an empty
+// string for virtual manifests.
+func rootPackage(path string) string {
+	var manifest struct {
+		Package struct {
+			Name string `toml:"name"`
+		} `toml:"package"`
+	}
+
+	if _, err := toml.DecodeFile(path, &manifest); err != nil {
+		return ""
+	}
+
+	return manifest.Package.Name
+}
+
+// resolveLocked returns the ID of the package a dependency of Cargo.lock refers to.
+func resolveLocked(dep string, versions map[string][]string) (string, bool) {
+	fields := strings.Fields(dep)
+	if len(fields) == 0 {
+		return "", false
+	}
+
+	name := fields[0]
+	if len(fields) > 1 {
+		return types.LockedID(name, fields[1]), true
+	}
+
+	// The version is only written when it is ambiguous
+	if len(versions[name]) != 1 {
+		return "", false
+	}
+
+	return types.LockedID(name, versions[name][0]), true
+}
@@ -0,0 +1,191 @@
+package composer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+type Composer struct{}
+
+type ComposerJSON struct {
+	Require    map[string]string `json:"require"`
+	RequireDev map[string]string `json:"require-dev"`
+}
+
+type LockPackage struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type ComposerLock struct {
+	Packages    []LockPackage `json:"packages"`
+	PackagesDev []LockPackage `json:"packages-dev"`
+}
+
+func (Composer) GetType() types.ManagerType {
+	return types.Composer
+}
+
+func (Composer) Managed(path string) bool {
+	return filepath.Base(path) == "composer.json"
+}
+
+func (Composer) Dependencies(path string) ([]types.Dependency, error) {
+	var dependencies []types.Dependency
+
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var composerJSON ComposerJSON
+	if err := json.Unmarshal(file, &composerJSON); err != nil {
+		return nil, err
+	}
+
+	sections := []struct {
+		name     string
+		packages map[string]string
+		dev      bool
+	}{
+		{name: "require", packages: composerJSON.Require, dev: false},
+		{name: "require-dev", packages: composerJSON.RequireDev, dev: true},
+	}
+
+	for _, section := range sections {
+		for name, version := range section.packages {
+			if isPlatformPackage(name) {
+				continue
+			}
+
+			line, rawLine := findLineInfo(file, section.name, name)
+			dependencies = append(dependencies, types.Dependency{
+				Manager: types.Composer,
+				Name:    name,
+				Version: cleanVersion(version),
+				Dev:     section.dev,
+				Definition: types.Definition{
+					Path:    path,
+					RawLine: rawLine,
+					Line:    line,
+				},
+			})
+		}
+	}
+
+	// Some of the rules require the original order of dependencies
+	// Sort dependencies by line number
+	sort.Slice(dependencies, func(i, j int) bool {
+		return dependencies[i].Line < dependencies[j].Line
+	})
+
+	// Use the versions from composer.lock when there is one, or keep the declared ones
+	if lockfilePath, err := (Composer{}).LockfilePath(path); err == nil {
+		locked, err := readLockfile(lockfilePath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading lockfile %s: %s\n", lockfilePath, err)
+		}
+
+		for i, dep := range dependencies {
+			if version, ok := locked[strings.ToLower(dep.Name)]; ok {
+				dependencies[i].Version = version
+			}
+		}
+	}
+
+	return dependencies, nil
+}
+
+// isPlatformPackage checks for packages provided by the system like php, ext-json or lib-curl.
+// Packages installed from Packagist always have a vendor prefix.
+func isPlatformPackage(name string) bool {
+	return !strings.Contains(name, "/")
+}
+
+// readLockfile returns the locked versions keyed by lowercase package name.
+func readLockfile(path string) (map[string]string, error) {
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var lock ComposerLock
+	if err := json.Unmarshal(file, &lock); err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+
+	locked := make(map[string]string)
+	for _, pkg := range append(lock.Packages, lock.PackagesDev...) {
+		locked[strings.ToLower(pkg.Name)] = strings.TrimPrefix(pkg.Version, "v")
+	}
+
+	return locked, nil
+}
+
+func (Composer) LockfilePath(path string) (string, error) {
+	lockfilePath := filepath.Join(filepath.Dir(path), "composer.lock")
+
+	if _, err := os.Stat(lockfilePath); os.IsNotExist(err) {
+		return "", fmt.Errorf("lockfile not found")
+	}
+
+	return lockfilePath, nil
+}
+
+// Returns the version without any prefix or suffix
+func cleanVersion(version string) string {
+	// Use the first constraint of "^1.0 || ^2.0" and ">=1.0 <2.0"
+	for _, separator := range []string{"|", ",", " "} {
+		version = strings.TrimSpace(version)
+		if idx := strings.Index(version, separator); idx != -1 {
+			version = version[:idx]
+		}
+	}
+
+	// Remove stability flags like 1.0@beta
+	if idx := strings.Index(version, "@"); idx != -1 {
+		version = version[:idx]
+	}
+
+	// Wildcards like 1.0.* match the lowest version
+	version = strings.TrimSuffix(version, ".*")
+
+	return strings.TrimLeft(version, "v^~*><=! ")
+}
+
+func findLineInfo(data []byte, section string, key string) (line int, rawLine string) {
+	lines := bytes.Split(data, []byte{'\n'})
+	inSection := false
+	quotedSection := []byte(`"` + section + `"`)
+	quotedKey := []byte(`"` + key + `"`)
+
+	for i, line := range lines {
+		trimmed := bytes.TrimSpace(line)
+
+		// Check if we're entering the right section
+		if bytes.HasPrefix(trimmed, quotedSection) {
+			inSection = true
+			continue
+		}
+
+		// Check if we're leaving the section
+		if inSection && bytes.HasPrefix(trimmed, []byte("}")) {
+			inSection = false
+			continue
+		}
+
+		// Look for our key while in the correct section
+		if inSection && bytes.HasPrefix(trimmed, quotedKey) {
+			return i + 1, string(trimmed)
+		}
+	}
+
+	return 0, ""
+}
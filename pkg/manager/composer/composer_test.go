@@ -0,0 +1,180 @@
+package composer
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/depshubhq/depshub/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComposer_GetType(t *testing.T) {
+	manager := Composer{}
+	assert.Equal(t, types.Composer, manager.GetType())
+}
+
+func TestComposer_Managed(t *testing.T) {
+	manager := Composer{}
+	tests := []struct {
+		name     string
+		path     string
+		expected bool
+	}{
+		{
+			name:     "composer.json",
+			path:     "path/to/composer.json",
+			expected: true,
+		},
+		{
+			name:     "composer.lock",
+			path:     "path/to/composer.lock",
+			expected: false,
+		},
+		{
+			name:     "package.json",
+			path:     "path/to/package.json",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := manager.Managed(tt.path)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestComposer_Dependencies(t *testing.T) {
+	manager := Composer{}
+
+	dependency := func(path, name, version string, dev bool, line int, rawLine string) types.Dependency {
+		return types.Dependency{
+			Manager: types.Composer,
+			Name:    name,
+			Version: version,
+			Dev:     dev,
+			Definition: types.Definition{
+				Path:    path,
+				RawLine: rawLine,
+				Line:    line,
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		path     string
+		expected func(path string) []types.Dependency
+	}{
+		{
+			name: "with lockfile",
+			path: filepath.Join("testdata", "composer.json"),
+			expected: func(path string) []types.Dependency {
+				return []types.Dependency{
+					dependency(path, "laravel/framework", "11.26.0", false, 7, `"laravel/framework": "^11.0",`),
+					dependency(path, "guzzlehttp/guzzle", "7.9.2", false, 8, `"guzzlehttp/guzzle": "^7.2 || ^6.5",`),
+					dependency(path, "Monolog/Monolog", "3.7.0", false, 9, `"Monolog/Monolog": "~3.0",`),
+					dependency(path, "symfony/console", "7.1.5", false, 10, `"symfony/console": "7.1.*"`),
+					dependency(path, "phpunit/phpunit", "11.3.6", true, 13, `"phpunit/phpunit": "^11.0.1",`),
+					dependency(path, "mockery/mockery", "1.6", true, 14, `"mockery/mockery": "^1.6@dev"`),
+				}
+			},
+		},
+		{
+			name: "without lockfile",
+			path: filepath.Join("testdata", "nolock", "composer.json"),
+			expected: func(path string) []types.Dependency {
+				return []types.Dependency{
+					dependency(path, "laravel/framework", "11.0", false, 7, `"laravel/framework": "^11.0",`),
+					dependency(path, "guzzlehttp/guzzle", "7.2", false, 8, `"guzzlehttp/guzzle": "^7.2 || ^6.5",`),
+					dependency(path, "Monolog/Monolog", "3.0", false, 9, `"Monolog/Monolog": "~3.0",`),
+					dependency(path, "symfony/console", "7.1", false, 10, `"symfony/console": "7.1.*"`),
+					dependency(path, "phpunit/phpunit", "11.0.1", true, 13, `"phpunit/phpunit": "^11.0.1",`),
+					dependency(path, "mockery/mockery", "1.6", true, 14, `"mockery/mockery": "^1.6@dev"`),
+				}
+			},
+		},
+		{
+			// The declared versions are kept when composer.lock can't be read
+			name: "with invalid lockfile",
+			path: filepath.Join("testdata", "invalidlock", "composer.json"),
+			expected: func(path string) []types.Dependency {
+				return []types.Dependency{
+					dependency(path, "laravel/framework", "11.0", false, 7, `"laravel/framework": "^11.0",`),
+					dependency(path, "guzzlehttp/guzzle", "7.2", false, 8, `"guzzlehttp/guzzle": "^7.2 || ^6.5",`),
+					dependency(path, "Monolog/Monolog", "3.0", false, 9, `"Monolog/Monolog": "~3.0",`),
+					dependency(path, "symfony/console", "7.1", false, 10, `"symfony/console": "7.1.*"`),
+					dependency(path, "phpunit/phpunit", "11.0.1", true, 13, `"phpunit/phpunit": "^11.0.1",`),
+					dependency(path, "mockery/mockery", "1.6", true, 14, `"mockery/mockery": "^1.6@dev"`),
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dependencies, err := manager.Dependencies(tt.path)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected(tt.path), dependencies)
+		})
+	}
+}
+
+func TestComposer_LockfilePath(t *testing.T) {
+	manager := Composer{}
+	tests := []struct {
+		name        string
+		inputPath   string
+		expectError bool
+	}{
+		{
+			name:        "existing lockfile",
+			inputPath:   "testdata/composer.json",
+			expectError: false,
+		},
+		{
+			name:        "missing lockfile",
+			inputPath:   "testdata/nolock/composer.json",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lockfilePath, err := manager.LockfilePath(tt.inputPath)
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Empty(t, lockfilePath)
+			} else {
+				assert.NoError(t, err)
+				assert.NotEmpty(t, lockfilePath)
+			}
+		})
+	}
+}
+
+func TestCleanVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  string
+		expected string
+	}{
+		{name: "caret", version: "^11.0", expected: "11.0"},
+		{name: "tilde", version: "~3.0", expected: "3.0"},
+		{name: "alternatives", version: "^7.2 || ^6.5", expected: "7.2"},
+		{name: "range", version: ">=1.0 <2.0", expected: "1.0"},
+		{name: "wildcard", version: "7.1.*", expected: "7.1"},
+		{name: "any", version: "*", expected: ""},
+		{name: "stability flag", version: "^1.6@dev", expected: "1.6"},
+		{name: "branch", version: "dev-main", expected: "dev-main"},
+		{name: "prefixed", version: "v2.1.0", expected: "2.1.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := cleanVersion(tt.version)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
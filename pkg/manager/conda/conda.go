@@ -0,0 +1,203 @@
+package conda
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/depshubhq/depshub/pkg/manager/pylock"
+	"github.com/depshubhq/depshub/pkg/manager/pyproject"
+	"github.com/depshubhq/depshub/pkg/types"
+	"gopkg.in/yaml.v3"
+)
+
+// The channel of environments without channels
+const DefaultChannel = "defaults"
+
+// Matches match specs like `numpy`, `numpy=1.26`, `numpy >=1.26,<2` and `conda-forge::numpy==1.26.4=py312h`
+var specPattern = regexp.MustCompile(`^(?:([^:\s]+)::)?([A-Za-z0-9_][A-Za-z0-9_.-]*)\s*(.*)$`)
+
+// Matches pip packages installed from VCS or URLs like git+https://github.com/...
+var urlPattern = regexp.MustCompile(`^[A-Za-z+]+://`)
+
+type Conda struct{}
+
+func (Conda) GetType() types.ManagerType {
+	return types.Conda
+}
+
+func (Conda) Managed(path string) bool {
+	base := filepath.Base(path)
+	return base == "environment.yml" || base == "environment.yaml"
+}
+
+// Dependencies returns the conda packages named after their channel, like
+// conda-forge/numpy, and the packages of the nested pip list. The pip packages
+// are checked with PyPI like the ones of requirements.txt.
+func (Conda) Dependencies(path string) ([]types.Dependency, error) {
+	var dependencies []types.Dependency
+
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var document yaml.Node
+	if err := yaml.Unmarshal(file, &document); err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+
+	if len(document.Content) == 0 {
+		return nil, nil
+	}
+
+	root := document.Content[0]
+	lines := strings.Split(string(file), "\n")
+
+	add := func(manager types.ManagerType, name, version string, line int) {
+		dependencies = append(dependencies, types.Dependency{
+			Manager: manager,
+			Name:    name,
+			Version: version,
+			Definition: types.Definition{
+				Path:    path,
+				RawLine: strings.TrimSpace(lines[line-1]),
+				Line:    line,
+			},
+		})
+	}
+
+	// Packages without a channel come from the first channel of the environment
+	channel := DefaultChannel
+	if channels := mappingValue(root, "channels"); channels != nil && channels.Kind == yaml.SequenceNode {
+		for _, c := range channels.Content {
+			if c.Value != "nodefaults" {
+				channel = c.Value
+				break
+			}
+		}
+	}
+
+	specs := mappingValue(root, "dependencies")
+	if specs == nil || specs.Kind != yaml.SequenceNode {
+		return nil, nil
+	}
+
+	for _, spec := range specs.Content {
+		switch spec.Kind {
+		case yaml.ScalarNode:
+			matches := specPattern.FindStringSubmatch(strings.TrimSpace(spec.Value))
+			if matches == nil {
+				continue
+			}
+
+			packageChannel := channel
+			if matches[1] != "" {
+				packageChannel = matches[1]
+			}
+
+			add(types.Conda, PackageName(packageChannel, matches[2]), cleanVersion(matches[3]), spec.Line)
+		case yaml.MappingNode:
+			requirements := mappingValue(spec, "pip")
+			if requirements == nil || requirements.Kind != yaml.SequenceNode {
+				continue
+			}
+
+			for _, requirement := range requirements.Content {
+				// Skip options like -r requirements.txt and packages from VCS or URLs
+				if strings.HasPrefix(requirement.Value, "-") || urlPattern.MatchString(requirement.Value) {
+					continue
+				}
+
+				name, version, ok := pyproject.ParseRequirement(requirement.Value)
+				if !ok {
+					continue
+				}
+
+				add(types.Pip, name, version, requirement.Line)
+			}
+		}
+	}
+
+	// Use the versions from conda-lock.yml when there is one, or keep the declared ones
+	if lockfilePath, err := (Conda{}).LockfilePath(path); err == nil {
+		lock, err := ReadLockfile(lockfilePath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading lockfile %s: %s\n", lockfilePath, err)
+		}
+
+		for i, dep := range dependencies {
+			var entry LockEntry
+			var ok bool
+
+			if dep.Manager == types.Pip {
+				entry, ok = lock.Pip[pylock.Normalize(dep.Name)]
+			} else {
+				entry, ok = lock.Conda[dep.Name[strings.LastIndex(dep.Name, "/")+1:]]
+			}
+
+			if ok {
+				dependencies[i].Version = entry.Version
+			}
+		}
+	}
+
+	return dependencies, nil
+}
+
+// PackageName returns the name of a conda package including its channel, like
+// conda-forge/numpy, since channels publish different builds of the same packages.
+// Channels can also be URLs like https://conda.example.com/internal.
+func PackageName(channel string, name string) string {
+	return strings.TrimSuffix(channel, "/") + "/" + strings.ToLower(name)
+}
+
+// mappingValue returns the value of key in a YAML mapping, if any.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+
+	return nil
+}
+
+func (Conda) LockfilePath(path string) (string, error) {
+	lockfilePath := filepath.Join(filepath.Dir(path), "conda-lock.yml")
+
+	if _, err := os.Stat(lockfilePath); os.IsNotExist(err) {
+		return "", fmt.Errorf("lockfile not found")
+	}
+
+	return lockfilePath, nil
+}
+
+// Returns the version without any prefix or suffix
+func cleanVersion(version string) string {
+	version = strings.TrimSpace(version)
+
+	// Use the first constraint of ">=1.26,<2" and "1.26|1.27"
+	for _, separator := range []string{",", "|"} {
+		if idx := strings.Index(version, separator); idx != -1 {
+			version = version[:idx]
+		}
+	}
+
+	// Remove the build string of "=1.26.4=py312h8753938_0" and "1.26.4 py312h8753938_0"
+	version = strings.TrimLeft(version, "=")
+	if idx := strings.IndexAny(version, "= "); idx != -1 && strings.Trim(version[:idx], "<>!~") != "" {
+		version = version[:idx]
+	}
+
+	// Wildcards like 1.26.* match the lowest version
+	version = strings.TrimSuffix(strings.TrimSuffix(version, "*"), ".")
+
+	return strings.TrimSpace(strings.TrimLeft(version, "<>=!~ "))
+}
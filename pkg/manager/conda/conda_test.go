@@ -0,0 +1,177 @@
+package conda
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/depshubhq/depshub/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConda_GetType(t *testing.T) {
+	manager := Conda{}
+	assert.Equal(t, types.Conda, manager.GetType())
+}
+
+func TestConda_Managed(t *testing.T) {
+	manager := Conda{}
+	tests := []struct {
+		name     string
+		path     string
+		expected bool
+	}{
+		{name: "environment.yml", path: "path/to/environment.yml", expected: true},
+		{name: "environment.yaml", path: "path/to/environment.yaml", expected: true},
+		{name: "lockfile", path: "path/to/conda-lock.yml", expected: false},
+		{name: "other yaml file", path: "path/to/meta.yaml", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := manager.Managed(tt.path)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestConda_Dependencies(t *testing.T) {
+	manager := Conda{}
+
+	dependency := func(path string, manager types.ManagerType, name, version string, line int, rawLine string) types.Dependency {
+		return types.Dependency{
+			Manager: manager,
+			Name:    name,
+			Version: version,
+			Definition: types.Definition{
+				Path:    path,
+				RawLine: rawLine,
+				Line:    line,
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		path     string
+		expected func(path string) []types.Dependency
+	}{
+		{
+			name: "with lockfile",
+			path: filepath.Join("testdata", "environment.yml"),
+			expected: func(path string) []types.Dependency {
+				return []types.Dependency{
+					dependency(path, types.Conda, "conda-forge/python", "3.11.9", 6, "- python=3.11"),
+					dependency(path, types.Conda, "conda-forge/numpy", "1.26.4", 7, "- numpy>=1.26,<2"),
+					dependency(path, types.Conda, "conda-forge/pandas", "2.2.2", 8, "- pandas==2.2.2=py311h14de704_1"),
+					dependency(path, types.Conda, "bioconda/samtools", "1.20", 9, "- bioconda::samtools 1.20.*"),
+					dependency(path, types.Conda, "conda-forge/scikit-learn", "", 10, "- scikit-learn"),
+					dependency(path, types.Conda, "conda-forge/pip", "", 11, "- pip"),
+					dependency(path, types.Pip, "requests", "2.31.0", 13, "- requests==2.31.0"),
+					dependency(path, types.Pip, "polars", "0.20.31", 14, "- polars[pyarrow] >=0.20"),
+				}
+			},
+		},
+		{
+			name: "without channels and lockfile",
+			path: filepath.Join("testdata", "nolock", "environment.yaml"),
+			expected: func(path string) []types.Dependency {
+				return []types.Dependency{
+					dependency(path, types.Conda, "defaults/sphinx", "7.3", 3, "- sphinx=7.3"),
+					dependency(path, types.Conda, "conda-forge/myst-parser", "", 4, "- conda-forge::myst-parser"),
+				}
+			},
+		},
+		{
+			// The declared versions are kept when conda-lock.yml can't be read
+			name: "with invalid lockfile",
+			path: filepath.Join("testdata", "invalidlock", "environment.yaml"),
+			expected: func(path string) []types.Dependency {
+				return []types.Dependency{
+					dependency(path, types.Conda, "defaults/sphinx", "7.3", 3, "- sphinx=7.3"),
+					dependency(path, types.Conda, "conda-forge/myst-parser", "", 4, "- conda-forge::myst-parser"),
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dependencies, err := manager.Dependencies(tt.path)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected(tt.path), dependencies)
+		})
+	}
+}
+
+func TestReadLockfile(t *testing.T) {
+	lock, err := ReadLockfile(filepath.Join("testdata", "conda-lock.yml"))
+	assert.NoError(t, err)
+
+	// The first platform wins
+	assert.Equal(t, LockEntry{
+		Name:         "numpy",
+		Version:      "1.26.4",
+		Platform:     "linux-64",
+		Dependencies: []string{"libblas", "python"},
+	}, lock.Conda["numpy"])
+
+	assert.Equal(t, "0.20.31", lock.Pip["polars"].Version)
+	assert.NotContains(t, lock.Conda, "requests")
+}
+
+func TestConda_LockfilePath(t *testing.T) {
+	manager := Conda{}
+	tests := []struct {
+		name        string
+		inputPath   string
+		expected    string
+		expectError bool
+	}{
+		{
+			name:      "existing lockfile",
+			inputPath: filepath.Join("testdata", "environment.yml"),
+			expected:  filepath.Join("testdata", "conda-lock.yml"),
+		},
+		{
+			name:        "missing lockfile",
+			inputPath:   filepath.Join("testdata", "nolock", "environment.yaml"),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lockfilePath, err := manager.LockfilePath(tt.inputPath)
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Empty(t, lockfilePath)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, lockfilePath)
+			}
+		})
+	}
+}
+
+func TestCleanVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  string
+		expected string
+	}{
+		{name: "fuzzy", version: "=3.11", expected: "3.11"},
+		{name: "exact with build", version: "==2.2.2=py311h14de704_1", expected: "2.2.2"},
+		{name: "range", version: ">=1.26,<2", expected: "1.26"},
+		{name: "range with space", version: ">= 1.26", expected: "1.26"},
+		{name: "wildcard", version: "1.20.*", expected: "1.20"},
+		{name: "version and build", version: "1.26.4 py311h64a7726_0", expected: "1.26.4"},
+		{name: "any", version: "", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := cleanVersion(tt.version)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
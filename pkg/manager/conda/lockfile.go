@@ -0,0 +1,82 @@
+package conda
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/depshubhq/depshub/pkg/manager/pylock"
+	"gopkg.in/yaml.v3"
+)
+
+// LockEntry is a package resolved in conda-lock.yml.
+type LockEntry struct {
+	Name    string
+	Version string
+	// The platform the package was resolved for, like linux-64
+	Platform string
+	// The names of the packages this package depends on
+	Dependencies []string
+}
+
+// Lockfile holds the conda and pip packages of conda-lock.yml. Conda packages
+// are keyed by name and pip packages by normalized name.
+type Lockfile struct {
+	Conda map[string]LockEntry
+	Pip   map[string]LockEntry
+}
+
+type lockfile struct {
+	Package []struct {
+		Name         string            `yaml:"name"`
+		Version      string            `yaml:"version"`
+		Manager      string            `yaml:"manager"`
+		Platform     string            `yaml:"platform"`
+		Dependencies map[string]string `yaml:"dependencies"`
+	} `yaml:"package"`
+}
+
+// ReadLockfile parses conda-lock.yml. Packages are resolved for each platform,
+// the first platform of the lockfile is used when they differ.
+func ReadLockfile(path string) (Lockfile, error) {
+	result := Lockfile{
+		Conda: make(map[string]LockEntry),
+		Pip:   make(map[string]LockEntry),
+	}
+
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return result, err
+	}
+
+	var lock lockfile
+	if err := yaml.Unmarshal(file, &lock); err != nil {
+		return result, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+
+	for _, pkg := range lock.Package {
+		entries, key := result.Conda, pkg.Name
+		if pkg.Manager == "pip" {
+			entries, key = result.Pip, pylock.Normalize(pkg.Name)
+		}
+
+		if _, ok := entries[key]; ok {
+			continue
+		}
+
+		var dependencies []string
+		for name := range pkg.Dependencies {
+			dependencies = append(dependencies, name)
+		}
+		sort.Strings(dependencies)
+
+		entries[key] = LockEntry{
+			Name:         pkg.Name,
+			Version:      pkg.Version,
+			Platform:     pkg.Platform,
+			Dependencies: dependencies,
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,210 @@
+package deno
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+type Deno struct{}
+
+type DenoJSON struct {
+	Imports map[string]string `json:"imports"`
+}
+
+func (Deno) GetType() types.ManagerType {
+	return types.Deno
+}
+
+func (Deno) Managed(path string) bool {
+	base := filepath.Base(path)
+	return base == "deno.json" || base == "deno.jsonc"
+}
+
+// Dependencies returns the JSR and npm packages of the import map. npm packages
+// are checked with the npm registry like the ones of package.json.
+func (Deno) Dependencies(path string) ([]types.Dependency, error) {
+	var dependencies []types.Dependency
+
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var denoJSON DenoJSON
+	if err := json.Unmarshal(stripJSONC(file), &denoJSON); err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+
+	// The specifier of each package without its subpath, which is how deno.lock references it
+	specifiers := make(map[string]Specifier)
+
+	for key, value := range denoJSON.Imports {
+		// Prefixes like "@std/path/" map the modules of a package already imported
+		if strings.HasSuffix(key, "/") {
+			continue
+		}
+
+		s, ok := ParseSpecifier(value)
+		if !ok {
+			continue
+		}
+
+		manager := types.Deno
+		if s.Registry == "npm" {
+			manager = types.Npm
+		}
+
+		specifiers[s.Name] = s
+
+		line, rawLine := findLineInfo(file, "imports", key)
+		dependencies = append(dependencies, types.Dependency{
+			Manager: manager,
+			Name:    s.Name,
+			Version: cleanVersion(s.Version),
+			Definition: types.Definition{
+				Path:    path,
+				RawLine: rawLine,
+				Line:    line,
+			},
+		})
+	}
+
+	// Some of the rules require the original order of dependencies
+	// Sort dependencies by line number
+	sort.Slice(dependencies, func(i, j int) bool {
+		return dependencies[i].Line < dependencies[j].Line
+	})
+
+	// Use the versions from deno.lock when there is one. The declared versions are
+	// kept when it can't be read, the scanner reports the error.
+	if lockfilePath, err := (Deno{}).LockfilePath(path); err == nil {
+		if lock, err := ReadLockfile(lockfilePath); err == nil {
+			for i, dep := range dependencies {
+				if version, ok := lock.Resolve(specifiers[dep.Name]); ok {
+					dependencies[i].Version = version
+				}
+			}
+		}
+	}
+
+	return dependencies, nil
+}
+
+// Specifier is a package imported with a jsr: or npm: specifier.
+type Specifier struct {
+	// jsr or npm
+	Registry string
+	Name     string
+	// The version constraint, empty for the latest version
+	Version string
+}
+
+// ParseSpecifier splits specifiers like jsr:@std/path@^1.0/posix or npm:chalk@5.
+// URL imports like https://deno.land/x/oak@v12.6.1/mod.ts aren't packages.
+func ParseSpecifier(specifier string) (Specifier, bool) {
+	registry, rest, found := strings.Cut(specifier, ":")
+	if !found || (registry != "jsr" && registry != "npm") {
+		return Specifier{}, false
+	}
+
+	rest = strings.TrimPrefix(rest, "/")
+
+	// Scoped packages have a second slash before the subpath
+	nameEnd := 0
+	if strings.HasPrefix(rest, "@") {
+		idx := strings.Index(rest, "/")
+		if idx == -1 {
+			return Specifier{}, false
+		}
+		nameEnd = idx + 1
+	}
+
+	end := len(rest)
+	if idx := strings.IndexAny(rest[nameEnd:], "@/"); idx != -1 {
+		end = nameEnd + idx
+	}
+
+	s := Specifier{Registry: registry, Name: rest[:end]}
+
+	if version, ok := strings.CutPrefix(rest[end:], "@"); ok {
+		// Remove the subpath of jsr:@std/path@^1.0/posix
+		if idx := strings.Index(version, "/"); idx != -1 {
+			version = version[:idx]
+		}
+		s.Version = version
+	}
+
+	return s, s.Name != ""
+}
+
+// String returns the specifier without a subpath, like jsr:@std/path@^1.0.
+func (s Specifier) String() string {
+	if s.Version == "" {
+		return s.Registry + ":" + s.Name
+	}
+	return s.Registry + ":" + s.Name + "@" + s.Version
+}
+
+func (Deno) LockfilePath(path string) (string, error) {
+	lockfilePath := filepath.Join(filepath.Dir(path), "deno.lock")
+
+	if _, err := os.Stat(lockfilePath); os.IsNotExist(err) {
+		return "", fmt.Errorf("lockfile not found")
+	}
+
+	return lockfilePath, nil
+}
+
+// Returns the version without any prefix or suffix
+func cleanVersion(version string) string {
+	// Use the first constraint of "^1.0 || ^2.0" and ">=1.0 <2.0"
+	for _, separator := range []string{"||", " "} {
+		version = strings.TrimSpace(version)
+		if idx := strings.Index(version, separator); idx != -1 {
+			version = version[:idx]
+		}
+	}
+
+	if version == "*" || version == "latest" {
+		return ""
+	}
+
+	return strings.TrimLeft(version, "v^~><= ")
+}
+
+func findLineInfo(data []byte, section string, key string) (line int, rawLine string) {
+	lines := bytes.Split(data, []byte{'\n'})
+	inSection := false
+	quotedSection := []byte(`"` + section + `"`)
+	quotedKey := []byte(`"` + key + `"`)
+
+	for i, line := range lines {
+		trimmed := bytes.TrimSpace(line)
+
+		// Check if we're entering the right section
+		if bytes.HasPrefix(trimmed, quotedSection) {
+			inSection = true
+			continue
+		}
+
+		// Check if we're leaving the section
+		if inSection && bytes.HasPrefix(trimmed, []byte("}")) {
+			inSection = false
+			continue
+		}
+
+		// Look for our key while in the correct section
+		if inSection && bytes.HasPrefix(trimmed, quotedKey) {
+			return i + 1, string(trimmed)
+		}
+	}
+
+	return 0, ""
+}
@@ -0,0 +1,231 @@
+package deno
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/depshubhq/depshub/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeno_GetType(t *testing.T) {
+	manager := Deno{}
+	assert.Equal(t, types.Deno, manager.GetType())
+}
+
+func TestDeno_Managed(t *testing.T) {
+	manager := Deno{}
+	tests := []struct {
+		name     string
+		path     string
+		expected bool
+	}{
+		{name: "deno.json", path: "path/to/deno.json", expected: true},
+		{name: "deno.jsonc", path: "path/to/deno.jsonc", expected: true},
+		{name: "lockfile", path: "path/to/deno.lock", expected: false},
+		{name: "package.json", path: "path/to/package.json", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := manager.Managed(tt.path)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestDeno_Dependencies(t *testing.T) {
+	manager := Deno{}
+
+	dependency := func(path string, manager types.ManagerType, name, version string, line int, rawLine string) types.Dependency {
+		return types.Dependency{
+			Manager: manager,
+			Name:    name,
+			Version: version,
+			Definition: types.Definition{
+				Path:    path,
+				RawLine: rawLine,
+				Line:    line,
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		path     string
+		expected func(path string) []types.Dependency
+	}{
+		{
+			name: "with lockfile",
+			path: filepath.Join("testdata", "deno.jsonc"),
+			expected: func(path string) []types.Dependency {
+				return []types.Dependency{
+					dependency(path, types.Deno, "@std/assert", "1.0.6", 8, `"@std/assert": "jsr:@std/assert@^1.0.6",`),
+					dependency(path, types.Deno, "@std/path", "1.0.8", 9, `"@std/path": "jsr:@std/path@^1.0", // pinned by deno.lock`),
+					dependency(path, types.Npm, "chalk", "5.3.0", 11, `"chalk": "npm:chalk@5",`),
+					dependency(path, types.Npm, "preact", "10.24.3", 13, `"preact": "npm:preact",`),
+					dependency(path, types.Npm, "react", "18.3.1", 14, `"react": "npm:react@^18.3.1",`),
+				}
+			},
+		},
+		{
+			name: "without lockfile",
+			path: filepath.Join("testdata", "nolock", "deno.json"),
+			expected: func(path string) []types.Dependency {
+				return []types.Dependency{
+					dependency(path, types.Deno, "@luca/flag", "1.0.1", 3, `"@luca/flag": "jsr:@luca/flag@~1.0.1",`),
+					dependency(path, types.Npm, "express", "4.18.0", 4, `"express": "npm:express@>=4.18.0 <5"`),
+				}
+			},
+		},
+		{
+			// The declared versions are kept when deno.lock can't be read
+			name: "with invalid lockfile",
+			path: filepath.Join("testdata", "invalidlock", "deno.json"),
+			expected: func(path string) []types.Dependency {
+				return []types.Dependency{
+					dependency(path, types.Deno, "@luca/flag", "1.0.1", 3, `"@luca/flag": "jsr:@luca/flag@~1.0.1",`),
+					dependency(path, types.Npm, "express", "4.18.0", 4, `"express": "npm:express@>=4.18.0 <5"`),
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dependencies, err := manager.Dependencies(tt.path)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected(tt.path), dependencies)
+		})
+	}
+}
+
+func TestParseSpecifier(t *testing.T) {
+	tests := []struct {
+		specifier string
+		expected  Specifier
+		ok        bool
+	}{
+		{specifier: "jsr:@std/path@^1.0", expected: Specifier{Registry: "jsr", Name: "@std/path", Version: "^1.0"}, ok: true},
+		{specifier: "jsr:/@std/path@^1.0/", expected: Specifier{Registry: "jsr", Name: "@std/path", Version: "^1.0"}, ok: true},
+		{specifier: "jsr:@std/path@^1.0/posix", expected: Specifier{Registry: "jsr", Name: "@std/path", Version: "^1.0"}, ok: true},
+		{specifier: "jsr:@std/path/posix", expected: Specifier{Registry: "jsr", Name: "@std/path"}, ok: true},
+		{specifier: "npm:chalk@5", expected: Specifier{Registry: "npm", Name: "chalk", Version: "5"}, ok: true},
+		{specifier: "npm:preact/hooks", expected: Specifier{Registry: "npm", Name: "preact"}, ok: true},
+		{specifier: "npm:@types/node@22", expected: Specifier{Registry: "npm", Name: "@types/node", Version: "22"}, ok: true},
+		{specifier: "https://deno.land/x/oak@v12.6.1/mod.ts"},
+		{specifier: "./src/mod.ts"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.specifier, func(t *testing.T) {
+			result, ok := ParseSpecifier(tt.specifier)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestReadLockfile(t *testing.T) {
+	t.Run("version 4", func(t *testing.T) {
+		lock, err := ReadLockfile(filepath.Join("testdata", "deno.lock"))
+		assert.NoError(t, err)
+
+		assert.Equal(t, "18.3.1", lock.Specifiers["npm:react-dom@^18.3.1"])
+		assert.Equal(t, LockEntry{
+			Specifier:    Specifier{Registry: "jsr", Name: "@std/assert", Version: "1.0.6"},
+			Integrity:    "1904c05806a25d94fe791d6d883b685c9e2dcd60e4f9fc30f4fc5cf010c72207",
+			Dependencies: []string{"jsr:@std/internal"},
+		}, lock.Packages["jsr:@std/assert@1.0.6"])
+		assert.Equal(t, LockEntry{
+			Specifier:    Specifier{Registry: "npm", Name: "react-dom", Version: "18.3.1"},
+			Integrity:    "sha512-5m4nQKp+rZRb09LNH59GM4BxTh9251/ylbKIbpe7TpGxfJ+9kv6BLkLBXIjjspbgbnIBNqlI23tRnTWT0snUIw==",
+			Dependencies: []string{"npm:loose-envify", "npm:react"},
+		}, lock.Packages["npm:react-dom@18.3.1"])
+	})
+
+	t.Run("version 3", func(t *testing.T) {
+		lock, err := ReadLockfile(filepath.Join("testdata", "v3", "deno.lock"))
+		assert.NoError(t, err)
+
+		version, ok := lock.Resolve(Specifier{Registry: "jsr", Name: "@std/path", Version: "^1.0"})
+		assert.True(t, ok)
+		assert.Equal(t, "1.0.2", version)
+		assert.Contains(t, lock.Packages, "npm:chalk@5.3.0")
+	})
+}
+
+func TestDeno_LockedPackages(t *testing.T) {
+	packages, err := Deno{}.LockedPackages(filepath.Join("testdata", "deno.lock"))
+	assert.NoError(t, err)
+
+	// Dependencies without a version resolve to the locked package
+	assert.Contains(t, packages, types.LockedPackage{
+		Manager:      types.Deno,
+		Name:         "@std/assert",
+		Version:      "1.0.6",
+		Hashes:       []types.Hash{{Algorithm: "SHA-256", Value: "1904c05806a25d94fe791d6d883b685c9e2dcd60e4f9fc30f4fc5cf010c72207"}},
+		Dependencies: []string{"@std/internal@1.0.4"},
+	})
+
+	assert.Contains(t, packages, types.LockedPackage{
+		Manager: types.Npm,
+		Name:    "react-dom",
+		Version: "18.3.1",
+		Hashes: []types.Hash{{
+			Algorithm: "SHA-512",
+			Value:     "e66e2740aa7ead945bd3d2cd1f9f463380714e1f76e75ff295b2886e97bb4e91b17c9fbd92fe812e42c15c88e3b296e06e720136a948db7b519d3593d2c9d423",
+		}},
+		Dependencies: []string{"loose-envify@1.4.0", "react@18.3.1"},
+	})
+}
+
+func TestDeno_LockfilePath(t *testing.T) {
+	manager := Deno{}
+
+	lockfilePath, err := manager.LockfilePath(filepath.Join("testdata", "deno.jsonc"))
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join("testdata", "deno.lock"), lockfilePath)
+
+	lockfilePath, err = manager.LockfilePath(filepath.Join("testdata", "nolock", "deno.json"))
+	assert.Error(t, err)
+	assert.Empty(t, lockfilePath)
+}
+
+func TestStripJSONC(t *testing.T) {
+	input := "{\n  // comment\n  \"url\": \"https://jsr.io\", /* block\n  comment */\n  \"list\": [1, 2,],\n  \"a,}\": \"/*\", // trailing\n}\n"
+
+	result := stripJSONC([]byte(input))
+	assert.Len(t, result, len(input))
+
+	var value map[string]any
+	assert.NoError(t, json.Unmarshal(result, &value))
+	assert.Equal(t, map[string]any{
+		"url":  "https://jsr.io",
+		"list": []any{float64(1), float64(2)},
+		"a,}":  "/*",
+	}, value)
+}
+
+func TestCleanVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  string
+		expected string
+	}{
+		{name: "exact version", version: "1.0.6", expected: "1.0.6"},
+		{name: "caret", version: "^1.0", expected: "1.0"},
+		{name: "tilde", version: "~1.0.1", expected: "1.0.1"},
+		{name: "range", version: ">=4.18.0 <5", expected: "4.18.0"},
+		{name: "or", version: "^1.0 || ^2.0", expected: "1.0"},
+		{name: "any", version: "*", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := cleanVersion(tt.version)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package deno
+
+// stripJSONC turns JSON with comments and trailing commas into plain JSON.
+// Removed characters are replaced with spaces, so lines don't change.
+func stripJSONC(data []byte) []byte {
+	result := make([]byte, len(data))
+	copy(result, data)
+
+	// Remove comments
+	walk(result, func(i int) int {
+		switch {
+		case result[i] == '/' && i+1 < len(result) && result[i+1] == '/':
+			for ; i < len(result) && result[i] != '\n'; i++ {
+				result[i] = ' '
+			}
+		case result[i] == '/' && i+1 < len(result) && result[i+1] == '*':
+			for ; i < len(result); i++ {
+				if result[i] == '*' && i+1 < len(result) && result[i+1] == '/' {
+					result[i], result[i+1] = ' ', ' '
+					return i + 1
+				}
+				if result[i] != '\n' {
+					result[i] = ' '
+				}
+			}
+		}
+		return i
+	})
+
+	// Remove trailing commas like `{"a": 1,}`
+	walk(result, func(i int) int {
+		if result[i] != ',' {
+			return i
+		}
+
+		j := i + 1
+		for j < len(result) && (result[j] == ' ' || result[j] == '\t' || result[j] == '\n' || result[j] == '\r') {
+			j++
+		}
+
+		if j < len(result) && (result[j] == '}' || result[j] == ']') {
+			result[i] = ' '
+		}
+		return i
+	})
+
+	return result
+}
+
+// walk calls visit with the index of each byte outside of strings. visit
+// returns the index of the last byte it consumed.
+func walk(data []byte, visit func(i int) int) {
+	inString := false
+
+	for i := 0; i < len(data); i++ {
+		if inString {
+			if data[i] == '\\' {
+				i++
+			} else if data[i] == '"' {
+				inString = false
+			}
+			continue
+		}
+
+		if data[i] == '"' {
+			inString = true
+			continue
+		}
+
+		i = visit(i)
+	}
+}
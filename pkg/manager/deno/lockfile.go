@@ -0,0 +1,216 @@
+package deno
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+// LockEntry is a JSR or npm package resolved in deno.lock.
+type LockEntry struct {
+	Specifier
+	// The SHA-256 of JSR packages, or the subresource integrity of npm packages
+	Integrity string
+	// The specifiers of the packages this package depends on
+	Dependencies []string
+}
+
+// Lockfile holds the resolved versions of deno.lock.
+type Lockfile struct {
+	// The resolved version of each specifier, like jsr:@std/path@^1.0 => 1.0.2
+	Specifiers map[string]string
+	// The resolved packages keyed by specifier, like jsr:@std/path@1.0.2
+	Packages map[string]LockEntry
+}
+
+type lockPackage struct {
+	Integrity    string `json:"integrity"`
+	Dependencies any    `json:"dependencies"`
+}
+
+type lockPackages struct {
+	Specifiers map[string]string      `json:"specifiers"`
+	JSR        map[string]lockPackage `json:"jsr"`
+	Npm        map[string]lockPackage `json:"npm"`
+}
+
+type lockfile struct {
+	Version string `json:"version"`
+	// Version 4 and later
+	lockPackages
+	// Version 3
+	Packages lockPackages `json:"packages"`
+}
+
+// ReadLockfile parses the version 3 and 4 formats of deno.lock.
+func ReadLockfile(path string) (Lockfile, error) {
+	result := Lockfile{
+		Specifiers: make(map[string]string),
+		Packages:   make(map[string]LockEntry),
+	}
+
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return result, err
+	}
+
+	var lock lockfile
+	if err := json.Unmarshal(file, &lock); err != nil {
+		return result, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+
+	packages := lock.lockPackages
+	if lock.Version == "3" || lock.Version == "2" {
+		packages = lock.Packages
+	}
+
+	for specifier, resolved := range packages.Specifiers {
+		// Version 3 resolves to specifiers like npm:chalk@5.3.0, version 4 to versions
+		if s, ok := ParseSpecifier(resolved); ok {
+			resolved = s.Version
+		}
+
+		result.Specifiers[specifier] = trimPeers(resolved)
+	}
+
+	for registry, entries := range map[string]map[string]lockPackage{"jsr": packages.JSR, "npm": packages.Npm} {
+		for key, entry := range entries {
+			s, ok := ParseSpecifier(registry + ":" + key)
+			if !ok {
+				continue
+			}
+			s.Version = trimPeers(s.Version)
+
+			result.Packages[s.String()] = LockEntry{
+				Specifier:    s,
+				Integrity:    entry.Integrity,
+				Dependencies: lockDependencies(registry, entry.Dependencies),
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// Resolve returns the version deno.lock resolved a specifier to. Specifiers
+// without a version are locked as name@*.
+func (l Lockfile) Resolve(s Specifier) (string, bool) {
+	if s.Version == "" {
+		s.Version = "*"
+	}
+
+	version, ok := l.Specifiers[s.String()]
+	return version, ok
+}
+
+// Lookup returns the package a dependency of a lockfile package resolves to.
+// Dependencies are specifiers like jsr:@std/path@1.0.8, jsr:@std/path@^1.0 or
+// npm:react, without a version when a single version of the package is locked.
+func (l Lockfile) Lookup(dependency string) (LockEntry, bool) {
+	if entry, ok := l.Packages[dependency]; ok {
+		return entry, true
+	}
+
+	s, ok := ParseSpecifier(dependency)
+	if !ok {
+		return LockEntry{}, false
+	}
+
+	if version, ok := l.Resolve(s); ok {
+		entry, ok := l.Packages[Specifier{Registry: s.Registry, Name: s.Name, Version: version}.String()]
+		return entry, ok
+	}
+
+	var matches []string
+	for key, entry := range l.Packages {
+		if entry.Registry == s.Registry && entry.Name == s.Name {
+			matches = append(matches, key)
+		}
+	}
+
+	if len(matches) == 0 {
+		return LockEntry{}, false
+	}
+
+	slices.Sort(matches)
+	return l.Packages[matches[0]], true
+}
+
+// LockedPackages returns the JSR and npm packages of deno.lock.
+func (Deno) LockedPackages(lockfilePath string) ([]types.LockedPackage, error) {
+	lock, err := ReadLockfile(lockfilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var packages []types.LockedPackage
+
+	for _, entry := range lock.Packages {
+		pkg := types.LockedPackage{
+			Manager: types.Deno,
+			Name:    entry.Name,
+			Version: entry.Version,
+		}
+
+		if entry.Registry == "npm" {
+			pkg.Manager = types.Npm
+
+			if hash, ok := types.IntegrityHash(entry.Integrity); ok {
+				pkg.Hashes = []types.Hash{hash}
+			}
+		} else if entry.Integrity != "" {
+			pkg.Hashes = []types.Hash{{Algorithm: "SHA-256", Value: entry.Integrity}}
+		}
+
+		for _, dependency := range entry.Dependencies {
+			if dep, ok := lock.Lookup(dependency); ok {
+				pkg.Dependencies = append(pkg.Dependencies, types.LockedID(dep.Name, dep.Version))
+			}
+		}
+
+		packages = append(packages, pkg)
+	}
+
+	return packages, nil
+}
+
+// lockDependencies returns the dependencies of a package as specifiers. JSR
+// packages list specifiers, npm packages list names in version 4 and map names
+// to name@version in version 3.
+func lockDependencies(registry string, dependencies any) []string {
+	var result []string
+
+	switch deps := dependencies.(type) {
+	case []any:
+		for _, dep := range deps {
+			if s, ok := dep.(string); ok {
+				if !strings.HasPrefix(s, "jsr:") && !strings.HasPrefix(s, "npm:") {
+					s = registry + ":" + s
+				}
+				result = append(result, trimPeers(s))
+			}
+		}
+	case map[string]any:
+		for _, dep := range deps {
+			if s, ok := dep.(string); ok {
+				result = append(result, registry+":"+trimPeers(s))
+			}
+		}
+	}
+
+	slices.Sort(result)
+
+	return result
+}
+
+// trimPeers removes the peer dependencies of npm versions like 18.3.1_react@18.3.1.
+func trimPeers(version string) string {
+	if idx := strings.Index(version, "_"); idx != -1 {
+		return version[:idx]
+	}
+	return version
+}
@@ -0,0 +1,31 @@
+package docker
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Matches `image: node:20` in Compose files
+var imagePattern = regexp.MustCompile(`^\s*image\s*:\s*["']?([^"'\s#]+)`)
+
+// parseCompose returns the images of the services of a Compose file. Variables
+// are replaced with their defaults since the environment isn't known.
+func parseCompose(source string) []image {
+	var images []image
+
+	for i, line := range strings.Split(source, "\n") {
+		matches := imagePattern.FindStringSubmatch(line)
+		if matches == nil {
+			continue
+		}
+
+		reference, ok := interpolate(matches[1], nil)
+		if !ok {
+			continue
+		}
+
+		images = append(images, image{reference: reference, line: i + 1})
+	}
+
+	return images
+}
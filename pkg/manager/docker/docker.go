@@ -0,0 +1,120 @@
+package docker
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+// Matches variables like $VERSION, ${VERSION} and ${VERSION:-20}
+var variablePattern = regexp.MustCompile(`\$(?:\{([A-Za-z_][A-Za-z0-9_]*)(?::?-([^}]*))?\}|([A-Za-z_][A-Za-z0-9_]*))`)
+
+type Docker struct{}
+
+func (Docker) GetType() types.ManagerType {
+	return types.Docker
+}
+
+func (Docker) Managed(path string) bool {
+	return isDockerfile(path) || isComposeFile(path)
+}
+
+func isDockerfile(path string) bool {
+	base := filepath.Base(path)
+	return strings.HasPrefix(base, "Dockerfile") || strings.HasSuffix(base, ".dockerfile") || base == "Containerfile"
+}
+
+func isComposeFile(path string) bool {
+	base := filepath.Base(path)
+	ext := filepath.Ext(base)
+	if ext != ".yml" && ext != ".yaml" {
+		return false
+	}
+
+	return strings.HasPrefix(base, "docker-compose") || strings.HasPrefix(base, "compose.")
+}
+
+func (Docker) Dependencies(path string) ([]types.Dependency, error) {
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var images []image
+	if isDockerfile(path) {
+		images = parseDockerfile(string(file))
+	} else {
+		images = parseCompose(string(file))
+	}
+
+	return imageDependencies(path, string(file), images), nil
+}
+
+// imageDependencies converts the images found in a file to dependencies.
+func imageDependencies(path string, source string, images []image) []types.Dependency {
+	lines := strings.Split(source, "\n")
+	dependencies := []types.Dependency{}
+
+	for _, img := range images {
+		name, tag, digest := parseReference(img.reference)
+
+		dependencies = append(dependencies, types.Dependency{
+			Manager: types.Docker,
+			Name:    name,
+			Version: tag,
+			Digest:  digest,
+			Definition: types.Definition{
+				Path:    path,
+				RawLine: strings.TrimSpace(lines[img.line-1]),
+				Line:    img.line,
+			},
+		})
+	}
+
+	return dependencies
+}
+
+// parseReference splits an image reference like registry/name:tag@digest.
+func parseReference(reference string) (name, tag, digest string) {
+	name, digest, _ = strings.Cut(reference, "@")
+
+	// The tag follows the last colon, unless the colon is part of the registry host
+	if idx := strings.LastIndex(name, ":"); idx != -1 && !strings.Contains(name[idx:], "/") {
+		name, tag = name[:idx], name[idx+1:]
+	}
+
+	return name, tag, digest
+}
+
+// interpolate replaces variables with their values or defaults. It returns false
+// when some variables can't be resolved.
+func interpolate(s string, variables map[string]string) (string, bool) {
+	resolved := true
+
+	result := variablePattern.ReplaceAllStringFunc(s, func(match string) string {
+		groups := variablePattern.FindStringSubmatch(match)
+		name := groups[1] + groups[3]
+
+		if value, ok := variables[name]; ok && value != "" {
+			return value
+		}
+
+		if strings.Contains(match, "-") {
+			return groups[2]
+		}
+
+		resolved = false
+		return match
+	})
+
+	return result, resolved
+}
+
+// LockfilePath always fails since images are pinned with digests instead of lockfiles.
+func (Docker) LockfilePath(path string) (string, error) {
+	return "", fmt.Errorf("container images don't use a lockfile")
+}
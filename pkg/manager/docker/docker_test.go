@@ -0,0 +1,128 @@
+package docker
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/depshubhq/depshub/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDocker_GetType(t *testing.T) {
+	manager := Docker{}
+	assert.Equal(t, types.Docker, manager.GetType())
+}
+
+func TestDocker_Managed(t *testing.T) {
+	manager := Docker{}
+	tests := []struct {
+		name     string
+		path     string
+		expected bool
+	}{
+		{name: "Dockerfile", path: "path/to/Dockerfile", expected: true},
+		{name: "Dockerfile with suffix", path: "path/to/Dockerfile.prod", expected: true},
+		{name: "dockerfile extension", path: "path/to/api.dockerfile", expected: true},
+		{name: "Containerfile", path: "path/to/Containerfile", expected: true},
+		{name: "Compose file", path: "path/to/docker-compose.yml", expected: true},
+		{name: "Compose override", path: "path/to/docker-compose.override.yaml", expected: true},
+		{name: "Compose specification file", path: "path/to/compose.yaml", expected: true},
+		{name: "dockerignore", path: "path/to/.dockerignore", expected: false},
+		{name: "other yaml file", path: "path/to/config.yml", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := manager.Managed(tt.path)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestDocker_Dependencies(t *testing.T) {
+	manager := Docker{}
+
+	dependency := func(path, name, tag, digest string, line int, rawLine string) types.Dependency {
+		return types.Dependency{
+			Manager: types.Docker,
+			Name:    name,
+			Version: tag,
+			Digest:  digest,
+			Definition: types.Definition{
+				Path:    path,
+				RawLine: rawLine,
+				Line:    line,
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		path     string
+		expected func(path string) []types.Dependency
+	}{
+		{
+			name: "Dockerfile",
+			path: filepath.Join("testdata", "Dockerfile"),
+			expected: func(path string) []types.Dependency {
+				return []types.Dependency{
+					dependency(path, "node", "20.17.0-alpine", "", 5, "FROM --platform=$BUILDPLATFORM node:${NODE_VERSION}-alpine AS build"),
+					dependency(path, "gcr.io/distroless/nodejs20-debian12", "", "sha256:0b7d2e3bbb8d6f1e5f6c5a4c1c7e5bd5e1e8f0dc3b0e0b7d2e3bbb8d6f1e5f6c", 15,
+						`FROM gcr.io/distroless/nodejs20-debian12@sha256:0b7d2e3bbb8d6f1e5f6c5a4c1c7e5bd5e1e8f0dc3b0e0b7d2e3bbb8d6f1e5f6c \`),
+					dependency(path, "localhost:5000/tools", "", "", 19, "FROM localhost:5000/tools"),
+				}
+			},
+		},
+		{
+			name: "Compose file",
+			path: filepath.Join("testdata", "docker-compose.yml"),
+			expected: func(path string) []types.Dependency {
+				return []types.Dependency{
+					dependency(path, "postgres", "16.4", "", 3, "image: postgres:16.4"),
+					dependency(path, "redis", "latest", "", 5, `image: "redis:latest"`),
+					dependency(path, "ghcr.io/example/app", "1.2.0", "", 8, "image: ${REGISTRY:-ghcr.io}/example/app:${TAG:-1.2.0}"),
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dependencies, err := manager.Dependencies(tt.path)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected(tt.path), dependencies)
+		})
+	}
+}
+
+func TestParseReference(t *testing.T) {
+	tests := []struct {
+		reference string
+		name      string
+		tag       string
+		digest    string
+	}{
+		{reference: "node", name: "node"},
+		{reference: "node:20", name: "node", tag: "20"},
+		{reference: "node:20@sha256:abc", name: "node", tag: "20", digest: "sha256:abc"},
+		{reference: "registry:5000/team/app", name: "registry:5000/team/app"},
+		{reference: "registry:5000/team/app:1.0", name: "registry:5000/team/app", tag: "1.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.reference, func(t *testing.T) {
+			name, tag, digest := parseReference(tt.reference)
+			assert.Equal(t, tt.name, name)
+			assert.Equal(t, tt.tag, tag)
+			assert.Equal(t, tt.digest, digest)
+		})
+	}
+}
+
+func TestDocker_LockfilePath(t *testing.T) {
+	manager := Docker{}
+
+	lockfilePath, err := manager.LockfilePath(filepath.Join("testdata", "Dockerfile"))
+	assert.Error(t, err)
+	assert.Empty(t, lockfilePath)
+}
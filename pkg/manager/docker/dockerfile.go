@@ -0,0 +1,70 @@
+package docker
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	fromPattern = regexp.MustCompile(`(?i)^FROM\s+(?:--\S+\s+)*(\S+)(?:\s+AS\s+(\S+))?`)
+	argPattern  = regexp.MustCompile(`(?i)^ARG\s+([A-Za-z_][A-Za-z0-9_]*)(?:=(\S*))?`)
+)
+
+type image struct {
+	reference string
+	line      int
+}
+
+// parseDockerfile returns the base images of all the stages. Stages based on
+// previous stages and scratch are skipped, and global ARGs are substituted.
+func parseDockerfile(source string) []image {
+	var images []image
+
+	variables := make(map[string]string)
+	stages := make(map[string]bool)
+	inStage := false
+
+	lines := strings.Split(source, "\n")
+
+	for i := 0; i < len(lines); i++ {
+		start := i
+		instruction := strings.TrimSpace(lines[i])
+
+		// Join continuation lines
+		for strings.HasSuffix(instruction, "\\") && i+1 < len(lines) {
+			i++
+			instruction = strings.TrimSuffix(instruction, "\\") + " " + strings.TrimSpace(lines[i])
+		}
+
+		if instruction == "" || strings.HasPrefix(instruction, "#") {
+			continue
+		}
+
+		// Only the ARGs declared before the first FROM can be used in FROM
+		if matches := argPattern.FindStringSubmatch(instruction); matches != nil && !inStage {
+			variables[matches[1]] = strings.Trim(matches[2], `"'`)
+			continue
+		}
+
+		matches := fromPattern.FindStringSubmatch(instruction)
+		if matches == nil {
+			continue
+		}
+		inStage = true
+
+		reference, ok := interpolate(matches[1], variables)
+		previousStage := stages[strings.ToLower(reference)]
+
+		if matches[2] != "" {
+			stages[strings.ToLower(matches[2])] = true
+		}
+
+		if !ok || previousStage || strings.EqualFold(reference, "scratch") {
+			continue
+		}
+
+		images = append(images, image{reference: reference, line: start + 1})
+	}
+
+	return images
+}
@@ -0,0 +1,110 @@
+package docker
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/depshubhq/depshub/pkg/types"
+	"gopkg.in/yaml.v3"
+)
+
+// Kubernetes reads the container images of static or rendered Kubernetes
+// manifests, like the output of `helm template` or `kustomize build`.
+// The images are checked like the ones of Dockerfiles.
+type Kubernetes struct{}
+
+func (Kubernetes) GetType() types.ManagerType {
+	return types.Docker
+}
+
+// Managed accepts any YAML file, files that aren't Kubernetes manifests
+// don't have dependencies. Helm templates aren't valid YAML until they are
+// rendered, so they are skipped.
+func (Kubernetes) Managed(path string) bool {
+	ext := filepath.Ext(path)
+	if ext != ".yml" && ext != ".yaml" {
+		return false
+	}
+
+	return filepath.Base(filepath.Dir(path)) != "templates"
+}
+
+func (Kubernetes) Dependencies(path string) ([]types.Dependency, error) {
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return imageDependencies(path, string(file), parseKubernetes(file)), nil
+}
+
+// parseKubernetes returns the images of all the Kubernetes objects of a YAML
+// stream. Parsing stops at the first invalid document.
+func parseKubernetes(source []byte) []image {
+	var images []image
+
+	decoder := yaml.NewDecoder(bytes.NewReader(source))
+	for {
+		var document yaml.Node
+		if err := decoder.Decode(&document); err != nil {
+			return images
+		}
+
+		if len(document.Content) == 0 || !isKubernetesObject(document.Content[0]) {
+			continue
+		}
+
+		images = append(images, findImages(document.Content[0])...)
+	}
+}
+
+// isKubernetesObject checks for the apiVersion and kind of Kubernetes objects.
+func isKubernetesObject(node *yaml.Node) bool {
+	if node.Kind != yaml.MappingNode {
+		return false
+	}
+
+	keys := make(map[string]bool)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		keys[node.Content[i].Value] = true
+	}
+
+	return keys["apiVersion"] && keys["kind"]
+}
+
+// findImages returns the values of the `image` fields of containers, at any depth
+// since pod templates are nested differently by each workload kind.
+func findImages(node *yaml.Node) []image {
+	var images []image
+
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+
+			if key.Value == "image" && value.Kind == yaml.ScalarNode {
+				// Skip unrendered templates like {{ .Values.image }}
+				if value.Value != "" && !strings.ContainsAny(value.Value, "{}$") {
+					images = append(images, image{reference: value.Value, line: value.Line})
+				}
+				continue
+			}
+
+			images = append(images, findImages(value)...)
+		}
+	case yaml.SequenceNode:
+		for _, item := range node.Content {
+			images = append(images, findImages(item)...)
+		}
+	}
+
+	return images
+}
+
+// LockfilePath always fails since images are pinned with digests instead of lockfiles.
+func (Kubernetes) LockfilePath(path string) (string, error) {
+	return "", fmt.Errorf("container images don't use a lockfile")
+}
@@ -0,0 +1,77 @@
+package docker
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/depshubhq/depshub/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKubernetes_Managed(t *testing.T) {
+	manager := Kubernetes{}
+	tests := []struct {
+		name     string
+		path     string
+		expected bool
+	}{
+		{name: "manifest", path: "deploy/deployment.yaml", expected: true},
+		{name: "rendered chart", path: "build/manifests.yml", expected: true},
+		{name: "helm template", path: "charts/api/templates/deployment.yaml", expected: false},
+		{name: "other file", path: "deploy/kustomization.json", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := manager.Managed(tt.path)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestKubernetes_Dependencies(t *testing.T) {
+	manager := Kubernetes{}
+
+	t.Run("manifests", func(t *testing.T) {
+		path := filepath.Join("testdata", "k8s", "deployment.yaml")
+
+		dependencies, err := manager.Dependencies(path)
+		assert.NoError(t, err)
+		assert.Equal(t, []types.Dependency{
+			{
+				Manager:    types.Docker,
+				Name:       "ghcr.io/example/migrate",
+				Version:    "1.4.0",
+				Definition: types.Definition{Path: path, RawLine: "image: ghcr.io/example/migrate:1.4.0", Line: 11},
+			},
+			{
+				Manager: types.Docker,
+				Name:    "ghcr.io/example/api",
+				Version: "2.3.1",
+				Digest:  "sha256:4f2e3c1a0b9d8e7f6a5b4c3d2e1f0a9b8c7d6e5f4a3b2c1d0e9f8a7b6c5d4e3f",
+				Definition: types.Definition{
+					Path:    path,
+					RawLine: `image: "ghcr.io/example/api:2.3.1@sha256:4f2e3c1a0b9d8e7f6a5b4c3d2e1f0a9b8c7d6e5f4a3b2c1d0e9f8a7b6c5d4e3f"`,
+					Line:    14,
+				},
+			},
+			{
+				Manager:    types.Docker,
+				Name:       "envoyproxy/envoy",
+				Version:    "v1.30-latest",
+				Definition: types.Definition{Path: path, RawLine: "image: envoyproxy/envoy:v1.30-latest", Line: 18},
+			},
+			{
+				Manager:    types.Docker,
+				Name:       "busybox",
+				Definition: types.Definition{Path: path, RawLine: "image: busybox", Line: 32},
+			},
+		}, dependencies)
+	})
+
+	t.Run("not a Kubernetes object", func(t *testing.T) {
+		dependencies, err := manager.Dependencies(filepath.Join("testdata", "k8s", "values.yaml"))
+		assert.NoError(t, err)
+		assert.Empty(t, dependencies)
+	})
+}
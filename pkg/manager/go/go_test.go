@@ -137,3 +137,27 @@ func TestCleanVersion(t *testing.T) {
 		})
 	}
 }
+
+func TestGo_LockedPackages(t *testing.T) {
+	cache, err := filepath.Abs(filepath.Join("testdata", "modcache"))
+	assert.NoError(t, err)
+	t.Setenv("GOMODCACHE", cache)
+
+	packages, err := Go{}.LockedPackages(filepath.Join("testdata", "graph", "go.sum"))
+	assert.NoError(t, err)
+
+	// Requirements of modules that aren't selected for the build are skipped,
+	// and modules that aren't in the cache have no dependencies
+	assert.Equal(t, []types.LockedPackage{
+		{Manager: types.Go, Name: "github.com/BurntSushi/toml", Version: "v1.4.0", Direct: true},
+		{
+			Manager:      types.Go,
+			Name:         "github.com/spf13/cobra",
+			Version:      "v1.8.1",
+			Dependencies: []string{"github.com/inconshreveable/mousetrap@v1.1.0", "github.com/spf13/pflag@v1.0.5"},
+			Direct:       true,
+		},
+		{Manager: types.Go, Name: "github.com/inconshreveable/mousetrap", Version: "v1.1.0"},
+		{Manager: types.Go, Name: "github.com/spf13/pflag", Version: "v1.0.5"},
+	}, packages)
+}
@@ -0,0 +1,109 @@
+package gomanager
+
+import (
+	"go/build"
+	"os"
+	"path/filepath"
+
+	"github.com/depshubhq/depshub/pkg/types"
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/module"
+)
+
+// LockedPackages returns the modules required by the go.mod next to go.sum,
+// which lists all the modules of the build since Go 1.17. The requirements of
+// each module, like go mod graph prints them, are read from the go.mod files
+// of the module cache, so modules that aren't downloaded have no dependencies.
+func (Go) LockedPackages(lockfilePath string) ([]types.LockedPackage, error) {
+	modPath := filepath.Join(filepath.Dir(lockfilePath), "go.mod")
+
+	file, err := os.ReadFile(modPath)
+	if err != nil {
+		return nil, err
+	}
+
+	mod, err := modfile.Parse(modPath, file, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// The versions selected for the build
+	selected := make(map[string]string)
+	for _, require := range mod.Require {
+		selected[require.Mod.Path] = require.Mod.Version
+	}
+
+	cache := moduleCache()
+	var packages []types.LockedPackage
+
+	for _, require := range mod.Require {
+		pkg := types.LockedPackage{
+			Manager: types.Go,
+			Name:    require.Mod.Path,
+			Version: require.Mod.Version,
+			Direct:  !require.Indirect,
+		}
+
+		for _, dep := range cachedRequirements(cache, require.Mod) {
+			// Dependencies are resolved to the version selected for the build
+			if version, ok := selected[dep.Path]; ok {
+				pkg.Dependencies = append(pkg.Dependencies, types.LockedID(dep.Path, version))
+			}
+		}
+
+		packages = append(packages, pkg)
+	}
+
+	return packages, nil
+}
+
+// moduleCache returns the directory of the module cache, like go env GOMODCACHE.
+func moduleCache() string {
+	if cache := os.Getenv("GOMODCACHE"); cache != "" {
+		return cache
+	}
+
+	gopath := filepath.SplitList(build.Default.GOPATH)
+	if len(gopath) == 0 {
+		return ""
+	}
+
+	return filepath.Join(gopath[0], "pkg", "mod")
+}
+
+// cachedRequirements returns the requirements of the go.mod of a module
+// version downloaded to the module cache.
+func cachedRequirements(cache string, mod module.Version) []module.Version {
+	if cache == "" {
+		return nil
+	}
+
+	escapedPath, err := module.EscapePath(mod.Path)
+	if err != nil {
+		return nil
+	}
+
+	escapedVersion, err := module.EscapeVersion(mod.Version)
+	if err != nil {
+		return nil
+	}
+
+	path := filepath.Join(cache, "cache", "download", escapedPath, "@v", escapedVersion+".mod")
+
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+
+	modFile, err := modfile.ParseLax(path, file, nil)
+	if err != nil {
+		return nil
+	}
+
+	var requirements []module.Version
+	for _, require := range modFile.Require {
+		requirements = append(requirements, require.Mod)
+	}
+
+	return requirements
+}
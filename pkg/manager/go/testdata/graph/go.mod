@@ -0,0 +1,13 @@
+module example.com/app
+
+go 1.23
+
+require (
+	github.com/BurntSushi/toml v1.4.0
+	github.com/spf13/cobra v1.8.1
+)
+
+require (
+	github.com/inconshreveable/mousetrap v1.1.0 // indirect
+	github.com/spf13/pflag v1.0.5 // indirect
+)
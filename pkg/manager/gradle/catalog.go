@@ -0,0 +1,123 @@
+package gradle
+
+import (
+	"os"
+	"strings"
+
+	"github.com/depshubhq/depshub/pkg/types"
+	"github.com/pelletier/go-toml"
+)
+
+// catalogDependencies reads the [libraries] of a version catalog like gradle/libs.versions.toml.
+// Plugins are skipped since they are published to the Gradle Plugin Portal.
+func catalogDependencies(path string) ([]types.Dependency, error) {
+	var dependencies []types.Dependency
+
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := toml.LoadBytes(file)
+	if err != nil {
+		return nil, err
+	}
+
+	libraries, ok := tree.Get("libraries").(*toml.Tree)
+	if !ok {
+		return nil, nil
+	}
+
+	versions, _ := tree.Get("versions").(*toml.Tree)
+	lines := strings.Split(string(file), "\n")
+
+	for _, alias := range libraries.Keys() {
+		var group, name, version string
+
+		switch value := libraries.GetPath([]string{alias}).(type) {
+		case string:
+			// alias = "group:name:version"
+			parts := strings.Split(value, ":")
+			if len(parts) < 2 {
+				continue
+			}
+			group, name = parts[0], parts[1]
+			if len(parts) > 2 {
+				version = parts[2]
+			}
+		case *toml.Tree:
+			if module, ok := value.Get("module").(string); ok {
+				group, name, _ = strings.Cut(module, ":")
+			} else {
+				group, _ = value.Get("group").(string)
+				name, _ = value.Get("name").(string)
+			}
+			version = catalogVersion(value.Get("version"), versions)
+		default:
+			continue
+		}
+
+		if group == "" || name == "" {
+			continue
+		}
+
+		line, rawLine := findLineInfo(lines, libraries.Position().Line, alias)
+
+		dependencies = append(dependencies, types.Dependency{
+			Manager: types.Maven,
+			Name:    group + ":" + name,
+			Version: cleanVersion(version),
+			Definition: types.Definition{
+				Path:    path,
+				RawLine: rawLine,
+				Line:    line,
+			},
+		})
+	}
+
+	return dependencies, nil
+}
+
+// catalogVersion returns the version of a library, which is either a plain string,
+// a reference to the [versions] table or a rich version like { strictly = "1.0" }.
+func catalogVersion(value interface{}, versions *toml.Tree) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case *toml.Tree:
+		if ref, ok := v.Get("ref").(string); ok {
+			if versions == nil {
+				return ""
+			}
+			// References can't point to other references
+			return catalogVersion(versions.GetPath([]string{ref}), nil)
+		}
+
+		for _, key := range []string{"strictly", "require", "prefer"} {
+			if version, ok := v.Get(key).(string); ok {
+				return version
+			}
+		}
+	}
+
+	return ""
+}
+
+// findLineInfo returns the first line after the section header that assigns the key.
+func findLineInfo(lines []string, from int, key string) (line int, rawLine string) {
+	if from < 1 {
+		from = 1
+	}
+
+	for i := from - 1; i < len(lines); i++ {
+		trimmed := strings.TrimSpace(lines[i])
+
+		for _, k := range []string{key, `"` + key + `"`} {
+			if rest, ok := strings.CutPrefix(trimmed, k); ok && strings.HasPrefix(strings.TrimSpace(rest), "=") {
+				return i + 1, trimmed
+			}
+		}
+	}
+
+	return 0, ""
+}
@@ -0,0 +1,260 @@
+package gradle
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+// Gradle reports the dependencies of build scripts and version catalogs as
+// Maven artifacts, since Gradle resolves them from Maven repositories.
+type Gradle struct{}
+
+// Matches dependency declarations like:
+//
+//	implementation("com.google.guava:guava:33.3.0-jre")
+//	testImplementation 'junit:junit:4.13.2'
+//	api(platform("org.springframework.boot:spring-boot-dependencies:3.3.4"))
+var notationPattern = regexp.MustCompile(`^\s*(\w+)\s*\(?\s*(?:(?:enforcedPlatform|platform)\s*\(\s*)?["']([^"':\s]+):([^"':\s]+)(?::([^"'@:\s]+))?[^"']*["']`)
+
+// Matches map notation like:
+//
+//	implementation group: 'org.slf4j', name: 'slf4j-api', version: '2.0.16'
+//	implementation(group = "org.slf4j", name = "slf4j-api", version = "2.0.16")
+var mapPattern = regexp.MustCompile(`^\s*(\w+)\s*\(?\s*group\s*[:=]\s*["']([^"']+)["']\s*,\s*name\s*[:=]\s*["']([^"']+)["'](?:\s*,\s*version\s*[:=]\s*["']([^"']+)["'])?`)
+
+// Matches variables like `val okhttpVersion = "4.12.0"` or `ext.okhttpVersion = '4.12.0'`
+var variablePattern = regexp.MustCompile(`^\s*(?:(?:val|var|def)\s+|ext\.|extra\[")?(\w+)(?:"\])?\s*(?::\s*String\s*)?=\s*["']([^"'$]+)["']\s*$`)
+
+var interpolationPattern = regexp.MustCompile(`\$\{?([A-Za-z_][\w.]*)\}?`)
+
+// Configurations whose dependencies are not part of the runtime classpath
+var devConfigurations = []string{"test", "androidTest", "compileOnly"}
+
+// Configurations that declare dependencies, anything else is not a dependency
+var configurations = []string{
+	"implementation", "api", "compileOnly", "runtimeOnly", "compileOnlyApi",
+	"annotationProcessor", "kapt", "ksp", "classpath", "compile", "runtime",
+}
+
+func (Gradle) GetType() types.ManagerType {
+	return types.Maven
+}
+
+func (Gradle) Managed(path string) bool {
+	base := filepath.Base(path)
+	return base == "build.gradle" || base == "build.gradle.kts" || strings.HasSuffix(base, ".versions.toml")
+}
+
+func (Gradle) Dependencies(path string) ([]types.Dependency, error) {
+	var dependencies []types.Dependency
+	var err error
+
+	if strings.HasSuffix(path, ".versions.toml") {
+		dependencies, err = catalogDependencies(path)
+	} else {
+		dependencies, err = buildDependencies(path)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Some of the rules require the original order of dependencies
+	// Sort dependencies by line number
+	sort.Slice(dependencies, func(i, j int) bool {
+		return dependencies[i].Line < dependencies[j].Line
+	})
+
+	// Use the versions from gradle.lockfile when there is one, or keep the declared ones
+	if lockfilePath, err := (Gradle{}).LockfilePath(path); err == nil {
+		locked, err := readLockfile(lockfilePath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading lockfile %s: %s\n", lockfilePath, err)
+		}
+
+		for i, dep := range dependencies {
+			if version, ok := locked[dep.Name]; ok {
+				dependencies[i].Version = version
+			}
+		}
+	}
+
+	return dependencies, nil
+}
+
+func buildDependencies(path string) ([]types.Dependency, error) {
+	var dependencies []types.Dependency
+
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	variables := readVariables(path, file)
+	lines := strings.Split(string(file), "\n")
+
+	for i, line := range lines {
+		configuration, group, name, version, ok := parseDeclaration(line)
+		if !ok {
+			continue
+		}
+
+		version = interpolationPattern.ReplaceAllStringFunc(version, func(match string) string {
+			key := interpolationPattern.FindStringSubmatch(match)[1]
+			if value, ok := variables[key]; ok {
+				return value
+			}
+			return match
+		})
+
+		dependencies = append(dependencies, types.Dependency{
+			Manager: types.Maven,
+			Name:    group + ":" + name,
+			Version: cleanVersion(version),
+			Dev:     isDev(configuration),
+			Definition: types.Definition{
+				Path:    path,
+				RawLine: strings.TrimSpace(line),
+				Line:    i + 1,
+			},
+		})
+	}
+
+	return dependencies, nil
+}
+
+// parseDeclaration extracts the dependency of a single line of a build script.
+func parseDeclaration(line string) (configuration, group, name, version string, ok bool) {
+	matches := notationPattern.FindStringSubmatch(line)
+	if matches == nil {
+		matches = mapPattern.FindStringSubmatch(line)
+	}
+
+	if matches == nil || !isConfiguration(matches[1]) {
+		return "", "", "", "", false
+	}
+
+	return matches[1], matches[2], matches[3], matches[4], true
+}
+
+func isConfiguration(name string) bool {
+	for _, prefix := range append(devConfigurations, configurations...) {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	// Custom source sets like integrationTestImplementation
+	return strings.HasSuffix(name, "Implementation") || strings.HasSuffix(name, "RuntimeOnly") || strings.HasSuffix(name, "CompileOnly")
+}
+
+func isDev(configuration string) bool {
+	for _, prefix := range devConfigurations {
+		if strings.HasPrefix(configuration, prefix) {
+			return true
+		}
+	}
+
+	return strings.Contains(configuration, "Test")
+}
+
+// readVariables collects the string variables of the build script and gradle.properties.
+func readVariables(path string, file []byte) map[string]string {
+	variables := make(map[string]string)
+
+	if properties, err := os.Open(filepath.Join(filepath.Dir(path), "gradle.properties")); err == nil {
+		defer properties.Close()
+
+		scanner := bufio.NewScanner(properties)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+
+			if key, value, ok := strings.Cut(line, "="); ok {
+				variables[strings.TrimSpace(key)] = strings.TrimSpace(value)
+			}
+		}
+	}
+
+	for _, line := range strings.Split(string(file), "\n") {
+		if matches := variablePattern.FindStringSubmatch(line); matches != nil {
+			variables[matches[1]] = matches[2]
+		}
+	}
+
+	return variables
+}
+
+// readLockfile parses gradle.lockfile lines like `com.google.guava:guava:33.3.0-jre=compileClasspath`.
+func readLockfile(path string) (map[string]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	locked := make(map[string]string)
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "empty=") {
+			continue
+		}
+
+		coordinates, _, _ := strings.Cut(line, "=")
+		parts := strings.Split(coordinates, ":")
+		if len(parts) != 3 {
+			continue
+		}
+
+		locked[parts[0]+":"+parts[1]] = parts[2]
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return locked, nil
+}
+
+// LockfilePath returns the gradle.lockfile of the project. Version catalogs
+// live in the gradle directory, so their lockfile is in the parent directory.
+func (Gradle) LockfilePath(path string) (string, error) {
+	dir := filepath.Dir(path)
+	if strings.HasSuffix(path, ".versions.toml") && filepath.Base(dir) == "gradle" {
+		dir = filepath.Dir(dir)
+	}
+
+	lockfilePath := filepath.Join(dir, "gradle.lockfile")
+
+	if _, err := os.Stat(lockfilePath); os.IsNotExist(err) {
+		return "", fmt.Errorf("lockfile not found")
+	}
+
+	return lockfilePath, nil
+}
+
+// Returns the version without any prefix or suffix
+func cleanVersion(version string) string {
+	// Use the lower bound of ranges like "[1.0,2.0)"
+	if idx := strings.Index(version, ","); idx != -1 {
+		version = version[:idx]
+	}
+
+	// Dynamic versions like "1.+" or "latest.release" can't be checked
+	if strings.HasPrefix(version, "latest.") {
+		return ""
+	}
+
+	return strings.Trim(version, "[](+. ")
+}
@@ -0,0 +1,295 @@
+package gradle
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/depshubhq/depshub/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGradle_GetType(t *testing.T) {
+	manager := Gradle{}
+	assert.Equal(t, types.Maven, manager.GetType())
+}
+
+func TestGradle_Managed(t *testing.T) {
+	manager := Gradle{}
+	tests := []struct {
+		name     string
+		path     string
+		expected bool
+	}{
+		{
+			name:     "groovy build script",
+			path:     "path/to/build.gradle",
+			expected: true,
+		},
+		{
+			name:     "kotlin build script",
+			path:     "path/to/build.gradle.kts",
+			expected: true,
+		},
+		{
+			name:     "version catalog",
+			path:     "path/to/gradle/libs.versions.toml",
+			expected: true,
+		},
+		{
+			name:     "settings script",
+			path:     "path/to/settings.gradle.kts",
+			expected: false,
+		},
+		{
+			name:     "lockfile",
+			path:     "path/to/gradle.lockfile",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := manager.Managed(tt.path)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestGradle_DependenciesKotlin(t *testing.T) {
+	manager := Gradle{}
+	testPath := filepath.Join("testdata", "build.gradle.kts")
+
+	dependencies, err := manager.Dependencies(testPath)
+	assert.NoError(t, err)
+
+	dependency := func(name, version string, dev bool, line int, rawLine string) types.Dependency {
+		return types.Dependency{
+			Manager: types.Maven,
+			Name:    name,
+			Version: version,
+			Dev:     dev,
+			Definition: types.Definition{
+				Path:    testPath,
+				RawLine: rawLine,
+				Line:    line,
+			},
+		}
+	}
+
+	expected := []types.Dependency{
+		dependency("org.springframework.boot:spring-boot-dependencies", "3.3.4", false, 8, `implementation(platform("org.springframework.boot:spring-boot-dependencies:3.3.4"))`),
+		dependency("org.jetbrains.kotlinx:kotlinx-coroutines-core", "1.9.0", false, 9, `implementation("org.jetbrains.kotlinx:kotlinx-coroutines-core:$coroutinesVersion")`),
+		dependency("org.slf4j:slf4j-api", "2.0.16", false, 10, `implementation("org.slf4j:slf4j-api:${slf4jVersion}")`),
+		dependency("org.springframework.boot:spring-boot-starter-web", "", false, 13, `implementation("org.springframework.boot:spring-boot-starter-web")`),
+		dependency("org.postgresql:postgresql", "42.7.4", false, 14, `runtimeOnly(group = "org.postgresql", name = "postgresql", version = "42.7.4")`),
+		dependency("org.projectlombok:lombok", "1.18.34", true, 15, `compileOnly("org.projectlombok:lombok:1.18.34")`),
+		dependency("org.junit.jupiter:junit-jupiter", "5.11.0", true, 16, `testImplementation("org.junit.jupiter:junit-jupiter:5.11.0")`),
+		// The dynamic version is resolved from gradle.lockfile
+		dependency("io.mockk:mockk", "1.13.12", true, 17, `testImplementation("io.mockk:mockk:1.+")`),
+	}
+
+	assert.Equal(t, expected, dependencies)
+}
+
+func TestGradle_DependenciesGroovy(t *testing.T) {
+	manager := Gradle{}
+	testPath := filepath.Join("testdata", "groovy", "build.gradle")
+
+	dependencies, err := manager.Dependencies(testPath)
+	assert.NoError(t, err)
+
+	expected := []types.Dependency{
+		{
+			Manager: types.Maven,
+			Name:    "com.google.guava:guava",
+			Version: "33.3.0-jre",
+			Definition: types.Definition{
+				Path:    testPath,
+				RawLine: "implementation 'com.google.guava:guava:33.3.0-jre'",
+				Line:    6,
+			},
+		},
+		{
+			Manager: types.Maven,
+			Name:    "com.fasterxml.jackson.core:jackson-databind",
+			Version: "2.17.2",
+			Definition: types.Definition{
+				Path:    testPath,
+				RawLine: `implementation "com.fasterxml.jackson.core:jackson-databind:${jacksonVersion}"`,
+				Line:    7,
+			},
+		},
+		{
+			Manager: types.Maven,
+			Name:    "org.slf4j:slf4j-api",
+			Version: "2.0.16",
+			Definition: types.Definition{
+				Path:    testPath,
+				RawLine: "implementation group: 'org.slf4j', name: 'slf4j-api', version: '2.0.16'",
+				Line:    8,
+			},
+		},
+		{
+			Manager: types.Maven,
+			Name:    "junit:junit",
+			Version: "4.13.2",
+			Dev:     true,
+			Definition: types.Definition{
+				Path:    testPath,
+				RawLine: "testImplementation 'junit:junit:4.13.2'",
+				Line:    9,
+			},
+		},
+		{
+			Manager: types.Maven,
+			Name:    "org.junit.vintage:junit-vintage-engine",
+			Version: "5.11.0",
+			Dev:     true,
+			Definition: types.Definition{
+				Path:    testPath,
+				RawLine: "testRuntimeOnly 'org.junit.vintage:junit-vintage-engine:5.11.0'",
+				Line:    10,
+			},
+		},
+	}
+
+	assert.Equal(t, expected, dependencies)
+}
+
+func TestGradle_DependenciesCatalog(t *testing.T) {
+	manager := Gradle{}
+	testPath := filepath.Join("testdata", "gradle", "libs.versions.toml")
+
+	dependencies, err := manager.Dependencies(testPath)
+	assert.NoError(t, err)
+
+	expected := []types.Dependency{
+		{
+			Manager: types.Maven,
+			Name:    "com.google.guava:guava",
+			Version: "33.3.0-jre",
+			Definition: types.Definition{
+				Path:    testPath,
+				RawLine: `guava = "com.google.guava:guava:33.3.0-jre"`,
+				Line:    7,
+			},
+		},
+		{
+			Manager: types.Maven,
+			Name:    "com.fasterxml.jackson.core:jackson-databind",
+			Version: "2.17.2",
+			Definition: types.Definition{
+				Path:    testPath,
+				RawLine: `jackson-databind = { module = "com.fasterxml.jackson.core:jackson-databind", version.ref = "jackson" }`,
+				Line:    8,
+			},
+		},
+		{
+			Manager: types.Maven,
+			Name:    "com.squareup.okhttp3:okhttp",
+			Version: "4.12.0",
+			Definition: types.Definition{
+				Path:    testPath,
+				RawLine: `okhttp = { group = "com.squareup.okhttp3", name = "okhttp", version = "4.12.0" }`,
+				Line:    9,
+			},
+		},
+		{
+			Manager: types.Maven,
+			Name:    "org.junit.jupiter:junit-jupiter",
+			Version: "5.11.0",
+			Definition: types.Definition{
+				Path:    testPath,
+				RawLine: `junit-jupiter = { module = "org.junit.jupiter:junit-jupiter", version.ref = "junit" }`,
+				Line:    10,
+			},
+		},
+		{
+			Manager: types.Maven,
+			Name:    "org.jetbrains.kotlin:kotlin-bom",
+			Version: "",
+			Definition: types.Definition{
+				Path:    testPath,
+				RawLine: `kotlin-bom = { module = "org.jetbrains.kotlin:kotlin-bom" }`,
+				Line:    11,
+			},
+		},
+	}
+
+	assert.Equal(t, expected, dependencies)
+}
+
+func TestGradle_LockfilePath(t *testing.T) {
+	manager := Gradle{}
+	tests := []struct {
+		name        string
+		inputPath   string
+		expected    string
+		expectError bool
+	}{
+		{
+			name:      "build script",
+			inputPath: filepath.Join("testdata", "build.gradle.kts"),
+			expected:  filepath.Join("testdata", "gradle.lockfile"),
+		},
+		{
+			name:      "version catalog",
+			inputPath: filepath.Join("testdata", "gradle", "libs.versions.toml"),
+			expected:  filepath.Join("testdata", "gradle.lockfile"),
+		},
+		{
+			name:        "missing lockfile",
+			inputPath:   filepath.Join("testdata", "groovy", "build.gradle"),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lockfilePath, err := manager.LockfilePath(tt.inputPath)
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Empty(t, lockfilePath)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, lockfilePath)
+			}
+		})
+	}
+}
+
+func TestCleanVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  string
+		expected string
+	}{
+		{
+			name:     "exact version",
+			version:  "33.3.0-jre",
+			expected: "33.3.0-jre",
+		},
+		{
+			name:     "dynamic version",
+			version:  "1.+",
+			expected: "1",
+		},
+		{
+			name:     "range",
+			version:  "[1.0,2.0)",
+			expected: "1.0",
+		},
+		{
+			name:     "latest",
+			version:  "latest.release",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := cleanVersion(tt.version)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
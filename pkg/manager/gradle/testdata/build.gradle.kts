@@ -0,0 +1,18 @@
+plugins {
+    kotlin("jvm") version "2.0.20"
+}
+
+val coroutinesVersion = "1.9.0"
+
+dependencies {
+    implementation(platform("org.springframework.boot:spring-boot-dependencies:3.3.4"))
+    implementation("org.jetbrains.kotlinx:kotlinx-coroutines-core:$coroutinesVersion")
+    implementation("org.slf4j:slf4j-api:${slf4jVersion}")
+    implementation(libs.guava)
+    implementation(project(":core"))
+    implementation("org.springframework.boot:spring-boot-starter-web")
+    runtimeOnly(group = "org.postgresql", name = "postgresql", version = "42.7.4")
+    compileOnly("org.projectlombok:lombok:1.18.34")
+    testImplementation("org.junit.jupiter:junit-jupiter:5.11.0")
+    testImplementation("io.mockk:mockk:1.+")
+}
@@ -0,0 +1,171 @@
+package helm
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/depshubhq/depshub/pkg/types"
+	"gopkg.in/yaml.v3"
+)
+
+type Helm struct{}
+
+func (Helm) GetType() types.ManagerType {
+	return types.Helm
+}
+
+// Managed checks for charts. Charts of apiVersion v1 declare their
+// dependencies in requirements.yaml instead of Chart.yaml.
+func (Helm) Managed(path string) bool {
+	base := filepath.Base(path)
+	return base == "Chart.yaml" || base == "requirements.yaml"
+}
+
+func (Helm) Dependencies(path string) ([]types.Dependency, error) {
+	var dependencies []types.Dependency
+
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var document yaml.Node
+	if err := yaml.Unmarshal(file, &document); err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+
+	if len(document.Content) == 0 {
+		return nil, nil
+	}
+
+	lines := strings.Split(string(file), "\n")
+
+	charts := mappingValue(document.Content[0], "dependencies")
+	if charts == nil || charts.Kind != yaml.SequenceNode {
+		return nil, nil
+	}
+
+	for _, chart := range charts.Content {
+		var fields struct {
+			Name       string `yaml:"name"`
+			Version    string `yaml:"version"`
+			Repository string `yaml:"repository"`
+		}
+
+		if err := chart.Decode(&fields); err != nil {
+			return nil, fmt.Errorf("error parsing %s: %w", path, err)
+		}
+
+		name, ok := ChartName(fields.Repository, fields.Name)
+		if !ok {
+			continue
+		}
+
+		dependencies = append(dependencies, types.Dependency{
+			Manager: types.Helm,
+			Name:    name,
+			Version: cleanVersion(fields.Version),
+			Definition: types.Definition{
+				Path:    path,
+				RawLine: strings.TrimSpace(lines[chart.Line-1]),
+				Line:    chart.Line,
+			},
+		})
+	}
+
+	// Use the versions from Chart.lock when there is one
+	if lockfilePath, err := (Helm{}).LockfilePath(path); err == nil {
+		locked, err := ReadLockfile(lockfilePath)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, dep := range dependencies {
+			if version, ok := locked[dep.Name]; ok {
+				dependencies[i].Version = version
+			}
+		}
+	}
+
+	return dependencies, nil
+}
+
+// ChartName returns the name of a chart including its repository, like
+// https://charts.bitnami.com/bitnami/postgresql, since charts with the same name
+// are published by many repositories. Repositories added with `helm repo add`
+// are referenced as @bitnami/postgresql. Local charts can't be checked.
+func ChartName(repository string, chart string) (string, bool) {
+	if chart == "" || repository == "" || strings.HasPrefix(repository, "file://") {
+		return "", false
+	}
+
+	if alias, ok := strings.CutPrefix(repository, "alias:"); ok {
+		repository = "@" + alias
+	}
+
+	return strings.TrimSuffix(repository, "/") + "/" + chart, true
+}
+
+// mappingValue returns the value of key in a YAML mapping, if any.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+
+	return nil
+}
+
+func (Helm) LockfilePath(path string) (string, error) {
+	lockfile := "Chart.lock"
+	if filepath.Base(path) == "requirements.yaml" {
+		lockfile = "requirements.lock"
+	}
+
+	lockfilePath := filepath.Join(filepath.Dir(path), lockfile)
+
+	if _, err := os.Stat(lockfilePath); os.IsNotExist(err) {
+		return "", fmt.Errorf("lockfile not found")
+	}
+
+	return lockfilePath, nil
+}
+
+// Returns the version without any prefix or suffix
+func cleanVersion(version string) string {
+	// Use the first constraint of "^1.0 || ^2.0" and ">= 1.0, < 2.0"
+	for _, separator := range []string{"||", ","} {
+		if idx := strings.Index(version, separator); idx != -1 {
+			version = version[:idx]
+		}
+	}
+
+	// The operator may be followed by a space like ">= 1.0 <2.0"
+	fields := strings.Fields(version)
+	if len(fields) == 0 {
+		return ""
+	}
+
+	version = fields[0]
+	if strings.Trim(version, "^~><=!") == "" && len(fields) > 1 {
+		version += fields[1]
+	}
+
+	// Wildcards like 1.2.x match the lowest version
+	for _, wildcard := range []string{".x", ".X", ".*"} {
+		version = strings.TrimSuffix(version, wildcard)
+	}
+
+	if version == "x" || version == "X" || version == "*" {
+		return ""
+	}
+
+	return strings.TrimLeft(version, "v^~><=!")
+}
@@ -0,0 +1,151 @@
+package helm
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/depshubhq/depshub/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHelm_GetType(t *testing.T) {
+	manager := Helm{}
+	assert.Equal(t, types.Helm, manager.GetType())
+}
+
+func TestHelm_Managed(t *testing.T) {
+	manager := Helm{}
+	tests := []struct {
+		name     string
+		path     string
+		expected bool
+	}{
+		{name: "chart", path: "charts/platform/Chart.yaml", expected: true},
+		{name: "requirements of v1 charts", path: "charts/legacy/requirements.yaml", expected: true},
+		{name: "lockfile", path: "charts/platform/Chart.lock", expected: false},
+		{name: "values", path: "charts/platform/values.yaml", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := manager.Managed(tt.path)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestHelm_Dependencies(t *testing.T) {
+	manager := Helm{}
+
+	dependency := func(path, name, version string, line int, rawLine string) types.Dependency {
+		return types.Dependency{
+			Manager: types.Helm,
+			Name:    name,
+			Version: version,
+			Definition: types.Definition{
+				Path:    path,
+				RawLine: rawLine,
+				Line:    line,
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		path     string
+		expected func(path string) []types.Dependency
+	}{
+		{
+			name: "with lockfile",
+			path: filepath.Join("testdata", "Chart.yaml"),
+			expected: func(path string) []types.Dependency {
+				return []types.Dependency{
+					dependency(path, "https://charts.bitnami.com/bitnami/postgresql", "15.5.38", 9, "- name: postgresql"),
+					dependency(path, "https://charts.bitnami.com/bitnami/redis", "19.6.4", 13, "- name: redis"),
+					dependency(path, "@ingress-nginx/ingress-nginx", "4.10.1", 16, "- name: ingress-nginx"),
+					dependency(path, "oci://registry-1.docker.io/bitnamicharts/rabbitmq", "14.6.6", 19, "- alias: queue"),
+				}
+			},
+		},
+		{
+			name: "requirements without lockfile",
+			path: filepath.Join("testdata", "legacy", "requirements.yaml"),
+			expected: func(path string) []types.Dependency {
+				return []types.Dependency{
+					dependency(path, "@stable/mysql", "1.6.0", 2, "- name: mysql"),
+				}
+			},
+		},
+		{
+			name: "chart without dependencies",
+			path: filepath.Join("testdata", "legacy", "Chart.yaml"),
+			expected: func(path string) []types.Dependency {
+				return nil
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dependencies, err := manager.Dependencies(tt.path)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected(tt.path), dependencies)
+		})
+	}
+}
+
+func TestHelm_LockfilePath(t *testing.T) {
+	manager := Helm{}
+	tests := []struct {
+		name        string
+		inputPath   string
+		expected    string
+		expectError bool
+	}{
+		{
+			name:      "existing lockfile",
+			inputPath: filepath.Join("testdata", "Chart.yaml"),
+			expected:  filepath.Join("testdata", "Chart.lock"),
+		},
+		{
+			name:        "missing requirements.lock",
+			inputPath:   filepath.Join("testdata", "legacy", "requirements.yaml"),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lockfilePath, err := manager.LockfilePath(tt.inputPath)
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Empty(t, lockfilePath)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, lockfilePath)
+			}
+		})
+	}
+}
+
+func TestCleanVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  string
+		expected string
+	}{
+		{name: "exact version", version: "15.5.38", expected: "15.5.38"},
+		{name: "tilde", version: "~15.5.0", expected: "15.5.0"},
+		{name: "range", version: ">= 18.0.0, < 20.0.0", expected: "18.0.0"},
+		{name: "alternatives", version: "^1.6.0 || ^2.0.0", expected: "1.6.0"},
+		{name: "wildcard", version: "4.10.x", expected: "4.10"},
+		{name: "any", version: "*", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := cleanVersion(tt.version)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package helm
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+type lockfile struct {
+	Dependencies []struct {
+		Name       string `yaml:"name"`
+		Repository string `yaml:"repository"`
+		Version    string `yaml:"version"`
+	} `yaml:"dependencies"`
+}
+
+// ReadLockfile parses Chart.lock or requirements.lock and returns the locked
+// versions keyed like the names of the dependencies.
+func ReadLockfile(path string) (map[string]string, error) {
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var lock lockfile
+	if err := yaml.Unmarshal(file, &lock); err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+
+	locked := make(map[string]string)
+	for _, dep := range lock.Dependencies {
+		if name, ok := ChartName(dep.Repository, dep.Name); ok {
+			locked[name] = dep.Version
+		}
+	}
+
+	return locked, nil
+}
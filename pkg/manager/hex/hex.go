@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"regexp"
 	"strings"
 
 	"github.com/depshubhq/depshub/pkg/types"
@@ -28,54 +27,46 @@ func (Hex) Dependencies(path string) ([]types.Dependency, error) {
 		return nil, err
 	}
 
-	content := string(file)
-	lines := strings.Split(content, "\n")
-
-	// Regex pattern to match dependencies in mix.exs
-	// Matches lines like: `{:ecto, "~> 3.7"}`
-	dependencyPattern := regexp.MustCompile(`^\s*\{\s*:(\w+),\s*"([^"]+)"`)
-	inDepsBlock := false
+	project, err := parseProject(string(file))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", path, err)
+	}
 
-	for lineNum, line := range lines {
-		trimmedLine := strings.TrimSpace(line)
+	lines := strings.Split(string(file), "\n")
 
-		// Detect start of deps block
-		if strings.HasPrefix(trimmedLine, "defp deps do") {
-			inDepsBlock = true
-			continue
+	// The versions of mix.exs are kept when mix.lock can't be read, the scanner reports the error
+	var lock map[string]LockEntry
+	if lockfilePath, err := (Hex{}).LockfilePath(path); err == nil {
+		if entries, err := ReadLockfile(lockfilePath); err == nil {
+			lock = entries
 		}
+	}
 
-		// Detect end of deps block
-		if inDepsBlock && trimmedLine == "end" {
-			break
+	for _, dep := range project.dependencies {
+		version := cleanVersion(dep.requirement)
+
+		// Use the version from mix.lock when there is one
+		if entry, ok := lock[dep.app]; ok && entry.Version != "" {
+			version = entry.Version
 		}
 
-		if inDepsBlock {
-			// Skip lines with git dependencies
-			if strings.Contains(trimmedLine, "git:") {
-				continue
-			}
-
-			matches := dependencyPattern.FindStringSubmatch(trimmedLine)
-			if matches == nil {
-				continue
-			}
-
-			name := matches[1]
-			version := cleanVersion(matches[2])
-
-			dependencies = append(dependencies, types.Dependency{
-				Manager: types.Hex,
-				Name:    name,
-				Version: version,
-				Dev:     false,
-				Definition: types.Definition{
-					Path:    path,
-					RawLine: strings.TrimSpace(line),
-					Line:    lineNum + 1, // Line number starts from 1
-				},
-			})
+		rawLine := ""
+		if dep.line > 0 && dep.line <= len(lines) {
+			rawLine = strings.TrimSpace(lines[dep.line-1])
 		}
+
+		dependencies = append(dependencies, types.Dependency{
+			Manager:    types.Hex,
+			Name:       dep.name,
+			Version:    version,
+			Constraint: dep.requirement,
+			Dev:        dep.dev,
+			Definition: types.Definition{
+				Path:    path,
+				RawLine: rawLine,
+				Line:    dep.line,
+			},
+		})
 	}
 
 	return dependencies, nil
@@ -83,22 +74,45 @@ func (Hex) Dependencies(path string) ([]types.Dependency, error) {
 
 // Returns the version without any prefix or suffix
 func cleanVersion(version string) string {
+	// Use the first requirement of "~> 1.0 or ~> 2.0"
+	for _, separator := range []string{" or ", " and "} {
+		if idx := strings.Index(version, separator); idx != -1 {
+			version = version[:idx]
+		}
+	}
+
 	// Trim spaces
 	version = strings.TrimSpace(version)
 
 	// Remove constraints like >=, ~>, etc.
 	version = strings.TrimPrefix(version, ">=")
 	version = strings.TrimPrefix(version, "~>")
+	version = strings.TrimPrefix(version, "==")
 
 	return strings.TrimSpace(version)
 }
 
+// LockfilePath returns the mix.lock used by the project. Umbrella children
+// share the lockfile of the umbrella root, configured with `lockfile: "../../mix.lock"`.
 func (Hex) LockfilePath(path string) (string, error) {
-	// Check for requirements.txt in the same directory
-	lockfilePath := filepath.Join(filepath.Dir(path), "mix.lock")
+	dir := filepath.Dir(path)
+	candidates := []string{filepath.Join(dir, "mix.lock")}
+
+	if file, err := os.ReadFile(path); err == nil {
+		if project, err := parseProject(string(file)); err == nil && project.lockfile != "" {
+			candidates = []string{filepath.Join(dir, project.lockfile)}
+		}
+	}
 
-	if _, err := os.Stat(lockfilePath); err == nil {
-		return lockfilePath, nil
+	// Apps of an umbrella project live under apps/*
+	if filepath.Base(filepath.Dir(dir)) == "apps" {
+		candidates = append(candidates, filepath.Join(dir, "..", "..", "mix.lock"))
+	}
+
+	for _, lockfilePath := range candidates {
+		if _, err := os.Stat(lockfilePath); err == nil {
+			return lockfilePath, nil
+		}
 	}
 
 	return "", fmt.Errorf("no lockfile found")
@@ -3,6 +3,7 @@ package hex
 import (
 	"github.com/depshubhq/depshub/pkg/types"
 	"github.com/stretchr/testify/assert"
+	"os"
 	"path/filepath"
 	"testing"
 )
@@ -73,7 +74,7 @@ func TestHex_Dependencies(t *testing.T) {
 			Manager: types.Hex,
 			Name:    "phoenix_live_reload",
 			Version: "1.2",
-			Dev:     false,
+			Dev:     true,
 			Definition: types.Definition{
 				Path:    testPath,
 				RawLine: "{:phoenix_live_reload, \"~> 1.2\", only: :dev},",
@@ -92,6 +93,208 @@ func TestHex_Dependencies(t *testing.T) {
 	}
 }
 
+func TestHex_DependenciesInvalidLockfile(t *testing.T) {
+	dir := t.TempDir()
+	content, err := os.ReadFile(filepath.Join("testdata", "umbrella", "mix.exs"))
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "mix.exs"), content, 0o644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "mix.lock"), []byte("[\"credo\"]"), 0o644))
+
+	// The versions of mix.exs are kept when mix.lock can't be read
+	dependencies, err := Hex{}.Dependencies(filepath.Join(dir, "mix.exs"))
+	assert.NoError(t, err)
+	assert.Len(t, dependencies, 1)
+	assert.Equal(t, "1.7", dependencies[0].Version)
+}
+
+func TestHex_DependenciesUmbrella(t *testing.T) {
+	manager := Hex{}
+	tests := []struct {
+		name     string
+		path     string
+		expected []types.Dependency
+	}{
+		{
+			name: "umbrella root",
+			path: filepath.Join("testdata", "umbrella", "mix.exs"),
+			expected: []types.Dependency{
+				{
+					Name:    "credo",
+					Version: "1.7.8",
+					Dev:     true,
+					Definition: types.Definition{
+						RawLine: "defp deps, do: [{:credo, \"~> 1.7\", only: [:dev, :test], runtime: false}]",
+						Line:    16,
+					},
+				},
+			},
+		},
+		{
+			name: "umbrella app",
+			path: filepath.Join("testdata", "umbrella", "apps", "web", "mix.exs"),
+			expected: []types.Dependency{
+				{
+					Name:    "jason",
+					Version: "1.4.4",
+					Dev:     false,
+					Definition: types.Definition{
+						RawLine: "{:jason, @jason_version},",
+						Line:    22,
+					},
+				},
+				{
+					Name:    "plug_cowboy",
+					Version: "2.7",
+					Dev:     false,
+					Definition: types.Definition{
+						RawLine: ":plug_cowboy,",
+						Line:    24,
+					},
+				},
+				{
+					Name:    "hackney",
+					Version: "1.20.1",
+					Dev:     false,
+					Definition: types.Definition{
+						RawLine: "{:hackney_fork, \"~> 1.20\", hex: :hackney},",
+						Line:    28,
+					},
+				},
+				{
+					Name:    "mox",
+					Version: "1.2.0",
+					Dev:     true,
+					Definition: types.Definition{
+						RawLine: "{:mox, \"~> 1.0\", only: :test},",
+						Line:    30,
+					},
+				},
+				{
+					Name:    "telemetry",
+					Version: "1.0",
+					Dev:     false,
+					Definition: types.Definition{
+						RawLine: "{:telemetry, \"~> 1.0 or ~> 0.4\", only: [:prod, :dev]}",
+						Line:    31,
+					},
+				},
+				{
+					Name:    "excoveralls",
+					Version: "0.18",
+					Dev:     true,
+					Definition: types.Definition{
+						RawLine: "[{:excoveralls, \"~> 0.18\", [only: :test]}]",
+						Line:    37,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dependencies, err := manager.Dependencies(tt.path)
+			assert.NoError(t, err)
+
+			assert.Equal(t, len(tt.expected), len(dependencies))
+			for i, exp := range tt.expected {
+				assert.Equal(t, exp.Name, dependencies[i].Name)
+				assert.Equal(t, exp.Version, dependencies[i].Version)
+				assert.Equal(t, exp.Dev, dependencies[i].Dev)
+				assert.Equal(t, exp.Line, dependencies[i].Line)
+				assert.Equal(t, exp.RawLine, dependencies[i].RawLine)
+			}
+		})
+	}
+}
+
+func TestReadLockfile(t *testing.T) {
+	entries, err := ReadLockfile(filepath.Join("testdata", "umbrella", "mix.lock"))
+	assert.NoError(t, err)
+
+	// Git dependencies are skipped
+	assert.Len(t, entries, 4)
+	assert.NotContains(t, entries, "heroicons")
+
+	assert.Equal(t, LockEntry{
+		Name:          "credo",
+		Version:       "1.7.8",
+		Checksum:      "9722ba1681e973025908d542ec3d95db5f9c549251ba5b028e251ad8c24ab8c5",
+		OuterChecksum: "cb9e87cc64f152f3ed1c6e325e7b894dea8f5ef2e41123bd864e3cd5ceb44968",
+		Repository:    "hexpm",
+		Dependencies:  []string{"bunt", "file_system", "jason"},
+	}, entries["credo"])
+
+	// Aliased packages are keyed by the application name
+	assert.Equal(t, "hackney", entries["hackney_fork"].Name)
+	assert.Equal(t, "1.20.1", entries["hackney_fork"].Version)
+}
+
+func TestHex_LockedPackages(t *testing.T) {
+	packages, err := Hex{}.LockedPackages(filepath.Join("testdata", "umbrella", "mix.lock"))
+	assert.NoError(t, err)
+
+	assert.Len(t, packages, 4)
+
+	// Dependencies missing from the lockfile are optional ones that aren't used
+	assert.Contains(t, packages, types.LockedPackage{
+		Manager:      types.Hex,
+		Name:         "credo",
+		Version:      "1.7.8",
+		Hashes:       []types.Hash{{Algorithm: "SHA-256", Value: "cb9e87cc64f152f3ed1c6e325e7b894dea8f5ef2e41123bd864e3cd5ceb44968"}},
+		Dependencies: []string{"jason@1.4.4"},
+	})
+}
+
+func TestTokenize(t *testing.T) {
+	source := `@attr "a\"b" # comment
+{:name, only: [:dev], "key": ~w(a b)a, x >= 1.0}`
+
+	tokens, err := tokenize(source)
+	assert.NoError(t, err)
+
+	expected := []token{
+		{kind: tokenOperator, value: "@", line: 1},
+		{kind: tokenIdent, value: "attr", line: 1},
+		{kind: tokenString, value: "a\"b", line: 1},
+		{kind: tokenPunct, value: "{", line: 2},
+		{kind: tokenAtom, value: "name", line: 2},
+		{kind: tokenPunct, value: ",", line: 2},
+		{kind: tokenKeyword, value: "only", line: 2},
+		{kind: tokenPunct, value: "[", line: 2},
+		{kind: tokenAtom, value: "dev", line: 2},
+		{kind: tokenPunct, value: "]", line: 2},
+		{kind: tokenPunct, value: ",", line: 2},
+		{kind: tokenKeyword, value: "key", line: 2},
+		{kind: tokenString, value: "a b", line: 2},
+		{kind: tokenPunct, value: ",", line: 2},
+		{kind: tokenIdent, value: "x", line: 2},
+		{kind: tokenOperator, value: ">=", line: 2},
+		{kind: tokenNumber, value: "1.0", line: 2},
+		{kind: tokenPunct, value: "}", line: 2},
+	}
+
+	assert.Equal(t, expected, tokens)
+
+	_, err = tokenize(`"unterminated`)
+	assert.Error(t, err)
+}
+
+func TestParseProject_Truncated(t *testing.T) {
+	for _, source := range []string{
+		"defmodule A do\n def deps, do:",
+		"defmodule A do\n def deps, do: [{:jason,",
+		"defmodule A do\n def project, do: [deps:",
+		"defmodule A do\n defp deps do\n [",
+	} {
+		t.Run(source, func(t *testing.T) {
+			project, err := parseProject(source)
+			assert.NoError(t, err)
+			assert.Empty(t, project.dependencies)
+		})
+	}
+}
+
 func TestHex_LockfilePath(t *testing.T) {
 	manager := Hex{}
 	tests := []struct {
@@ -104,6 +307,16 @@ func TestHex_LockfilePath(t *testing.T) {
 			inputPath:   "testdata/mix.exs",
 			expectError: true,
 		},
+		{
+			name:        "umbrella root lockfile",
+			inputPath:   "testdata/umbrella/mix.exs",
+			expectError: false,
+		},
+		{
+			name:        "umbrella app lockfile",
+			inputPath:   "testdata/umbrella/apps/web/mix.exs",
+			expectError: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -146,6 +359,11 @@ func TestCleanVersion(t *testing.T) {
 			version:  "~> 4.10",
 			expected: "4.10",
 		},
+		{
+			name:     "multiple requirements",
+			version:  "~> 1.0 or ~> 0.4",
+			expected: "1.0",
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,276 @@
+package hex
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokenIdent    tokenKind = iota // foo, Mix, do, end, true
+	tokenAtom                      // :foo, :"foo bar"
+	tokenKeyword                   // foo: or "foo":
+	tokenString                    // "foo", 'foo', """foo""", ~s(foo)
+	tokenNumber                    // 1, 1.0, 0x1F
+	tokenPunct                     // { } [ ] ( ) ,
+	tokenOperator                  // everything else: ++, ==, ., |>, %, @
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+	line  int
+}
+
+// Pairs of sigil delimiters
+var sigilDelimiters = map[rune]rune{
+	'(': ')', '[': ']', '{': '}', '<': '>',
+	'/': '/', '|': '|', '"': '"', '\'': '\'',
+}
+
+// tokenize splits Elixir source code into tokens. It understands enough of the
+// syntax to read mix.exs and mix.lock files: comments, strings, heredocs,
+// sigils, atoms and keyword list keys.
+func tokenize(source string) ([]token, error) {
+	l := lexer{input: []rune(source), line: 1}
+
+	for {
+		l.skipWhitespace()
+		if l.pos >= len(l.input) {
+			break
+		}
+
+		if err := l.next(); err != nil {
+			return nil, err
+		}
+	}
+
+	return l.tokens, nil
+}
+
+type lexer struct {
+	input  []rune
+	pos    int
+	line   int
+	tokens []token
+}
+
+func (l *lexer) peek(offset int) rune {
+	if l.pos+offset >= len(l.input) {
+		return 0
+	}
+	return l.input[l.pos+offset]
+}
+
+func (l *lexer) emit(kind tokenKind, value string, line int) {
+	l.tokens = append(l.tokens, token{kind: kind, value: value, line: line})
+}
+
+func (l *lexer) skipWhitespace() {
+	for l.pos < len(l.input) {
+		c := l.input[l.pos]
+
+		switch {
+		case c == '\n':
+			l.line++
+			l.pos++
+		case unicode.IsSpace(c):
+			l.pos++
+		case c == '#':
+			// Comments last until the end of the line
+			for l.pos < len(l.input) && l.input[l.pos] != '\n' {
+				l.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+func (l *lexer) next() error {
+	c := l.peek(0)
+	line := l.line
+
+	switch {
+	case c == '"' || c == '\'':
+		value, err := l.readQuoted()
+		if err != nil {
+			return err
+		}
+
+		if l.isKeywordEnd() {
+			l.pos++
+			l.emit(tokenKeyword, value, line)
+		} else {
+			l.emit(tokenString, value, line)
+		}
+	case c == ':' && (isIdentStart(l.peek(1)) || l.peek(1) == '"'):
+		l.pos++
+		if l.peek(0) == '"' {
+			value, err := l.readQuoted()
+			if err != nil {
+				return err
+			}
+			l.emit(tokenAtom, value, line)
+		} else {
+			l.emit(tokenAtom, l.readIdent(), line)
+		}
+	case c == '~' && unicode.IsLetter(l.peek(1)):
+		value, err := l.readSigil()
+		if err != nil {
+			return err
+		}
+		l.emit(tokenString, value, line)
+	case c == '?' && l.peek(1) != 0 && !unicode.IsSpace(l.peek(1)):
+		// Character literals like ?a
+		l.pos += 2
+		l.emit(tokenNumber, string(l.input[l.pos-1]), line)
+	case unicode.IsDigit(c):
+		start := l.pos
+		for l.pos < len(l.input) && (unicode.IsLetter(l.input[l.pos]) || unicode.IsDigit(l.input[l.pos]) || l.input[l.pos] == '_' ||
+			(l.input[l.pos] == '.' && unicode.IsDigit(l.peek(1)))) {
+			l.pos++
+		}
+		l.emit(tokenNumber, string(l.input[start:l.pos]), line)
+	case isIdentStart(c):
+		value := l.readIdent()
+
+		if l.isKeywordEnd() {
+			l.pos++
+			l.emit(tokenKeyword, value, line)
+		} else {
+			l.emit(tokenIdent, value, line)
+		}
+	case strings.ContainsRune("{}[](),", c):
+		l.pos++
+		l.emit(tokenPunct, string(c), line)
+	default:
+		start := l.pos
+		for l.pos < len(l.input) && isOperator(l.input[l.pos]) {
+			l.pos++
+		}
+
+		if start == l.pos {
+			return fmt.Errorf("unexpected character %q on line %d", c, line)
+		}
+
+		l.emit(tokenOperator, string(l.input[start:l.pos]), line)
+	}
+
+	return nil
+}
+
+// isKeywordEnd checks if the current position is the colon of a keyword key like `only: :dev`.
+func (l *lexer) isKeywordEnd() bool {
+	if l.peek(0) != ':' {
+		return false
+	}
+
+	next := l.peek(1)
+	return next == 0 || unicode.IsSpace(next)
+}
+
+func (l *lexer) readIdent() string {
+	start := l.pos
+	for l.pos < len(l.input) && (isIdentStart(l.input[l.pos]) || unicode.IsDigit(l.input[l.pos])) {
+		l.pos++
+	}
+
+	// Identifiers can end with ? or !
+	if l.pos < len(l.input) && (l.input[l.pos] == '?' || l.input[l.pos] == '!') {
+		l.pos++
+	}
+
+	return string(l.input[start:l.pos])
+}
+
+// readQuoted reads a single, double quoted or heredoc string and returns its content.
+func (l *lexer) readQuoted() (string, error) {
+	quote := l.peek(0)
+	line := l.line
+
+	delimiter := string(quote)
+	if l.peek(1) == quote && l.peek(2) == quote {
+		delimiter = strings.Repeat(string(quote), 3)
+	}
+	l.pos += len(delimiter)
+
+	return l.readUntil(delimiter, line)
+}
+
+// readSigil reads sigils like ~w(a b c) or ~r/regex/i and returns their content.
+func (l *lexer) readSigil() (string, error) {
+	line := l.line
+
+	// Skip ~ and the sigil name
+	l.pos++
+	for l.pos < len(l.input) && unicode.IsLetter(l.input[l.pos]) {
+		l.pos++
+	}
+
+	open := l.peek(0)
+
+	if (open == '"' || open == '\'') && l.peek(1) == open && l.peek(2) == open {
+		value, err := l.readQuoted()
+		l.skipModifiers()
+		return value, err
+	}
+
+	closing, ok := sigilDelimiters[open]
+	if !ok {
+		return "", fmt.Errorf("invalid sigil delimiter %q on line %d", open, line)
+	}
+	l.pos++
+
+	value, err := l.readUntil(string(closing), line)
+	l.skipModifiers()
+
+	return value, err
+}
+
+func (l *lexer) skipModifiers() {
+	for l.pos < len(l.input) && unicode.IsLetter(l.input[l.pos]) {
+		l.pos++
+	}
+}
+
+func (l *lexer) readUntil(delimiter string, line int) (string, error) {
+	var value strings.Builder
+
+	for l.pos < len(l.input) {
+		c := l.input[l.pos]
+
+		if c == '\\' && l.pos+1 < len(l.input) {
+			value.WriteRune(l.input[l.pos+1])
+			if l.input[l.pos+1] == '\n' {
+				l.line++
+			}
+			l.pos += 2
+			continue
+		}
+
+		if strings.HasPrefix(string(l.input[l.pos:min(l.pos+len(delimiter), len(l.input))]), delimiter) {
+			l.pos += len(delimiter)
+			return value.String(), nil
+		}
+
+		if c == '\n' {
+			l.line++
+		}
+
+		value.WriteRune(c)
+		l.pos++
+	}
+
+	return "", fmt.Errorf("unterminated string starting on line %d", line)
+}
+
+func isIdentStart(c rune) bool {
+	return c == '_' || unicode.IsLetter(c)
+}
+
+func isOperator(c rune) bool {
+	return strings.ContainsRune("+-*/=<>!&|^.%@:;~?\\", c)
+}
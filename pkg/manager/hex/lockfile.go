@@ -0,0 +1,115 @@
+package hex
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+// LockEntry is a Hex package resolved in mix.lock.
+type LockEntry struct {
+	// The name of the package on Hex
+	Name    string
+	Version string
+	// The inner checksum of the package contents
+	Checksum string
+	// The outer checksum of the package tarball
+	OuterChecksum string
+	Repository    string
+	// The names of the applications this package depends on
+	Dependencies []string
+}
+
+// ReadLockfile parses mix.lock and returns the Hex packages keyed by application name.
+// Git and path dependencies are skipped since they don't have a version.
+func ReadLockfile(path string) (map[string]LockEntry, error) {
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := tokenize(string(file))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+
+	p := newParser(tokens)
+	lock, _ := p.parsePrimary(0)
+
+	if lock.kind != termMap {
+		return nil, fmt.Errorf("error parsing %s: expected a map", path)
+	}
+
+	entries := make(map[string]LockEntry)
+
+	for app, value := range keywords(lock) {
+		// %{"app": {:hex, :name, "version", "checksum", [:mix], [deps], "hexpm", "outer checksum"}}
+		if value.kind != termTuple || len(value.elements) < 3 || value.elements[0].value != "hex" {
+			continue
+		}
+
+		entry := LockEntry{
+			Name:    value.elements[1].value,
+			Version: value.elements[2].value,
+		}
+
+		if len(value.elements) > 3 {
+			entry.Checksum = value.elements[3].value
+		}
+
+		if len(value.elements) > 5 {
+			for _, dep := range value.elements[5].elements {
+				if dep.kind == termTuple && len(dep.elements) > 0 && dep.elements[0].kind == termAtom {
+					entry.Dependencies = append(entry.Dependencies, dep.elements[0].value)
+				}
+			}
+		}
+
+		if len(value.elements) > 6 {
+			entry.Repository = value.elements[6].value
+		}
+
+		if len(value.elements) > 7 {
+			entry.OuterChecksum = value.elements[7].value
+		}
+
+		entries[app] = entry
+	}
+
+	return entries, nil
+}
+
+// LockedPackages returns the Hex packages of mix.lock. The outer checksum is
+// the SHA-256 of the package tarball.
+func (Hex) LockedPackages(lockfilePath string) ([]types.LockedPackage, error) {
+	lock, err := ReadLockfile(lockfilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var packages []types.LockedPackage
+
+	for _, entry := range lock {
+		pkg := types.LockedPackage{
+			Manager: types.Hex,
+			Name:    entry.Name,
+			Version: entry.Version,
+		}
+
+		if entry.OuterChecksum != "" {
+			pkg.Hashes = []types.Hash{{Algorithm: "SHA-256", Value: entry.OuterChecksum}}
+		}
+
+		// Dependencies missing from the lockfile are optional ones that aren't used
+		for _, app := range entry.Dependencies {
+			if dep, ok := lock[app]; ok {
+				pkg.Dependencies = append(pkg.Dependencies, types.LockedID(dep.Name, dep.Version))
+			}
+		}
+
+		packages = append(packages, pkg)
+	}
+
+	return packages, nil
+}
@@ -0,0 +1,257 @@
+package hex
+
+// Environments that only exist during development
+var devEnvironments = map[string]bool{"dev": true, "test": true}
+
+// Options that point a dependency to something else than a Hex package
+var scmOptions = []string{"git", "github", "path", "in_umbrella"}
+
+type mixDependency struct {
+	// The name of the application in mix.exs and mix.lock
+	app string
+	// The name of the package on Hex, which differs from app for `hex: :name`
+	name        string
+	requirement string
+	dev         bool
+	line        int
+}
+
+type mixProject struct {
+	lockfile     string
+	dependencies []mixDependency
+}
+
+// parseProject reads the dependencies of a mix.exs file. It starts from the
+// function referenced by `deps:` in `project/0` and follows the calls to other
+// functions of the module, so deps split into several functions are found too.
+func parseProject(source string) (mixProject, error) {
+	var project mixProject
+
+	tokens, err := tokenize(source)
+	if err != nil {
+		return project, err
+	}
+
+	p := newParser(tokens)
+	functions := p.functions()
+	root := "deps"
+
+	for _, body := range functions["project"] {
+		for i := body.start; i < body.end; i++ {
+			t := p.at(i)
+			if t.kind != tokenKeyword {
+				continue
+			}
+
+			switch t.value {
+			case "deps":
+				if next := p.at(i + 1); next.kind == tokenIdent {
+					root = next.value
+				}
+			case "lockfile":
+				if next := p.at(i + 1); next.kind == tokenString {
+					project.lockfile = next.value
+				}
+			}
+		}
+	}
+
+	visited := make(map[string]bool)
+	var visit func(name string)
+
+	visit = func(name string) {
+		if visited[name] {
+			return
+		}
+		visited[name] = true
+
+		for _, body := range functions[name] {
+			for i := body.start; i < body.end; {
+				t := p.at(i)
+
+				if p.isPunct(i, "{") {
+					tuple, next := p.parsePrimary(i)
+					if dep, ok := toDependency(tuple); ok {
+						project.dependencies = append(project.dependencies, dep)
+						i = next
+						continue
+					}
+				}
+
+				// Follow calls to other functions of the module
+				if t.kind == tokenIdent {
+					if _, ok := functions[t.value]; ok {
+						visit(t.value)
+					}
+				}
+
+				i++
+			}
+		}
+	}
+
+	visit(root)
+
+	return project, nil
+}
+
+type span struct {
+	start int
+	end   int
+}
+
+// functions returns the token ranges of the bodies of all def and defp
+// functions, grouped by name. Functions with several clauses have several bodies.
+func (p parser) functions() map[string][]span {
+	functions := make(map[string][]span)
+
+	for i := 0; i+1 < len(p.tokens); i++ {
+		t := p.tokens[i]
+		if t.kind != tokenIdent || (t.value != "def" && t.value != "defp") {
+			continue
+		}
+
+		name := p.tokens[i+1]
+		if name.kind != tokenIdent {
+			continue
+		}
+
+		next := i + 2
+		if p.isPunct(next, "(") {
+			_, next = p.parseElements(next+1, ")")
+		}
+
+		// Skip guards like `when is_atom(env)`
+		for next < len(p.tokens) && !(p.at(next).kind == tokenIdent && p.at(next).value == "do") && !p.isPunct(next, ",") {
+			next++
+		}
+
+		switch {
+		case p.isPunct(next, ","):
+			// One line function: def name, do: value
+			if p.at(next+1).kind == tokenKeyword && p.at(next+1).value == "do" {
+				_, end := p.parseExpr(next + 2)
+				functions[name.value] = append(functions[name.value], p.span(next+2, end))
+				i = end - 1
+			}
+		case next < len(p.tokens):
+			end := p.skipBlock(next + 1)
+			functions[name.value] = append(functions[name.value], p.span(next+1, end))
+			i = end
+		}
+	}
+
+	return functions
+}
+
+// span returns the range of tokens from start to end, limited to the tokens of
+// the file since truncated functions like `def deps, do:` end after them.
+func (p parser) span(start int, end int) span {
+	return span{start: min(start, len(p.tokens)), end: min(end, len(p.tokens))}
+}
+
+// skipBlock returns the index of the `end` that closes the current do block.
+func (p parser) skipBlock(i int) int {
+	depth := 0
+
+	for ; i < len(p.tokens); i++ {
+		t := p.tokens[i]
+		if t.kind != tokenIdent {
+			continue
+		}
+
+		switch t.value {
+		case "do", "fn":
+			depth++
+		case "end":
+			if depth == 0 {
+				return i
+			}
+			depth--
+		}
+	}
+
+	return i
+}
+
+// toDependency converts tuples like {:name, "~> 1.0", only: :test} into dependencies.
+func toDependency(t term) (mixDependency, bool) {
+	if t.kind != termTuple || len(t.elements) < 2 || t.elements[0].kind != termAtom {
+		return mixDependency{}, false
+	}
+
+	dep := mixDependency{
+		app:  t.elements[0].value,
+		name: t.elements[0].value,
+		// Multi-line tuples are reported on the line with the name
+		line: t.elements[0].line,
+	}
+
+	rest := t.elements[1:]
+	if rest[0].kind == termString {
+		dep.requirement = rest[0].value
+		rest = rest[1:]
+	}
+
+	// Options are either written as trailing keywords or as an explicit list
+	options := term{kind: termList}
+	for _, element := range rest {
+		switch element.kind {
+		case termPair:
+			options.elements = append(options.elements, element)
+		case termList:
+			for _, option := range element.elements {
+				if option.kind != termPair {
+					return mixDependency{}, false
+				}
+			}
+			options.elements = append(options.elements, element.elements...)
+		default:
+			return mixDependency{}, false
+		}
+	}
+
+	opts := keywords(options)
+
+	// Tuples with neither a requirement nor options aren't dependencies
+	if dep.requirement == "" && len(opts) == 0 {
+		return mixDependency{}, false
+	}
+
+	for _, option := range scmOptions {
+		if _, ok := opts[option]; ok {
+			// Only Hex packages can be checked
+			return mixDependency{}, false
+		}
+	}
+
+	if hex, ok := opts["hex"]; ok && hex.kind == termAtom {
+		dep.name = hex.value
+	}
+
+	if only, ok := opts["only"]; ok {
+		dep.dev = isDevOnly(only)
+	}
+
+	return dep, true
+}
+
+// isDevOnly checks if the `only:` option limits the dependency to dev and test environments.
+func isDevOnly(only term) bool {
+	environments := []term{only}
+	if only.kind == termList {
+		environments = only.elements
+	}
+
+	if len(environments) == 0 {
+		return false
+	}
+
+	for _, env := range environments {
+		if env.kind != termAtom || !devEnvironments[env.value] {
+			return false
+		}
+	}
+
+	return true
+}
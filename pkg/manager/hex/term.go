@@ -0,0 +1,204 @@
+package hex
+
+type termKind int
+
+const (
+	termOther  termKind = iota // expressions we don't need to evaluate
+	termAtom                   // :dev
+	termString                 // "~> 1.0"
+	termIdent                  // true, deps, deps()
+	termList                   // [...]
+	termTuple                  // {...}
+	termMap                    // %{...}
+	termPair                   // key: value, value is the only element
+)
+
+type term struct {
+	kind     termKind
+	value    string
+	elements []term
+	line     int
+}
+
+// parser builds terms out of tokens. Module attributes (@name "value") are
+// substituted with their values when they are used.
+type parser struct {
+	tokens     []token
+	attributes map[string]string
+}
+
+func newParser(tokens []token) parser {
+	p := parser{
+		tokens:     tokens,
+		attributes: make(map[string]string),
+	}
+
+	for i := 0; i+2 < len(tokens); i++ {
+		if tokens[i].kind == tokenOperator && tokens[i].value == "@" &&
+			tokens[i+1].kind == tokenIdent && tokens[i+2].kind == tokenString {
+			p.attributes[tokens[i+1].value] = tokens[i+2].value
+		}
+	}
+
+	return p
+}
+
+func (p parser) at(i int) token {
+	if i >= len(p.tokens) {
+		return token{kind: tokenPunct, value: ""}
+	}
+	return p.tokens[i]
+}
+
+func (p parser) isPunct(i int, value string) bool {
+	t := p.at(i)
+	return t.kind == tokenPunct && t.value == value
+}
+
+// parseExpr parses a single element of a list, tuple or map and returns the
+// index of the token right after it.
+func (p parser) parseExpr(i int) (term, int) {
+	start := p.at(i)
+
+	if start.kind == tokenKeyword {
+		value, next := p.parseExpr(i + 1)
+		return term{kind: termPair, value: start.value, elements: []term{value}, line: start.line}, next
+	}
+
+	t, next := p.parsePrimary(i)
+
+	// Anything followed by an operator or another term is an expression we can't evaluate
+	if !p.isExprEnd(next) {
+		next = p.skipExpr(i)
+		t = term{kind: termOther, line: start.line}
+	}
+
+	return t, next
+}
+
+func (p parser) isExprEnd(i int) bool {
+	if i >= len(p.tokens) {
+		return true
+	}
+
+	t := p.tokens[i]
+	if t.kind != tokenPunct {
+		return false
+	}
+
+	switch t.value {
+	case ",", "]", "}", ")":
+		return true
+	}
+
+	return false
+}
+
+// skipExpr returns the index of the first token after the current expression.
+func (p parser) skipExpr(i int) int {
+	depth := 0
+
+	for ; i < len(p.tokens); i++ {
+		t := p.tokens[i]
+
+		switch {
+		case t.kind == tokenPunct && (t.value == "{" || t.value == "[" || t.value == "("):
+			depth++
+		case t.kind == tokenPunct && (t.value == "}" || t.value == "]" || t.value == ")"):
+			if depth == 0 {
+				return i
+			}
+			depth--
+		case t.kind == tokenPunct && t.value == ",":
+			if depth == 0 {
+				return i
+			}
+		case t.kind == tokenIdent && (t.value == "do" || t.value == "fn"):
+			depth++
+		case t.kind == tokenIdent && t.value == "end":
+			depth--
+		}
+	}
+
+	return i
+}
+
+func (p parser) parsePrimary(i int) (term, int) {
+	t := p.at(i)
+
+	switch {
+	case t.kind == tokenAtom:
+		return term{kind: termAtom, value: t.value, line: t.line}, i + 1
+	case t.kind == tokenString:
+		return term{kind: termString, value: t.value, line: t.line}, i + 1
+	case t.kind == tokenOperator && t.value == "@" && p.at(i+1).kind == tokenIdent:
+		if value, ok := p.attributes[p.at(i+1).value]; ok {
+			return term{kind: termString, value: value, line: t.line}, i + 2
+		}
+		return term{kind: termOther, line: t.line}, i + 2
+	case t.kind == tokenOperator && t.value == "%" && p.isPunct(i+1, "{"):
+		elements, next := p.parseElements(i+2, "}")
+		return term{kind: termMap, elements: elements, line: t.line}, next
+	case p.isPunct(i, "["):
+		elements, next := p.parseElements(i+1, "]")
+		return term{kind: termList, elements: elements, line: t.line}, next
+	case p.isPunct(i, "{"):
+		elements, next := p.parseElements(i+1, "}")
+		return term{kind: termTuple, elements: elements, line: t.line}, next
+	case t.kind == tokenIdent:
+		next := i + 1
+		// Skip the arguments of function calls
+		if p.isPunct(next, "(") {
+			_, next = p.parseElements(next+1, ")")
+		}
+		return term{kind: termIdent, value: t.value, line: t.line}, next
+	}
+
+	return term{kind: termOther, line: t.line}, i + 1
+}
+
+// parseElements parses comma separated elements until the closing bracket.
+func (p parser) parseElements(i int, closing string) ([]term, int) {
+	var elements []term
+
+	for i < len(p.tokens) {
+		if p.isPunct(i, closing) {
+			return elements, i + 1
+		}
+
+		if p.isPunct(i, ",") {
+			i++
+			continue
+		}
+
+		element, next := p.parseExpr(i)
+		elements = append(elements, element)
+
+		// Make sure we always move forward, even on unexpected tokens
+		if next <= i {
+			next = i + 1
+		}
+
+		// Stop on a mismatched closing bracket
+		if next < len(p.tokens) && p.tokens[next].kind == tokenPunct && p.tokens[next].value != closing && p.tokens[next].value != "," {
+			return elements, next + 1
+		}
+
+		i = next
+	}
+
+	return elements, i
+}
+
+// keywords returns the key-value pairs of a keyword list.
+func keywords(t term) map[string]term {
+	result := make(map[string]term)
+
+	for _, element := range t.elements {
+		if element.kind == termPair && len(element.elements) == 1 {
+			result[element.value] = element.elements[0]
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,42 @@
+defmodule Web.MixProject do
+  use Mix.Project
+
+  @jason_version "~> 1.4"
+
+  def project do
+    [
+      app: :web,
+      version: "0.1.0",
+      build_path: "../../_build",
+      config_path: "../../config/config.exs",
+      deps_path: "../../deps",
+      lockfile: "../../mix.lock",
+      elixir: "~> 1.14",
+      deps: deps(Mix.env())
+    ]
+  end
+
+  defp deps(env) when is_atom(env) do
+    [
+      {:core, in_umbrella: true},
+      {:jason, @jason_version},
+      {
+        :plug_cowboy,
+        "~> 2.7",
+        override: true
+      },
+      {:hackney_fork, "~> 1.20", hex: :hackney},
+      # {:commented, "~> 1.0"},
+      {:mox, "~> 1.0", only: :test},
+      {:telemetry, "~> 1.0 or ~> 0.4", only: [:prod, :dev]}
+    ] ++ test_deps()
+  end
+
+  defp test_deps do
+    if System.get_env("CI") do
+      [{:excoveralls, "~> 0.18", [only: :test]}]
+    else
+      []
+    end
+  end
+end
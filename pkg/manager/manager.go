@@ -8,3 +8,9 @@ type Manager interface {
 	LockfilePath(path string) (string, error)
 	Dependencies(path string) ([]types.Dependency, error)
 }
+
+// LockfileReader is implemented by the managers that read the resolved packages
+// of their lockfile, including the transitive ones.
+type LockfileReader interface {
+	LockedPackages(lockfilePath string) ([]types.LockedPackage, error)
+}
@@ -0,0 +1,389 @@
+package maven
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/vifraa/gopom"
+)
+
+const DefaultRemoteRepository = "https://repo1.maven.org/maven2"
+
+// Guards against parent or import cycles
+const maxDepth = 20
+
+// Time allowed to download a POM from the remote repository
+const downloadTimeout = 10 * time.Second
+
+var propertyPattern = regexp.MustCompile(`\$\{([^}]+)\}`)
+
+// model is the effective model of a POM: the project merged with its parents
+// and the BOMs it imports. Managed dependencies keep their raw values until
+// they are used, so properties overridden by children are taken into account.
+type model struct {
+	path       string
+	project    *gopom.Project
+	groupID    string
+	artifactID string
+	version    string
+	properties map[string]string
+	// Managed dependencies keyed by groupId:artifactId
+	managed map[string]gopom.Dependency
+}
+
+// resolver builds effective models. Parents are looked up with relativePath
+// first, then in the local repository and finally in the remote repository.
+// POMs without a parent or an imported BOM are resolved without any download.
+type resolver struct {
+	localRepository  string
+	remoteRepository string
+	client           *http.Client
+	// POMs fetched from the repositories, keyed by groupId:artifactId:version
+	cache map[string]*gopom.Project
+}
+
+func newResolver(m Maven) *resolver {
+	r := &resolver{
+		localRepository:  m.LocalRepository,
+		remoteRepository: m.RemoteRepository,
+		client:           &http.Client{Timeout: downloadTimeout},
+		cache:            make(map[string]*gopom.Project),
+	}
+
+	if r.localRepository == "" {
+		if home, err := os.UserHomeDir(); err == nil {
+			r.localRepository = filepath.Join(home, ".m2", "repository")
+		}
+	}
+
+	if r.remoteRepository == "" {
+		r.remoteRepository = DefaultRemoteRepository
+	}
+
+	return r
+}
+
+// load builds the effective model of the POM file at path.
+func (r *resolver) load(path string) (*model, error) {
+	project, err := gopom.Parse(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.build(project, path, 0)
+}
+
+func (r *resolver) build(project *gopom.Project, path string, depth int) (*model, error) {
+	if depth > maxDepth {
+		return nil, fmt.Errorf("too many nested parents or imports in %s", path)
+	}
+
+	m := &model{
+		path:       path,
+		project:    project,
+		groupID:    value(project.GroupID),
+		artifactID: value(project.ArtifactID),
+		version:    value(project.Version),
+		properties: make(map[string]string),
+		managed:    make(map[string]gopom.Dependency),
+	}
+
+	var parent *model
+	if project.Parent != nil {
+		parent = r.parent(project.Parent, path, depth)
+	}
+
+	if parent != nil {
+		// groupId and version are inherited from the parent when they are missing
+		if m.groupID == "" {
+			m.groupID = parent.groupID
+		}
+		if m.version == "" {
+			m.version = parent.version
+		}
+
+		for k, v := range parent.properties {
+			m.properties[k] = v
+		}
+	} else if project.Parent != nil {
+		if m.groupID == "" {
+			m.groupID = value(project.Parent.GroupID)
+		}
+		if m.version == "" {
+			m.version = value(project.Parent.Version)
+		}
+	}
+
+	if project.Properties != nil {
+		for k, v := range project.Properties.Entries {
+			m.properties[k] = v
+		}
+	}
+
+	// Built-in properties
+	for _, prefix := range []string{"project.", "pom.", ""} {
+		m.properties[prefix+"groupId"] = m.groupID
+		m.properties[prefix+"artifactId"] = m.artifactID
+		m.properties[prefix+"version"] = m.version
+	}
+
+	if project.Parent != nil {
+		m.properties["project.parent.groupId"] = value(project.Parent.GroupID)
+		m.properties["project.parent.artifactId"] = value(project.Parent.ArtifactID)
+		m.properties["project.parent.version"] = value(project.Parent.Version)
+	}
+
+	if parent != nil {
+		for k, v := range parent.managed {
+			m.managed[k] = v
+		}
+	}
+
+	if project.DependencyManagement != nil && project.DependencyManagement.Dependencies != nil {
+		var imports []gopom.Dependency
+
+		for _, dep := range *project.DependencyManagement.Dependencies {
+			resolved := m.interpolateDependency(dep)
+
+			if value(resolved.Scope) == "import" && value(resolved.Type) == "pom" {
+				imports = append(imports, resolved)
+				continue
+			}
+
+			// Keep the raw values so that children can override the properties they use
+			m.managed[key(resolved)] = dep
+		}
+
+		// Declared entries win over imported ones, and the first import wins over the next ones
+		for _, dep := range imports {
+			bom := r.fetch(value(dep.GroupID), value(dep.ArtifactID), value(dep.Version), depth)
+			if bom == nil {
+				continue
+			}
+
+			for k, v := range bom.managed {
+				if _, ok := m.managed[k]; !ok {
+					m.managed[k] = bom.interpolateDependency(v)
+				}
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// parent returns the effective model of the parent POM, or nil if it can't be found.
+func (r *resolver) parent(parent *gopom.Parent, path string, depth int) *model {
+	relativePath := "../pom.xml"
+	if parent.RelativePath != nil {
+		relativePath = *parent.RelativePath
+	}
+
+	if relativePath != "" {
+		parentPath := filepath.Join(filepath.Dir(path), relativePath)
+		if info, err := os.Stat(parentPath); err == nil && info.IsDir() {
+			parentPath = filepath.Join(parentPath, "pom.xml")
+		}
+
+		if project, err := gopom.Parse(parentPath); err == nil && matches(project, parent) {
+			if m, err := r.build(project, parentPath, depth+1); err == nil {
+				return m
+			}
+		}
+	}
+
+	return r.fetch(value(parent.GroupID), value(parent.ArtifactID), value(parent.Version), depth)
+}
+
+// fetch returns the effective model of a POM from the local or remote repository.
+func (r *resolver) fetch(groupID, artifactID, version string, depth int) *model {
+	if groupID == "" || artifactID == "" || version == "" {
+		return nil
+	}
+
+	id := fmt.Sprintf("%s:%s:%s", groupID, artifactID, version)
+	project, ok := r.cache[id]
+
+	if !ok {
+		project = r.download(groupID, artifactID, version)
+		r.cache[id] = project
+	}
+
+	if project == nil {
+		return nil
+	}
+
+	m, err := r.build(project, id, depth+1)
+	if err != nil {
+		return nil
+	}
+
+	return m
+}
+
+func (r *resolver) download(groupID, artifactID, version string) *gopom.Project {
+	pomPath := fmt.Sprintf("%s/%s/%s/%s-%s.pom", strings.ReplaceAll(groupID, ".", "/"), artifactID, version, artifactID, version)
+
+	if r.localRepository != "" {
+		if project, err := gopom.Parse(filepath.Join(r.localRepository, filepath.FromSlash(pomPath))); err == nil {
+			return project
+		}
+	}
+
+	if r.remoteRepository == "" {
+		return nil
+	}
+
+	resp, err := r.client.Get(strings.TrimSuffix(r.remoteRepository, "/") + "/" + pomPath)
+	if err != nil {
+		// The next POMs are only looked up locally, so an unreachable repository doesn't time out again
+		r.remoteRepository = ""
+		return nil
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil
+	}
+
+	project, err := gopom.ParseFromReader(resp.Body)
+	if err != nil {
+		return nil
+	}
+
+	return project
+}
+
+// modules returns the groupId:artifactId of every module built together with
+// the POM at path, starting from the top-most local aggregator.
+func (r *resolver) modules(path string) map[string]bool {
+	root := path
+
+	// Walk up through the local parents to find the aggregator
+	for i := 0; i < maxDepth; i++ {
+		project, err := gopom.Parse(root)
+		if err != nil || project.Parent == nil {
+			break
+		}
+
+		relativePath := "../pom.xml"
+		if project.Parent.RelativePath != nil {
+			relativePath = *project.Parent.RelativePath
+		}
+		if relativePath == "" {
+			break
+		}
+
+		parentPath := filepath.Join(filepath.Dir(root), relativePath)
+		if info, err := os.Stat(parentPath); err == nil && info.IsDir() {
+			parentPath = filepath.Join(parentPath, "pom.xml")
+		}
+
+		parent, err := gopom.Parse(parentPath)
+		if err != nil || !matches(parent, project.Parent) {
+			break
+		}
+
+		root = parentPath
+	}
+
+	result := make(map[string]bool)
+	r.collectModules(root, result, 0)
+
+	return result
+}
+
+func (r *resolver) collectModules(path string, result map[string]bool, depth int) {
+	if depth > maxDepth {
+		return
+	}
+
+	m, err := r.load(path)
+	if err != nil {
+		return
+	}
+
+	result[m.groupID+":"+m.artifactID] = true
+
+	if m.project.Modules == nil {
+		return
+	}
+
+	for _, module := range *m.project.Modules {
+		modulePath := filepath.Join(filepath.Dir(path), module)
+		if info, err := os.Stat(modulePath); err == nil && info.IsDir() {
+			modulePath = filepath.Join(modulePath, "pom.xml")
+		}
+
+		r.collectModules(modulePath, result, depth+1)
+	}
+}
+
+// interpolate replaces ${property} references with their values.
+func (m *model) interpolate(s string) string {
+	for i := 0; i < maxDepth && strings.Contains(s, "${"); i++ {
+		replaced := propertyPattern.ReplaceAllStringFunc(s, func(match string) string {
+			if v, ok := m.properties[match[2:len(match)-1]]; ok {
+				return v
+			}
+			return match
+		})
+
+		if replaced == s {
+			break
+		}
+		s = replaced
+	}
+
+	return s
+}
+
+func (m *model) interpolateDependency(dep gopom.Dependency) gopom.Dependency {
+	for _, field := range []**string{&dep.GroupID, &dep.ArtifactID, &dep.Version, &dep.Scope, &dep.Type} {
+		if *field != nil {
+			v := m.interpolate(strings.TrimSpace(**field))
+			*field = &v
+		}
+	}
+
+	return dep
+}
+
+// resolve fills the version and scope of a dependency from dependencyManagement.
+func (m *model) resolve(dep gopom.Dependency) gopom.Dependency {
+	dep = m.interpolateDependency(dep)
+
+	if managed, ok := m.managed[key(dep)]; ok {
+		managed = m.interpolateDependency(managed)
+
+		if dep.Version == nil {
+			dep.Version = managed.Version
+		}
+		if dep.Scope == nil {
+			dep.Scope = managed.Scope
+		}
+	}
+
+	return dep
+}
+
+func matches(project *gopom.Project, parent *gopom.Parent) bool {
+	return value(project.ArtifactID) == value(parent.ArtifactID) &&
+		(project.GroupID == nil || value(project.GroupID) == value(parent.GroupID))
+}
+
+func key(dep gopom.Dependency) string {
+	return value(dep.GroupID) + ":" + value(dep.ArtifactID)
+}
+
+func value(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return strings.TrimSpace(*s)
+}
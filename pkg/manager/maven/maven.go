@@ -5,13 +5,22 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/depshubhq/depshub/pkg/types"
 	"github.com/vifraa/gopom"
 )
 
-type Maven struct{}
+type Maven struct {
+	// Local repository used to look up parent POMs and BOMs, ~/.m2/repository by default
+	LocalRepository string
+	// Remote repository used when a POM isn't in the local one, Maven Central by default
+	RemoteRepository string
+}
+
+// Scopes that are not part of the runtime classpath of the project
+var devScopes = []string{"test", "provided"}
 
 func (Maven) GetType() types.ManagerType {
 	return types.Maven
@@ -22,7 +31,7 @@ func (Maven) Managed(path string) bool {
 	return filepath.Base(path) == "pom.xml"
 }
 
-func (Maven) Dependencies(path string) ([]types.Dependency, error) {
+func (m Maven) Dependencies(path string) ([]types.Dependency, error) {
 	var dependencies []types.Dependency
 
 	fileBytes, err := os.ReadFile(path)
@@ -30,42 +39,46 @@ func (Maven) Dependencies(path string) ([]types.Dependency, error) {
 		return nil, err
 	}
 
-	parsedPom, err := gopom.Parse(path)
+	r := newResolver(m)
+
+	effective, err := r.load(path)
 	if err != nil {
 		return nil, err
 	}
 
-	if parsedPom.Dependencies == nil {
+	parsedPom := effective.project
+
+	// Modules of the same multi-module build are not published dependencies
+	modules := r.modules(path)
+
+	if parsedPom.Dependencies == nil && parsedPom.DependencyManagement == nil {
 		return []types.Dependency{}, nil
 	}
 
-	for _, dep := range *parsedPom.Dependencies {
+	var declared []gopom.Dependency
+	if parsedPom.Dependencies != nil {
+		declared = *parsedPom.Dependencies
+	}
+
+	for _, dep := range declared {
 		if dep.GroupID == nil || dep.ArtifactID == nil {
 			continue
 		}
+
+		dep = effective.resolve(dep)
+
+		if modules[key(dep)] {
+			continue
+		}
+
 		name := fmt.Sprintf("%s:%s", *dep.GroupID, *dep.ArtifactID)
 		line, rawLine := findLineInfo(fileBytes, *dep.ArtifactID)
-		version := ""
-		if dep.Version != nil {
-			version = *dep.Version
-		} else {
-			// Try to find version in dependencyManagement
-			if parsedPom.DependencyManagement != nil && parsedPom.DependencyManagement.Dependencies != nil {
-				for _, depMan := range *parsedPom.DependencyManagement.Dependencies {
-					if depMan.GroupID != nil && depMan.ArtifactID != nil && *depMan.ArtifactID == *dep.ArtifactID {
-						version = *depMan.Version
-					}
-				}
-			}
-		}
 
 		dependencies = append(dependencies, types.Dependency{
 			Manager: types.Maven,
 			Name:    name,
-			//  TODO We should use the version from the lockfile instead
-			Version: cleanVersion(version),
-			// FIXME We should use the scope to determine if it's a dev dependency
-			Dev: false,
+			Version: cleanVersion(value(dep.Version)),
+			Dev:     slices.Contains(devScopes, value(dep.Scope)),
 			Definition: types.Definition{
 				Path:    path,
 				RawLine: rawLine,
@@ -82,20 +95,21 @@ func (Maven) Dependencies(path string) ([]types.Dependency, error) {
 		if dep.GroupID == nil || dep.ArtifactID == nil {
 			continue
 		}
+
+		dep = effective.interpolateDependency(dep)
+
+		if modules[key(dep)] {
+			continue
+		}
+
 		name := fmt.Sprintf("%s:%s", *dep.GroupID, *dep.ArtifactID)
 		line, rawLine := findLineInfo(fileBytes, *dep.ArtifactID)
-		version := ""
-		if dep.Version != nil {
-			version = *dep.Version
-		}
 
 		dependencies = append(dependencies, types.Dependency{
 			Manager: types.Maven,
 			Name:    name,
-			//  TODO We should use the version from the lockfile instead
-			Version: cleanVersion(version),
-			// FIXME We should use the scope to determine if it's a dev dependency
-			Dev: false,
+			Version: cleanVersion(value(dep.Version)),
+			Dev:     slices.Contains(devScopes, value(dep.Scope)),
 			Definition: types.Definition{
 				Path:    path,
 				RawLine: rawLine,
@@ -1,10 +1,16 @@
 package maven
 
 import (
-	"github.com/depshubhq/depshub/pkg/types"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"path/filepath"
+	"reflect"
 	"strings"
+	"sync/atomic"
 	"testing"
+
+	"github.com/depshubhq/depshub/pkg/types"
 )
 
 func TestMaven_Managed(t *testing.T) {
@@ -130,3 +136,124 @@ func TestMaven_GetType(t *testing.T) {
 		t.Errorf("Maven.GetType() = %v, want %v", got, types.Maven)
 	}
 }
+
+func TestMaven_DependenciesEffective(t *testing.T) {
+	// Serves the parent of the imported BOM, which isn't in the local repository
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/com/example/platform-parent/1.0.0/platform-parent-1.0.0.pom" {
+			http.NotFound(w, r)
+			return
+		}
+
+		fmt.Fprint(w, `<project>
+    <groupId>com.example</groupId>
+    <artifactId>platform-parent</artifactId>
+    <version>1.0.0</version>
+    <properties>
+        <guava.version>33.3.0-jre</guava.version>
+    </properties>
+</project>`)
+	}))
+	defer server.Close()
+
+	maven := Maven{
+		LocalRepository:  filepath.Join("testdata", "m2"),
+		RemoteRepository: server.URL,
+	}
+
+	deps, err := maven.Dependencies(filepath.Join("testdata", "multi", "app", "pom.xml"))
+	if err != nil {
+		t.Fatalf("Failed to parse dependencies: %v", err)
+	}
+
+	expected := []struct {
+		name    string
+		version string
+		isDev   bool
+		line    int
+	}{
+		// Managed in the parent with a property
+		{name: "com.fasterxml.jackson.core:jackson-databind", version: "2.17.2", isDev: false, line: 26},
+		// Managed in a BOM whose property comes from its remote parent
+		{name: "com.google.guava:guava", version: "33.3.0-jre", isDev: false, line: 30},
+		// Managed in the parent with a property overridden by the child, provided scope
+		{name: "org.projectlombok:lombok", version: "1.18.34", isDev: true, line: 34},
+		// Managed in a BOM, test scope
+		{name: "org.junit.jupiter:junit-jupiter", version: "5.11.0", isDev: true, line: 38},
+	}
+
+	// The core module is part of the same build and is skipped
+	if len(deps) != len(expected) {
+		t.Fatalf("Expected %d dependencies, got %d: %+v", len(expected), len(deps), deps)
+	}
+
+	for i, exp := range expected {
+		dep := deps[i]
+		if dep.Name != exp.name {
+			t.Errorf("Expected dependency %s, got %s", exp.name, dep.Name)
+		}
+		if dep.Version != exp.version {
+			t.Errorf("Expected version %s for %s, got %s", exp.version, exp.name, dep.Version)
+		}
+		if dep.Dev != exp.isDev {
+			t.Errorf("Expected Dev=%v for %s, got %v", exp.isDev, exp.name, dep.Dev)
+		}
+		if dep.Line != exp.line {
+			t.Errorf("Expected line %d for %s, got %d", exp.line, exp.name, dep.Line)
+		}
+	}
+}
+
+func TestResolver_UnreachableRemote(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		panic(http.ErrAbortHandler)
+	}))
+	defer server.Close()
+
+	r := newResolver(Maven{LocalRepository: t.TempDir(), RemoteRepository: server.URL})
+
+	if r.client.Timeout != downloadTimeout {
+		t.Errorf("Expected a download timeout of %v, got %v", downloadTimeout, r.client.Timeout)
+	}
+
+	// The remote repository isn't used again once it can't be reached
+	if m := r.fetch("com.example", "parent", "1.0.0", 0); m != nil {
+		t.Errorf("Expected no parent, got %+v", m)
+	}
+	if m := r.fetch("com.example", "bom", "1.0.0", 0); m != nil {
+		t.Errorf("Expected no BOM, got %+v", m)
+	}
+
+	if got := requests.Load(); got != 1 {
+		t.Errorf("Expected 1 request to the remote repository, got %d", got)
+	}
+}
+
+func TestMaven_DependenciesParentManagement(t *testing.T) {
+	maven := Maven{
+		LocalRepository:  filepath.Join("testdata", "m2"),
+		RemoteRepository: "http://127.0.0.1:0",
+	}
+
+	deps, err := maven.Dependencies(filepath.Join("testdata", "multi", "pom.xml"))
+	if err != nil {
+		t.Fatalf("Failed to parse dependencies: %v", err)
+	}
+
+	versions := map[string]string{}
+	for _, dep := range deps {
+		versions[dep.Name] = dep.Version
+	}
+
+	expected := map[string]string{
+		"com.fasterxml.jackson.core:jackson-databind": "2.17.2",
+		"org.projectlombok:lombok":                    "1.18.30",
+		"com.example:platform-bom":                    "1.0.0",
+	}
+
+	if !reflect.DeepEqual(expected, versions) {
+		t.Errorf("Expected versions %v, got %v", expected, versions)
+	}
+}
@@ -0,0 +1,199 @@
+package npm
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+// lockPackage is a package of the packages section of package-lock.json
+// version 2 and 3, keyed by its path like node_modules/a/node_modules/b.
+type lockPackage struct {
+	Name                 string            `json:"name"`
+	Version              string            `json:"version"`
+	Integrity            string            `json:"integrity"`
+	Link                 bool              `json:"link"`
+	Dependencies         map[string]string `json:"dependencies"`
+	DevDependencies      map[string]string `json:"devDependencies"`
+	OptionalDependencies map[string]string `json:"optionalDependencies"`
+	PeerDependencies     map[string]string `json:"peerDependencies"`
+}
+
+// lockDependency is a package of the dependencies section of package-lock.json
+// version 1, where dependencies are nested like node_modules.
+type lockDependency struct {
+	Version      string                    `json:"version"`
+	Integrity    string                    `json:"integrity"`
+	Requires     map[string]string         `json:"requires"`
+	Dependencies map[string]lockDependency `json:"dependencies"`
+}
+
+type packageLock struct {
+	LockfileVersion int                       `json:"lockfileVersion"`
+	Packages        map[string]lockPackage    `json:"packages"`
+	Dependencies    map[string]lockDependency `json:"dependencies"`
+}
+
+// LockedPackages returns the packages installed by package-lock.json,
+// yarn.lock or pnpm-lock.yaml.
+func (Npm) LockedPackages(lockfilePath string) ([]types.LockedPackage, error) {
+	file, err := os.ReadFile(lockfilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	switch filepath.Base(lockfilePath) {
+	case "yarn.lock":
+		return readYarnLock(file, lockfilePath)
+	case "pnpm-lock.yaml":
+		return readPnpmLock(file, lockfilePath)
+	}
+
+	var lock packageLock
+	if err := json.Unmarshal(file, &lock); err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", lockfilePath, err)
+	}
+
+	if len(lock.Packages) > 0 {
+		return readPackages(lock.Packages), nil
+	}
+
+	return readDependencies(lock.Dependencies), nil
+}
+
+func readPackages(entries map[string]lockPackage) []types.LockedPackage {
+	var packages []types.LockedPackage
+
+	// Only the dependencies that package.json declares are direct ones
+	root := entries[""]
+	direct := make(map[string]bool)
+	for _, deps := range []map[string]string{root.Dependencies, root.DevDependencies} {
+		for name := range deps {
+			direct["node_modules/"+name] = true
+		}
+	}
+
+	for key, entry := range entries {
+		// The root, workspaces and links to them aren't installed from the registry
+		if !strings.Contains(key, "node_modules/") || entry.Link || entry.Version == "" {
+			continue
+		}
+
+		pkg := types.LockedPackage{
+			Manager: types.Npm,
+			Name:    packageName(key, entry.Name),
+			Version: entry.Version,
+			Direct:  direct[key],
+		}
+
+		if hash, ok := types.IntegrityHash(entry.Integrity); ok {
+			pkg.Hashes = []types.Hash{hash}
+		}
+
+		names := slices.Sorted(maps.Keys(entry.Dependencies))
+		names = append(names, slices.Sorted(maps.Keys(entry.OptionalDependencies))...)
+		names = append(names, slices.Sorted(maps.Keys(entry.PeerDependencies))...)
+
+		for _, name := range names {
+			if dep, ok := resolvePackage(entries, key, name); ok {
+				id := types.LockedID(packageName(dep, entries[dep].Name), entries[dep].Version)
+				if !slices.Contains(pkg.Dependencies, id) {
+					pkg.Dependencies = append(pkg.Dependencies, id)
+				}
+			}
+		}
+
+		packages = append(packages, pkg)
+	}
+
+	return packages
+}
+
+// resolvePackage returns the key of the package a dependency resolves to, like
+// Node.js does: in the node_modules of the package, then of its parents.
+func resolvePackage(entries map[string]lockPackage, key string, name string) (string, bool) {
+	dir := key
+
+	for {
+		candidate := "node_modules/" + name
+		if dir != "" {
+			candidate = dir + "/" + candidate
+		}
+
+		if entry, ok := entries[candidate]; ok && !entry.Link && entry.Version != "" {
+			return candidate, true
+		}
+
+		if dir == "" {
+			return "", false
+		}
+
+		if i := strings.LastIndex(dir, "/node_modules/"); i != -1 {
+			dir = dir[:i]
+		} else {
+			dir = ""
+		}
+	}
+}
+
+// packageName returns the name of the package at a node_modules path, or its
+// real name for aliases like "foo": "npm:bar@1.0.0".
+func packageName(key string, name string) string {
+	if name != "" {
+		return name
+	}
+
+	return key[strings.LastIndex(key, "node_modules/")+len("node_modules/"):]
+}
+
+func readDependencies(entries map[string]lockDependency) []types.LockedPackage {
+	var packages []types.LockedPackage
+
+	// scopes are the nested dependencies from the root to the current package.
+	// Top-level packages are the ones the project resolves its dependencies to.
+	var walk func(entries map[string]lockDependency, scopes []map[string]lockDependency, root bool)
+	walk = func(entries map[string]lockDependency, scopes []map[string]lockDependency, root bool) {
+		scopes = append(scopes, entries)
+
+		for _, name := range slices.Sorted(maps.Keys(entries)) {
+			entry := entries[name]
+
+			pkg := types.LockedPackage{
+				Manager: types.Npm,
+				Name:    name,
+				Version: entry.Version,
+				Direct:  root,
+			}
+
+			if hash, ok := types.IntegrityHash(entry.Integrity); ok {
+				pkg.Hashes = []types.Hash{hash}
+			}
+
+			// Dependencies resolve to the nested ones of the package first
+			inner := append(slices.Clone(scopes), entry.Dependencies)
+
+			for _, dep := range slices.Sorted(maps.Keys(entry.Requires)) {
+				for i := len(inner) - 1; i >= 0; i-- {
+					if resolved, ok := inner[i][dep]; ok {
+						pkg.Dependencies = append(pkg.Dependencies, types.LockedID(dep, resolved.Version))
+						break
+					}
+				}
+			}
+
+			packages = append(packages, pkg)
+
+			walk(entry.Dependencies, scopes, false)
+		}
+	}
+
+	walk(entries, nil, true)
+
+	return packages
+}
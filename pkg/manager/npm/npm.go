@@ -47,8 +47,9 @@ func (Npm) Dependencies(path string) ([]types.Dependency, error) {
 			Manager: types.Npm,
 			Name:    name,
 			//  TODO We should use the version from the lockfile instead
-			Version: cleanVersion(version),
-			Dev:     false,
+			Version:    cleanVersion(version),
+			Constraint: version,
+			Dev:        false,
 			Definition: types.Definition{
 				Path:    path,
 				RawLine: rawLine,
@@ -64,8 +65,9 @@ func (Npm) Dependencies(path string) ([]types.Dependency, error) {
 			Manager: types.Npm,
 			Name:    name,
 			//  TODO We should use the version from the lockfile instead
-			Version: cleanVersion(version),
-			Dev:     true,
+			Version:    cleanVersion(version),
+			Constraint: version,
+			Dev:        true,
 			Definition: types.Definition{
 				Path:    path,
 				RawLine: rawLine,
@@ -83,10 +85,11 @@ func (Npm) Dependencies(path string) ([]types.Dependency, error) {
 	return dependencies, nil
 }
 
-// LockfilePath checks for the existence of npm and yarn lockfiles and returns the path of the found lockfile.
+// LockfilePath checks for the existence of npm, yarn and pnpm lockfiles and returns the path of the found lockfile.
 func (Npm) LockfilePath(path string) (string, error) {
 	npmLockfilePath := filepath.Join(filepath.Dir(path), "package-lock.json")
 	yarnLockfilePath := filepath.Join(filepath.Dir(path), "yarn.lock")
+	pnpmLockfilePath := filepath.Join(filepath.Dir(path), "pnpm-lock.yaml")
 
 	// Check for npm lockfile
 	if _, err := os.Stat(npmLockfilePath); err == nil {
@@ -103,8 +106,15 @@ func (Npm) LockfilePath(path string) (string, error) {
 		return "", fmt.Errorf("error checking yarn lockfile: %v", err)
 	}
 
-	// If neither lockfile exists
-	return "", fmt.Errorf("no lockfile found (neither %s nor %s)", npmLockfilePath, yarnLockfilePath)
+	// Check for pnpm lockfile
+	if _, err := os.Stat(pnpmLockfilePath); err == nil {
+		return pnpmLockfilePath, nil
+	} else if !os.IsNotExist(err) {
+		return "", fmt.Errorf("error checking pnpm lockfile: %v", err)
+	}
+
+	// If no lockfile exists
+	return "", fmt.Errorf("no lockfile found (none of %s, %s or %s)", npmLockfilePath, yarnLockfilePath, pnpmLockfilePath)
 }
 
 // Returns the version without any prefix or suffix
@@ -116,6 +116,60 @@ func TestNpmDependencies(t *testing.T) {
 	}
 }
 
+func TestNpm_LockedPackages(t *testing.T) {
+	react := types.LockedPackage{
+		Manager: types.Npm,
+		Name:    "react",
+		Version: "18.3.1",
+		Hashes: []types.Hash{{
+			Algorithm: "SHA-512",
+			Value:     "b3a903c87f6e74d470d2963950e2a0068fbcad76d91c31b73873a6b376e32dbaa1593a23297aea1a7de966256776f21db6b58e101b4ee6f2df030cbd6a2d8f62",
+		}},
+		Dependencies: []string{"loose-envify@1.4.0"},
+		Direct:       true,
+	}
+
+	for _, lockfile := range []string{
+		filepath.Join("lockfile", "package-lock.json"),
+		filepath.Join("lockfile-v1", "package-lock.json"),
+		filepath.Join("yarn", "yarn.lock"),
+		filepath.Join("yarn-berry", "yarn.lock"),
+		filepath.Join("pnpm", "pnpm-lock.yaml"),
+		filepath.Join("pnpm-v6", "pnpm-lock.yaml"),
+	} {
+		t.Run(lockfile, func(t *testing.T) {
+			packages, err := Npm{}.LockedPackages(filepath.Join("testdata", lockfile))
+			assert.NoError(t, err)
+
+			// Workspaces and the links to them are skipped
+			assert.Len(t, packages, 5)
+
+			// Yarn 2 checksums aren't the ones of the npm registry
+			expected := react
+			if lockfile == filepath.Join("yarn-berry", "yarn.lock") {
+				expected.Hashes = nil
+			}
+			assert.Contains(t, packages, expected)
+
+			// Nested packages are used instead of the hoisted ones
+			var eslint, looseEnvify types.LockedPackage
+			for _, pkg := range packages {
+				switch pkg.Name {
+				case "eslint":
+					eslint = pkg
+				case "loose-envify":
+					looseEnvify = pkg
+				}
+			}
+			assert.Equal(t, []string{"js-tokens@3.0.2"}, eslint.Dependencies)
+			assert.Equal(t, []string{"js-tokens@4.0.0"}, looseEnvify.Dependencies)
+		})
+	}
+
+	_, err := Npm{}.LockedPackages(filepath.Join("testdata", "missing", "yarn.lock"))
+	assert.Error(t, err)
+}
+
 func TestFindLineInfo(t *testing.T) {
 	testJSON := []byte(`{
   "name": "test-package",
@@ -0,0 +1,160 @@
+package npm
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/depshubhq/depshub/pkg/types"
+	"gopkg.in/yaml.v3"
+)
+
+// pnpmDependency is a dependency of a project in pnpm-lock.yaml.
+type pnpmDependency struct {
+	Specifier string `yaml:"specifier"`
+	// The version, with the versions of its peer dependencies like 1.0.0(react@18.3.1)
+	Version string `yaml:"version"`
+}
+
+type pnpmImporter struct {
+	Dependencies    map[string]pnpmDependency `yaml:"dependencies"`
+	DevDependencies map[string]pnpmDependency `yaml:"devDependencies"`
+}
+
+// pnpmPackage is a package of pnpm-lock.yaml. Since version 9, the
+// dependencies of the packages are in the snapshots.
+type pnpmPackage struct {
+	Resolution struct {
+		Integrity string `yaml:"integrity"`
+	} `yaml:"resolution"`
+	Dependencies         map[string]string `yaml:"dependencies"`
+	OptionalDependencies map[string]string `yaml:"optionalDependencies"`
+}
+
+type pnpmLock struct {
+	Importers map[string]pnpmImporter `yaml:"importers"`
+	// Lockfiles before version 9 without workspaces have the project at the root
+	pnpmImporter `yaml:",inline"`
+	Packages     map[string]pnpmPackage `yaml:"packages"`
+	Snapshots    map[string]pnpmPackage `yaml:"snapshots"`
+}
+
+// readPnpmLock reads the packages of pnpm-lock.yaml version 6 and later.
+// Older lockfiles have another format, so they have no packages.
+func readPnpmLock(file []byte, lockfilePath string) ([]types.LockedPackage, error) {
+	var header struct {
+		LockfileVersion string `yaml:"lockfileVersion"`
+	}
+	if err := yaml.Unmarshal(file, &header); err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", lockfilePath, err)
+	}
+
+	if major, _, _ := strings.Cut(header.LockfileVersion, "."); major < "6" && len(major) == 1 {
+		return nil, nil
+	}
+
+	var lock pnpmLock
+	if err := yaml.Unmarshal(file, &lock); err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", lockfilePath, err)
+	}
+
+	root := lock.pnpmImporter
+	if importer, ok := lock.Importers["."]; ok {
+		root = importer
+	}
+
+	direct := make(map[string]bool)
+	for _, deps := range []map[string]pnpmDependency{root.Dependencies, root.DevDependencies} {
+		for name, dep := range deps {
+			if id, ok := pnpmID(name, dep.Version); ok {
+				direct[id] = true
+			}
+		}
+	}
+
+	var packages []types.LockedPackage
+	index := make(map[string]int)
+
+	add := func(key string, entry pnpmPackage) {
+		name, version, ok := splitPnpmKey(key)
+		if !ok {
+			return
+		}
+
+		id := types.LockedID(name, version)
+
+		i, ok := index[id]
+		if !ok {
+			i = len(packages)
+			index[id] = i
+			packages = append(packages, types.LockedPackage{
+				Manager: types.Npm,
+				Name:    name,
+				Version: version,
+				Direct:  direct[id],
+			})
+		}
+
+		pkg := &packages[i]
+
+		if hash, ok := types.IntegrityHash(entry.Resolution.Integrity); ok && len(pkg.Hashes) == 0 {
+			pkg.Hashes = []types.Hash{hash}
+		}
+
+		for _, deps := range []map[string]string{entry.Dependencies, entry.OptionalDependencies} {
+			for dep, depVersion := range deps {
+				if depID, ok := pnpmID(dep, depVersion); ok && !slices.Contains(pkg.Dependencies, depID) {
+					pkg.Dependencies = append(pkg.Dependencies, depID)
+				}
+			}
+		}
+	}
+
+	// Before version 9, packages with peer dependencies have a key for each
+	// version of their peers. Since version 9, their dependencies are in the
+	// snapshots, which are keyed like this.
+	for _, key := range slices.Sorted(maps.Keys(lock.Packages)) {
+		add(key, lock.Packages[key])
+	}
+	for _, key := range slices.Sorted(maps.Keys(lock.Snapshots)) {
+		add(key, lock.Snapshots[key])
+	}
+
+	for i := range packages {
+		slices.Sort(packages[i].Dependencies)
+	}
+
+	return packages, nil
+}
+
+// splitPnpmKey splits the key of a package like /react@18.3.1 or
+// react-dom@18.3.1(react@18.3.1) into its name and version.
+func splitPnpmKey(key string) (name string, version string, ok bool) {
+	key = strings.TrimPrefix(key, "/")
+	if i := strings.Index(key, "("); i != -1 {
+		key = key[:i]
+	}
+
+	name, version = splitDescriptor(key)
+	return name, version, version != ""
+}
+
+// pnpmID returns the ID of the package a dependency resolves to. Versions can
+// be aliases like npm:string-width@4.2.3, or links to workspaces which aren't
+// packages.
+func pnpmID(name string, version string) (string, bool) {
+	if i := strings.Index(version, "("); i != -1 {
+		version = version[:i]
+	}
+
+	if alias, ok := strings.CutPrefix(version, "npm:"); ok {
+		name, version = splitDescriptor(alias)
+	}
+
+	if version == "" || strings.HasPrefix(version, "link:") || strings.HasPrefix(version, "file:") {
+		return "", false
+	}
+
+	return types.LockedID(name, version), true
+}
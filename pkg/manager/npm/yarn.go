@@ -0,0 +1,219 @@
+package npm
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/depshubhq/depshub/pkg/types"
+	"gopkg.in/yaml.v3"
+)
+
+// yarnEntry is a package of yarn.lock, resolved for one or more descriptors
+// like react@^18.0.0.
+type yarnEntry struct {
+	Version   string `yaml:"version"`
+	Integrity string
+	// The dependencies as name and range, which are descriptors of other entries
+	Dependencies         map[string]string `yaml:"dependencies"`
+	OptionalDependencies map[string]string `yaml:"optionalDependencies"`
+}
+
+// readYarnLock reads the packages of yarn.lock. Yarn 1 lockfiles don't list the
+// dependencies of the project, so the ranges of the package.json next to it are
+// used. Yarn 2 and later lock the project as a workspace.
+func readYarnLock(file []byte, lockfilePath string) ([]types.LockedPackage, error) {
+	entries := make(map[string]*yarnEntry)
+	var workspace *yarnEntry
+	var err error
+
+	if bytes.Contains(file, []byte("__metadata:")) {
+		workspace, err = readYarnBerry(file, entries)
+	} else {
+		workspace, err = readYarnClassic(file, entries, filepath.Join(filepath.Dir(lockfilePath), "package.json"))
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", lockfilePath, err)
+	}
+
+	direct := make(map[*yarnEntry]bool)
+	if workspace != nil {
+		for name, rng := range workspace.Dependencies {
+			if entry, ok := entries[yarnDescriptor(name, rng)]; ok {
+				direct[entry] = true
+			}
+		}
+	}
+
+	var packages []types.LockedPackage
+	seen := make(map[*yarnEntry]bool)
+
+	for descriptor, entry := range entries {
+		if seen[entry] || entry == workspace {
+			continue
+		}
+		seen[entry] = true
+
+		name, _ := splitDescriptor(descriptor)
+		pkg := types.LockedPackage{
+			Manager: types.Npm,
+			Name:    name,
+			Version: entry.Version,
+			Direct:  direct[entry],
+		}
+
+		if hash, ok := types.IntegrityHash(entry.Integrity); ok {
+			pkg.Hashes = []types.Hash{hash}
+		}
+
+		for _, deps := range []map[string]string{entry.Dependencies, entry.OptionalDependencies} {
+			for dep, rng := range deps {
+				if resolved, ok := entries[yarnDescriptor(dep, rng)]; ok {
+					pkg.Dependencies = append(pkg.Dependencies, types.LockedID(dep, resolved.Version))
+				}
+			}
+		}
+		slices.Sort(pkg.Dependencies)
+
+		packages = append(packages, pkg)
+	}
+
+	return packages, nil
+}
+
+// readYarnClassic reads the entries of a Yarn 1 lockfile, which looks like YAML
+// without colons after the values. The project is a workspace depending on the
+// dependencies of package.json.
+func readYarnClassic(file []byte, entries map[string]*yarnEntry, packageJSONPath string) (*yarnEntry, error) {
+	var entry *yarnEntry
+	var section map[string]string
+
+	scanner := bufio.NewScanner(bytes.NewReader(file))
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+
+		indent := len(line) - len(strings.TrimLeft(line, " "))
+
+		switch {
+		// "react@^18.0.0", react@^18.3.1:
+		case indent == 0:
+			entry = &yarnEntry{}
+			section = nil
+			for _, descriptor := range strings.Split(strings.TrimSuffix(trimmed, ":"), ",") {
+				entries[unquote(descriptor)] = entry
+			}
+		case entry == nil:
+			return nil, fmt.Errorf("unexpected line %q", trimmed)
+		// dependencies:
+		case indent == 2 && strings.HasSuffix(trimmed, ":"):
+			section = make(map[string]string)
+			switch strings.TrimSuffix(trimmed, ":") {
+			case "dependencies":
+				entry.Dependencies = section
+			case "optionalDependencies":
+				entry.OptionalDependencies = section
+			}
+		// version "18.3.1"
+		case indent == 2:
+			section = nil
+			key, value, _ := strings.Cut(trimmed, " ")
+			switch key {
+			case "version":
+				entry.Version = unquote(value)
+			case "integrity":
+				entry.Integrity = unquote(value)
+			}
+		// loose-envify "^1.1.0"
+		case section != nil:
+			name, rng, _ := strings.Cut(trimmed, " ")
+			section[unquote(name)] = unquote(rng)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	workspace := &yarnEntry{Dependencies: make(map[string]string)}
+
+	if data, err := os.ReadFile(packageJSONPath); err == nil {
+		var packageJSON PackageJSON
+		if err := json.Unmarshal(data, &packageJSON); err == nil {
+			for _, deps := range []map[string]string{packageJSON.Dependencies, packageJSON.DevDependencies} {
+				for name, rng := range deps {
+					workspace.Dependencies[name] = rng
+				}
+			}
+		}
+	}
+
+	return workspace, nil
+}
+
+// readYarnBerry reads the entries of a Yarn 2 or later lockfile, which is YAML.
+// Descriptors have a protocol like react@npm:^18.0.0, and the project is the
+// workspace at the root.
+func readYarnBerry(file []byte, entries map[string]*yarnEntry) (*yarnEntry, error) {
+	var lock map[string]*yarnEntry
+	if err := yaml.Unmarshal(file, &lock); err != nil {
+		return nil, err
+	}
+
+	var workspace *yarnEntry
+
+	for key, entry := range lock {
+		if key == "__metadata" {
+			continue
+		}
+
+		for _, descriptor := range strings.Split(key, ",") {
+			descriptor = strings.TrimSpace(descriptor)
+
+			if _, rng := splitDescriptor(descriptor); rng == "workspace:." {
+				workspace = entry
+			}
+
+			entries[descriptor] = entry
+		}
+	}
+
+	// Workspaces aren't installed from the registry
+	for descriptor := range entries {
+		if _, rng := splitDescriptor(descriptor); strings.HasPrefix(rng, "workspace:") {
+			delete(entries, descriptor)
+		}
+	}
+
+	return workspace, nil
+}
+
+// yarnDescriptor returns the descriptor of a dependency, which is the key of
+// the entry it resolves to.
+func yarnDescriptor(name string, rng string) string {
+	return name + "@" + rng
+}
+
+// splitDescriptor splits a descriptor like @babel/core@^7.0.0 into the name
+// and the range.
+func splitDescriptor(descriptor string) (name string, rng string) {
+	if i := strings.Index(descriptor[min(1, len(descriptor)):], "@"); i != -1 {
+		return descriptor[:i+1], descriptor[i+2:]
+	}
+
+	return descriptor, ""
+}
+
+func unquote(s string) string {
+	return strings.Trim(strings.TrimSpace(s), `"`)
+}
@@ -0,0 +1,125 @@
+package nuget
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+// LockEntry is a package resolved in packages.lock.json.
+type LockEntry struct {
+	Name string
+	// Direct, Transitive, CentralTransitive or Project
+	Type      string
+	Requested string
+	Resolved  string
+	// The base64 encoded SHA-512 of the package
+	ContentHash string
+	// The names of the packages this package depends on
+	Dependencies []string
+}
+
+type lockPackage struct {
+	Type         string            `json:"type"`
+	Requested    string            `json:"requested"`
+	Resolved     string            `json:"resolved"`
+	ContentHash  string            `json:"contentHash"`
+	Dependencies map[string]string `json:"dependencies"`
+}
+
+type packagesLock struct {
+	Version int `json:"version"`
+	// Packages grouped by target framework like net8.0
+	Dependencies map[string]map[string]lockPackage `json:"dependencies"`
+}
+
+// ReadLockfile parses packages.lock.json and returns the packages keyed by lowercase name.
+// Projects targeting several frameworks keep the version of the first framework.
+func ReadLockfile(path string) (map[string]LockEntry, error) {
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var lock packagesLock
+	if err := json.Unmarshal(file, &lock); err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+
+	entries := make(map[string]LockEntry)
+
+	frameworks := make([]string, 0, len(lock.Dependencies))
+	for framework := range lock.Dependencies {
+		frameworks = append(frameworks, framework)
+	}
+	sort.Strings(frameworks)
+
+	for _, framework := range frameworks {
+		for name, pkg := range lock.Dependencies[framework] {
+			// Project references aren't NuGet packages
+			if pkg.Type == "Project" || pkg.Resolved == "" {
+				continue
+			}
+
+			key := strings.ToLower(name)
+			if _, ok := entries[key]; ok {
+				continue
+			}
+
+			entry := LockEntry{
+				Name:        name,
+				Type:        pkg.Type,
+				Requested:   pkg.Requested,
+				Resolved:    pkg.Resolved,
+				ContentHash: pkg.ContentHash,
+			}
+
+			for dep := range pkg.Dependencies {
+				entry.Dependencies = append(entry.Dependencies, dep)
+			}
+			slices.Sort(entry.Dependencies)
+
+			entries[key] = entry
+		}
+	}
+
+	return entries, nil
+}
+
+// LockedPackages returns the NuGet packages of packages.lock.json.
+func (NuGet) LockedPackages(lockfilePath string) ([]types.LockedPackage, error) {
+	lock, err := ReadLockfile(lockfilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var packages []types.LockedPackage
+
+	for _, entry := range lock {
+		pkg := types.LockedPackage{
+			Manager: types.NuGet,
+			Name:    entry.Name,
+			Version: entry.Resolved,
+		}
+
+		// The content hash is the SHA-512 of the .nupkg, like the integrity of npm packages
+		if hash, ok := types.IntegrityHash("sha512-" + entry.ContentHash); ok && entry.ContentHash != "" {
+			pkg.Hashes = []types.Hash{hash}
+		}
+
+		for _, name := range entry.Dependencies {
+			if dep, ok := lock[strings.ToLower(name)]; ok {
+				pkg.Dependencies = append(pkg.Dependencies, types.LockedID(dep.Name, dep.Resolved))
+			}
+		}
+
+		packages = append(packages, pkg)
+	}
+
+	return packages, nil
+}
@@ -0,0 +1,135 @@
+package nuget
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"regexp"
+	"strings"
+)
+
+var propertyPattern = regexp.MustCompile(`\$\(([^)]+)\)`)
+
+// Items that declare packages
+var itemKinds = map[string]bool{"PackageReference": true, "PackageVersion": true, "GlobalPackageReference": true}
+
+// packageReference is a package item of an MSBuild file.
+type packageReference struct {
+	// The element name, PackageReference, PackageVersion or GlobalPackageReference
+	kind    string
+	name    string
+	version string
+	// VersionOverride replaces the central version for a single project
+	versionOverride string
+	privateAssets   string
+	line            int
+	rawLine         string
+}
+
+// project holds the package items and properties of an MSBuild file.
+type project struct {
+	references []packageReference
+	properties map[string]string
+}
+
+// parseProject reads an MSBuild file like a .csproj or Directory.Packages.props.
+// Attributes and child elements are both supported:
+//
+//	<PackageReference Include="Serilog" Version="4.0.1" />
+//	<PackageReference Include="Serilog">
+//	  <Version>4.0.1</Version>
+//	</PackageReference>
+func parseProject(data []byte) (project, error) {
+	p := project{properties: make(map[string]string)}
+	lines := strings.Split(string(data), "\n")
+
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	decoder.Strict = false
+
+	var stack []string
+	var current *packageReference
+	var text strings.Builder
+
+	for {
+		offset := decoder.InputOffset()
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return p, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			stack = append(stack, t.Name.Local)
+			text.Reset()
+
+			if !itemKinds[t.Name.Local] {
+				continue
+			}
+
+			line := bytes.Count(data[:offset], []byte{'\n'}) + 1
+			ref := packageReference{
+				kind:    t.Name.Local,
+				line:    line,
+				rawLine: strings.TrimSpace(lines[line-1]),
+			}
+
+			for _, attr := range t.Attr {
+				switch attr.Name.Local {
+				case "Include":
+					ref.name = attr.Value
+				case "Version":
+					ref.version = attr.Value
+				case "VersionOverride":
+					ref.versionOverride = attr.Value
+				case "PrivateAssets":
+					ref.privateAssets = attr.Value
+				}
+			}
+
+			p.references = append(p.references, ref)
+			current = &p.references[len(p.references)-1]
+		case xml.CharData:
+			text.Write(t)
+		case xml.EndElement:
+			value := strings.TrimSpace(text.String())
+			text.Reset()
+
+			if len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+			}
+
+			switch {
+			case itemKinds[t.Name.Local]:
+				current = nil
+			case current != nil:
+				// Metadata written as child elements
+				switch t.Name.Local {
+				case "Version":
+					current.version = value
+				case "VersionOverride":
+					current.versionOverride = value
+				case "PrivateAssets":
+					current.privateAssets = value
+				}
+			case len(stack) > 0 && stack[len(stack)-1] == "PropertyGroup":
+				p.properties[t.Name.Local] = value
+			}
+		}
+	}
+
+	return p, nil
+}
+
+// interpolate replaces $(Property) references with their values.
+func interpolate(s string, properties map[string]string) string {
+	return propertyPattern.ReplaceAllStringFunc(s, func(match string) string {
+		if value, ok := properties[match[2:len(match)-1]]; ok {
+			return value
+		}
+		return match
+	})
+}
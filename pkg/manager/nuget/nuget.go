@@ -0,0 +1,185 @@
+package nuget
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+const centralPackagesFile = "Directory.Packages.props"
+
+type NuGet struct{}
+
+func (NuGet) GetType() types.ManagerType {
+	return types.NuGet
+}
+
+func (NuGet) Managed(path string) bool {
+	switch filepath.Ext(path) {
+	case ".csproj", ".fsproj", ".vbproj":
+		return true
+	}
+
+	return filepath.Base(path) == centralPackagesFile
+}
+
+func (NuGet) Dependencies(path string) ([]types.Dependency, error) {
+	var dependencies []types.Dependency
+
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := parseProject(file)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+
+	properties := make(map[string]string)
+	central := make(map[string]packageReference)
+
+	// Projects get their versions from the closest Directory.Packages.props, the
+	// versions of the project are kept when it can't be read
+	if filepath.Base(path) != centralPackagesFile {
+		if centralPath, ok := findCentralPackages(filepath.Dir(path)); ok {
+			cp, err := readCentralPackages(centralPath)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error reading %s: %s\n", centralPath, err)
+			} else {
+				for k, v := range cp.properties {
+					properties[k] = v
+				}
+
+				for _, ref := range cp.references {
+					if ref.kind == "PackageVersion" {
+						central[strings.ToLower(ref.name)] = ref
+					}
+				}
+			}
+		}
+	}
+
+	for k, v := range p.properties {
+		properties[k] = v
+	}
+
+	if strings.EqualFold(properties["ManagePackageVersionsCentrally"], "false") {
+		central = map[string]packageReference{}
+	}
+
+	// The versions of the project are kept when packages.lock.json can't be read, the scanner reports the error
+	var lock map[string]LockEntry
+	if lockfilePath, err := (NuGet{}).LockfilePath(path); err == nil && filepath.Base(path) != centralPackagesFile {
+		if entries, err := ReadLockfile(lockfilePath); err == nil {
+			lock = entries
+		}
+	}
+
+	for _, ref := range p.references {
+		// Items with Update only change packages declared somewhere else
+		if ref.name == "" || strings.Contains(ref.name, "$(") {
+			continue
+		}
+
+		version := ref.version
+		if ref.versionOverride != "" {
+			version = ref.versionOverride
+		} else if c, ok := central[strings.ToLower(ref.name)]; ok && version == "" {
+			version = c.version
+		}
+
+		version = cleanVersion(interpolate(version, properties))
+
+		// Use the version from packages.lock.json when there is one
+		if entry, ok := lock[strings.ToLower(ref.name)]; ok {
+			version = entry.Resolved
+		}
+
+		dependencies = append(dependencies, types.Dependency{
+			Manager: types.NuGet,
+			Name:    ref.name,
+			Version: version,
+			// Packages with PrivateAssets="all" like analyzers aren't shipped with the project
+			Dev: strings.EqualFold(ref.privateAssets, "all") || ref.kind == "GlobalPackageReference",
+			Definition: types.Definition{
+				Path:    path,
+				RawLine: ref.rawLine,
+				Line:    ref.line,
+			},
+		})
+	}
+
+	return dependencies, nil
+}
+
+// findCentralPackages walks up from dir to find Directory.Packages.props.
+func findCentralPackages(dir string) (string, bool) {
+	for {
+		candidate := filepath.Join(dir, centralPackagesFile)
+		if _, err := os.Stat(candidate); err == nil {
+			return candidate, true
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", false
+		}
+		dir = parent
+	}
+}
+
+// readCentralPackages reads the properties and package versions of Directory.Packages.props.
+func readCentralPackages(path string) (project, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return project{}, err
+	}
+
+	cp, err := parseProject(data)
+	if err != nil {
+		return project{}, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+
+	return cp, nil
+}
+
+// LockfilePath returns packages.lock.json of the project. Central package files
+// don't have their own lockfile, so the lockfile of any project below it is used.
+func (NuGet) LockfilePath(path string) (string, error) {
+	dir := filepath.Dir(path)
+	patterns := []string{filepath.Join(dir, "packages.lock.json")}
+
+	if filepath.Base(path) == centralPackagesFile {
+		patterns = append(patterns,
+			filepath.Join(dir, "*", "packages.lock.json"),
+			filepath.Join(dir, "*", "*", "packages.lock.json"),
+		)
+	}
+
+	for _, pattern := range patterns {
+		if matches, err := filepath.Glob(pattern); err == nil && len(matches) > 0 {
+			return matches[0], nil
+		}
+	}
+
+	return "", fmt.Errorf("lockfile not found")
+}
+
+// Returns the version without any prefix or suffix
+func cleanVersion(version string) string {
+	version = strings.TrimSpace(version)
+
+	// Use the lower bound of ranges like "[1.0, 2.0)"
+	if idx := strings.Index(version, ","); idx != -1 {
+		version = version[:idx]
+	}
+
+	// Floating versions like 1.* match the lowest version
+	version = strings.TrimSuffix(strings.Trim(version, "[]() "), "*")
+
+	return strings.TrimSuffix(version, ".")
+}
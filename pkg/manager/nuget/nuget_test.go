@@ -0,0 +1,243 @@
+package nuget
+
+import (
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/depshubhq/depshub/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNuGet_GetType(t *testing.T) {
+	manager := NuGet{}
+	assert.Equal(t, types.NuGet, manager.GetType())
+}
+
+func TestNuGet_Managed(t *testing.T) {
+	manager := NuGet{}
+	tests := []struct {
+		name     string
+		path     string
+		expected bool
+	}{
+		{
+			name:     "C# project",
+			path:     "path/to/Api.csproj",
+			expected: true,
+		},
+		{
+			name:     "F# project",
+			path:     "path/to/Core.fsproj",
+			expected: true,
+		},
+		{
+			name:     "central package management",
+			path:     "path/to/Directory.Packages.props",
+			expected: true,
+		},
+		{
+			name:     "build props",
+			path:     "path/to/Directory.Build.props",
+			expected: false,
+		},
+		{
+			name:     "lockfile",
+			path:     "path/to/packages.lock.json",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := manager.Managed(tt.path)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestNuGet_Dependencies(t *testing.T) {
+	manager := NuGet{}
+
+	dependency := func(path, name, version string, dev bool, line int, rawLine string) types.Dependency {
+		return types.Dependency{
+			Manager: types.NuGet,
+			Name:    name,
+			Version: version,
+			Dev:     dev,
+			Definition: types.Definition{
+				Path:    path,
+				RawLine: rawLine,
+				Line:    line,
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		path     string
+		expected func(path string) []types.Dependency
+	}{
+		{
+			name: "central package management",
+			path: filepath.Join("testdata", "Directory.Packages.props"),
+			expected: func(path string) []types.Dependency {
+				return []types.Dependency{
+					dependency(path, "Newtonsoft.Json", "13.0.3", false, 7, `<PackageVersion Include="Newtonsoft.Json" Version="13.0.3" />`),
+					dependency(path, "Serilog", "4.0.1", false, 8, `<PackageVersion Include="Serilog" Version="$(SerilogVersion)" />`),
+					dependency(path, "xunit", "2.9.0", false, 9, `<PackageVersion Include="xunit" Version="2.9.0" />`),
+					dependency(path, "Polly", "8.4.0", false, 10, `<PackageVersion Include="Polly" Version="[8.4.0, 9.0.0)" />`),
+					dependency(path, "StyleCop.Analyzers", "1.2.0-beta.556", true, 13, `<GlobalPackageReference Include="StyleCop.Analyzers" Version="1.2.0-beta.556" />`),
+				}
+			},
+		},
+		{
+			name: "project with lockfile",
+			path: filepath.Join("testdata", "src", "Api", "Api.csproj"),
+			expected: func(path string) []types.Dependency {
+				return []types.Dependency{
+					dependency(path, "Newtonsoft.Json", "13.0.3", false, 9, `<PackageReference Include="Newtonsoft.Json" />`),
+					dependency(path, "Serilog", "3.1.1", false, 10, `<PackageReference Include="Serilog" VersionOverride="3.1.1" />`),
+					dependency(path, "Polly", "8.4.2", false, 11, `<PackageReference Include="Polly" />`),
+					dependency(path, "Microsoft.SourceLink.GitHub", "8.0.0", true, 12, `<PackageReference Include="Microsoft.SourceLink.GitHub">`),
+				}
+			},
+		},
+		{
+			name: "project with central versions",
+			path: filepath.Join("testdata", "src", "Worker", "Worker.csproj"),
+			expected: func(path string) []types.Dependency {
+				return []types.Dependency{
+					dependency(path, "Serilog", "4.0.1", false, 6, `<PackageReference Include="Serilog" />`),
+					dependency(path, "xunit", "2.9.0", false, 7, `<PackageReference Include="xunit" />`),
+				}
+			},
+		},
+		{
+			name: "project without central package management",
+			path: filepath.Join("testdata", "legacy", "Legacy.csproj"),
+			expected: func(path string) []types.Dependency {
+				return []types.Dependency{
+					dependency(path, "AutoMapper", "13.0.1", false, 8, `<PackageReference Include="AutoMapper" Version="$(AutoMapperVersion)" />`),
+					dependency(path, "Dapper", "2.1", false, 9, `<PackageReference Include="Dapper" Version="2.1.*" />`),
+					dependency(path, "Serilog", "", false, 10, `<PackageReference Include="Serilog" />`),
+				}
+			},
+		},
+		{
+			// The versions of the project are kept when Directory.Packages.props or the lockfile can't be read
+			name: "project with invalid central packages and lockfile",
+			path: filepath.Join("testdata", "unparsable", "App", "App.csproj"),
+			expected: func(path string) []types.Dependency {
+				return []types.Dependency{
+					dependency(path, "Serilog", "", false, 6, `<PackageReference Include="Serilog" />`),
+					dependency(path, "Dapper", "2.1.35", false, 7, `<PackageReference Include="Dapper" Version="2.1.35" />`),
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dependencies, err := manager.Dependencies(tt.path)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected(tt.path), dependencies)
+		})
+	}
+}
+
+func TestReadLockfile(t *testing.T) {
+	entries, err := ReadLockfile(filepath.Join("testdata", "src", "Api", "packages.lock.json"))
+	assert.NoError(t, err)
+
+	// Project references aren't NuGet packages
+	assert.NotContains(t, entries, "worker")
+
+	assert.Equal(t, LockEntry{
+		Name:         "Microsoft.SourceLink.GitHub",
+		Type:         "Direct",
+		Requested:    "[8.0.0, )",
+		Resolved:     "8.0.0",
+		ContentHash:  "G5q7OqtwIyGTkeIOAc3u2ZuV/kicQaec5EaRnc0pIeSnh9LUjj+PYQrJYBURvDt7twGl2PKA7nSN0kz1Zw5bnQ==",
+		Dependencies: []string{"Microsoft.Build.Tasks.Git", "Microsoft.SourceLink.Common"},
+	}, entries["microsoft.sourcelink.github"])
+}
+
+func TestNuGet_LockedPackages(t *testing.T) {
+	packages, err := NuGet{}.LockedPackages(filepath.Join("testdata", "src", "Api", "packages.lock.json"))
+	assert.NoError(t, err)
+
+	// Dependencies missing from the lockfile are skipped
+	assert.Contains(t, packages, types.LockedPackage{
+		Manager: types.NuGet,
+		Name:    "Microsoft.SourceLink.GitHub",
+		Version: "8.0.0",
+		Hashes: []types.Hash{{
+			Algorithm: "SHA-512",
+			Value:     "1b9abb3aab7023219391e20e01cdeed99b95fe489c41a79ce446919dcd2921e4a787d2d48e3f8f610ac9601511bc3b7bb701a5d8f280ee748dd24cf5670e5b9d",
+		}},
+	})
+
+	i := slices.IndexFunc(packages, func(p types.LockedPackage) bool { return p.Name == "Polly" })
+	assert.Equal(t, []string{"Polly.Core@8.4.2"}, packages[i].Dependencies)
+}
+
+func TestNuGet_LockfilePath(t *testing.T) {
+	manager := NuGet{}
+	tests := []struct {
+		name        string
+		inputPath   string
+		expected    string
+		expectError bool
+	}{
+		{
+			name:      "project",
+			inputPath: filepath.Join("testdata", "src", "Api", "Api.csproj"),
+			expected:  filepath.Join("testdata", "src", "Api", "packages.lock.json"),
+		},
+		{
+			name:      "central package management",
+			inputPath: filepath.Join("testdata", "Directory.Packages.props"),
+			expected:  filepath.Join("testdata", "src", "Api", "packages.lock.json"),
+		},
+		{
+			name:        "missing lockfile",
+			inputPath:   filepath.Join("testdata", "src", "Worker", "Worker.csproj"),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lockfilePath, err := manager.LockfilePath(tt.inputPath)
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Empty(t, lockfilePath)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, lockfilePath)
+			}
+		})
+	}
+}
+
+func TestCleanVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  string
+		expected string
+	}{
+		{name: "exact version", version: "13.0.3", expected: "13.0.3"},
+		{name: "exact match", version: "[13.0.3]", expected: "13.0.3"},
+		{name: "range", version: "[8.4.0, 9.0.0)", expected: "8.4.0"},
+		{name: "floating", version: "2.1.*", expected: "2.1"},
+		{name: "any", version: "*", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := cleanVersion(tt.version)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
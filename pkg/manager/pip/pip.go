@@ -3,11 +3,13 @@ package pip
 import (
 	"bufio"
 	"fmt"
-	"github.com/depshubhq/depshub/pkg/types"
 	"os"
 	"path/filepath"
 	"regexp"
 	"strings"
+
+	"github.com/depshubhq/depshub/pkg/manager/pylock"
+	"github.com/depshubhq/depshub/pkg/types"
 )
 
 type Pip struct{}
@@ -17,7 +19,8 @@ func (Pip) GetType() types.ManagerType {
 }
 
 func (Pip) Managed(path string) bool {
-	return filepath.Base(path) == "requirements.txt"
+	base := filepath.Base(path)
+	return base == "requirements.txt" || base == "requirements.in"
 }
 
 func (Pip) Dependencies(path string) ([]types.Dependency, error) {
@@ -45,22 +48,36 @@ func (Pip) Dependencies(path string) ([]types.Dependency, error) {
 			continue
 		}
 
-		// Handle -r requirements inclusion
-		if strings.HasPrefix(line, "-r ") {
-			continue // Skip requirements file inclusions for now
+		// Skip options like -r, -e or the --hash lines of pip-compile output
+		if strings.HasPrefix(strings.TrimSpace(line), "-") {
+			continue
+		}
+
+		// Remove line continuations and environment markers
+		requirement := strings.TrimSuffix(strings.TrimSpace(line), "\\")
+		if idx := strings.Index(requirement, ";"); idx != -1 {
+			requirement = requirement[:idx]
 		}
 
 		// Extract package name and version
 		var name, version string
-		if matches := versionPattern.FindStringSubmatch(line); matches != nil {
+		if matches := versionPattern.FindStringSubmatch(requirement); matches != nil {
 			name = strings.TrimSpace(matches[1])
 			version = cleanVersion(matches[3])
 		} else {
 			// Package with no version specified
-			name = strings.TrimSpace(line)
+			name = strings.TrimSpace(requirement)
+			if idx := strings.Index(name, "#"); idx != -1 {
+				name = strings.TrimSpace(name[:idx])
+			}
 			version = ""
 		}
 
+		// Remove extras like requests[socks]
+		if idx := strings.Index(name, "["); idx != -1 {
+			name = strings.TrimSpace(name[:idx])
+		}
+
 		dependencies = append(dependencies, types.Dependency{
 			Manager: types.Pip,
 			Name:    name,
@@ -78,6 +95,16 @@ func (Pip) Dependencies(path string) ([]types.Dependency, error) {
 		return nil, err
 	}
 
+	// Use the versions from the lockfile when there is one
+	if lockfilePath, err := (Pip{}).LockfilePath(path); err == nil && lockfilePath != path {
+		packages, err := pylock.Read(lockfilePath)
+		if err != nil {
+			return nil, err
+		}
+
+		packages.Resolve(dependencies)
+	}
+
 	return dependencies, nil
 }
 
@@ -96,14 +123,30 @@ func cleanVersion(version string) string {
 }
 
 func (Pip) LockfilePath(path string) (string, error) {
+	// requirements.in is compiled into requirements.txt by pip-compile
+	if filepath.Base(path) == "requirements.in" {
+		lockfilePath := filepath.Join(filepath.Dir(path), "requirements.txt")
+		if _, err := os.Stat(lockfilePath); err == nil {
+			return lockfilePath, nil
+		}
+	}
+
 	// Check for requirements.txt in the same directory
 	lockfilePath := filepath.Join(filepath.Dir(path), "requirements.lock")
-	if _, err := os.Stat(lockfilePath); os.IsNotExist(err) {
-		// Some projects use pip-lock instead
-		lockfilePath = filepath.Join(filepath.Dir(path), "pip.lock")
-		if _, err := os.Stat(lockfilePath); os.IsNotExist(err) {
-			return "", fmt.Errorf("lockfile not found")
-		}
+	if _, err := os.Stat(lockfilePath); err == nil {
+		return lockfilePath, nil
 	}
-	return lockfilePath, nil
+
+	// Some projects use pip-lock instead
+	lockfilePath = filepath.Join(filepath.Dir(path), "pip.lock")
+	if _, err := os.Stat(lockfilePath); err == nil {
+		return lockfilePath, nil
+	}
+
+	// pip-compile output with hashes is a lockfile on its own
+	if pylock.IsPinned(path) {
+		return path, nil
+	}
+
+	return "", fmt.Errorf("lockfile not found")
 }
@@ -24,6 +24,11 @@ func TestPip_Managed(t *testing.T) {
 			path:     "path/to/requirements.txt",
 			expected: true,
 		},
+		{
+			name:     "requirements.in file",
+			path:     "path/to/requirements.in",
+			expected: true,
+		},
 		{
 			name:     "other file",
 			path:     "path/to/other.txt",
@@ -114,6 +119,79 @@ func TestPip_Dependencies(t *testing.T) {
 	}
 }
 
+func TestPip_DependenciesCompiled(t *testing.T) {
+	manager := Pip{}
+	tests := []struct {
+		name     string
+		path     string
+		expected []types.Dependency
+	}{
+		{
+			name: "requirements.in resolved from requirements.txt",
+			path: filepath.Join("testdata", "compiled", "requirements.in"),
+			expected: []types.Dependency{
+				{
+					Manager: types.Pip,
+					Name:    "requests",
+					Version: "2.32.3",
+					Definition: types.Definition{
+						RawLine: "requests[socks]>=2.31",
+						Line:    1,
+					},
+				},
+				{
+					Manager: types.Pip,
+					Name:    "certifi",
+					Version: "2024.8.30",
+					Definition: types.Definition{
+						RawLine: "certifi",
+						Line:    2,
+					},
+				},
+			},
+		},
+		{
+			name: "hash-pinned requirements.txt",
+			path: filepath.Join("testdata", "compiled", "requirements.txt"),
+			expected: []types.Dependency{
+				{
+					Manager: types.Pip,
+					Name:    "certifi",
+					Version: "2024.8.30",
+					Definition: types.Definition{
+						RawLine: "certifi==2024.8.30 \\",
+						Line:    7,
+					},
+				},
+				{
+					Manager: types.Pip,
+					Name:    "requests",
+					Version: "2.32.3",
+					Definition: types.Definition{
+						RawLine: "requests[socks]==2.32.3 \\",
+						Line:    13,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dependencies, err := manager.Dependencies(tt.path)
+			assert.NoError(t, err)
+
+			assert.Equal(t, len(tt.expected), len(dependencies))
+			for i, exp := range tt.expected {
+				assert.Equal(t, exp.Name, dependencies[i].Name)
+				assert.Equal(t, exp.Version, dependencies[i].Version)
+				assert.Equal(t, exp.Line, dependencies[i].Line)
+				assert.Equal(t, exp.RawLine, dependencies[i].RawLine)
+			}
+		})
+	}
+}
+
 func TestPip_LockfilePath(t *testing.T) {
 	manager := Pip{}
 	tests := []struct {
@@ -126,6 +204,16 @@ func TestPip_LockfilePath(t *testing.T) {
 			inputPath:   "testdata/requirements.txt",
 			expectError: true,
 		},
+		{
+			name:        "pip-compile output",
+			inputPath:   "testdata/compiled/requirements.in",
+			expectError: false,
+		},
+		{
+			name:        "hash-pinned requirements",
+			inputPath:   "testdata/compiled/requirements.txt",
+			expectError: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,145 @@
+package pipfile
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/depshubhq/depshub/pkg/manager/pylock"
+	"github.com/depshubhq/depshub/pkg/types"
+	"github.com/pelletier/go-toml"
+)
+
+type Pipfile struct{}
+
+func (Pipfile) GetType() types.ManagerType {
+	return types.Pipfile
+}
+
+func (Pipfile) Managed(path string) bool {
+	return filepath.Base(path) == "Pipfile"
+}
+
+func (Pipfile) Dependencies(path string) ([]types.Dependency, error) {
+	var dependencies []types.Dependency
+
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := toml.LoadBytes(file)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := strings.Split(string(file), "\n")
+
+	sections := []struct {
+		name string
+		dev  bool
+	}{
+		{name: "packages", dev: false},
+		{name: "dev-packages", dev: true},
+	}
+
+	for _, section := range sections {
+		packages, ok := tree.Get(section.name).(*toml.Tree)
+		if !ok {
+			continue
+		}
+
+		for _, name := range packages.Keys() {
+			var version string
+
+			switch value := packages.GetPath([]string{name}).(type) {
+			case string:
+				version = value
+			case *toml.Tree:
+				v, ok := value.Get("version").(string)
+				if !ok {
+					// Skip git, path and file dependencies
+					continue
+				}
+				version = v
+			default:
+				continue
+			}
+
+			line, rawLine := findLineInfo(lines, packages.Position().Line, name)
+
+			dependencies = append(dependencies, types.Dependency{
+				Manager: types.Pipfile,
+				Name:    name,
+				Version: cleanVersion(version),
+				Dev:     section.dev,
+				Definition: types.Definition{
+					Path:    path,
+					RawLine: rawLine,
+					Line:    line,
+				},
+			})
+		}
+	}
+
+	// Some of the rules require the original order of dependencies
+	// Sort dependencies by line number
+	sort.Slice(dependencies, func(i, j int) bool {
+		return dependencies[i].Line < dependencies[j].Line
+	})
+
+	// Use the versions from Pipfile.lock when there is one, or keep the declared ones
+	if lockfilePath, err := (Pipfile{}).LockfilePath(path); err == nil {
+		packages, err := pylock.Read(lockfilePath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading lockfile %s: %s\n", lockfilePath, err)
+		} else {
+			packages.Resolve(dependencies)
+		}
+	}
+
+	return dependencies, nil
+}
+
+func (Pipfile) LockfilePath(path string) (string, error) {
+	lockfilePath := filepath.Join(filepath.Dir(path), "Pipfile.lock")
+
+	if _, err := os.Stat(lockfilePath); os.IsNotExist(err) {
+		return "", fmt.Errorf("lockfile not found")
+	}
+
+	return lockfilePath, nil
+}
+
+// Returns the version without any prefix or suffix
+func cleanVersion(version string) string {
+	version = strings.TrimSpace(version)
+
+	// Check for a range of versions (e.g. ">=1.0.0,<2.0.0")
+	if idx := strings.Index(version, ","); idx != -1 {
+		version = version[:idx]
+	}
+
+	return strings.Trim(version, "^~*><=! ")
+}
+
+// findLineInfo returns the first line after the section header that assigns the key.
+func findLineInfo(lines []string, from int, key string) (line int, rawLine string) {
+	if from < 1 {
+		from = 1
+	}
+
+	for i := from - 1; i < len(lines); i++ {
+		trimmed := strings.TrimSpace(lines[i])
+
+		for _, k := range []string{key, `"` + key + `"`} {
+			if rest, ok := strings.CutPrefix(trimmed, k); ok && strings.HasPrefix(strings.TrimSpace(rest), "=") {
+				return i + 1, trimmed
+			}
+		}
+	}
+
+	return 0, ""
+}
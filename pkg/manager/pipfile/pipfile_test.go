@@ -0,0 +1,180 @@
+package pipfile
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/depshubhq/depshub/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPipfile_GetType(t *testing.T) {
+	manager := Pipfile{}
+	assert.Equal(t, types.Pipfile, manager.GetType())
+}
+
+func TestPipfile_Managed(t *testing.T) {
+	manager := Pipfile{}
+	tests := []struct {
+		name     string
+		path     string
+		expected bool
+	}{
+		{
+			name:     "Pipfile",
+			path:     "path/to/Pipfile",
+			expected: true,
+		},
+		{
+			name:     "Pipfile.lock",
+			path:     "path/to/Pipfile.lock",
+			expected: false,
+		},
+		{
+			name:     "other file",
+			path:     "path/to/other.txt",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := manager.Managed(tt.path)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestPipfile_Dependencies(t *testing.T) {
+	manager := Pipfile{}
+	testPath := filepath.Join("testdata", "Pipfile")
+
+	dependencies, err := manager.Dependencies(testPath)
+	assert.NoError(t, err)
+
+	expected := []types.Dependency{
+		{
+			Manager: types.Pipfile,
+			Name:    "flask",
+			Version: "3.0.3",
+			Dev:     false,
+			Definition: types.Definition{
+				Path:    testPath,
+				RawLine: "flask = \"==3.0.3\"",
+				Line:    7,
+			},
+		},
+		{
+			Manager: types.Pipfile,
+			Name:    "requests",
+			Version: "2.31",
+			Dev:     false,
+			Definition: types.Definition{
+				Path:    testPath,
+				RawLine: "requests = {version = \">=2.31,<3\", extras = [\"socks\"]}",
+				Line:    8,
+			},
+		},
+		{
+			Manager: types.Pipfile,
+			Name:    "pytest",
+			Version: "8.3.3",
+			Dev:     true,
+			Definition: types.Definition{
+				Path:    testPath,
+				RawLine: "pytest = \"*\"",
+				Line:    12,
+			},
+		},
+	}
+
+	assert.Equal(t, expected, dependencies)
+}
+
+func TestPipfile_DependenciesInvalidLockfile(t *testing.T) {
+	dir := t.TempDir()
+	content, err := os.ReadFile(filepath.Join("testdata", "Pipfile"))
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "Pipfile"), content, 0o644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "Pipfile.lock"), []byte("{"), 0o644))
+
+	// The declared versions are kept when the lockfile can't be read
+	dependencies, err := Pipfile{}.Dependencies(filepath.Join(dir, "Pipfile"))
+	assert.NoError(t, err)
+
+	var versions []string
+	for _, dep := range dependencies {
+		versions = append(versions, dep.Name+"@"+dep.Version)
+	}
+	assert.Equal(t, []string{"flask@3.0.3", "requests@2.31", "pytest@"}, versions)
+}
+
+func TestPipfile_LockfilePath(t *testing.T) {
+	manager := Pipfile{}
+	tests := []struct {
+		name        string
+		inputPath   string
+		expectError bool
+	}{
+		{
+			name:        "existing lockfile",
+			inputPath:   "testdata/Pipfile",
+			expectError: false,
+		},
+		{
+			name:        "missing lockfile",
+			inputPath:   "testdata/missing/Pipfile",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lockfilePath, err := manager.LockfilePath(tt.inputPath)
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Empty(t, lockfilePath)
+			} else {
+				assert.NoError(t, err)
+				assert.NotEmpty(t, lockfilePath)
+			}
+		})
+	}
+}
+
+func TestCleanVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  string
+		expected string
+	}{
+		{
+			name:     "exact version",
+			version:  "==3.0.3",
+			expected: "3.0.3",
+		},
+		{
+			name:     "any version",
+			version:  "*",
+			expected: "",
+		},
+		{
+			name:     "range",
+			version:  ">=2.31,<3",
+			expected: "2.31",
+		},
+		{
+			name:     "compatible release",
+			version:  "~=1.4",
+			expected: "1.4",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := cleanVersion(tt.version)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package pub
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// LockEntry is a package resolved in pubspec.lock.
+type LockEntry struct {
+	Name    string
+	Version string
+	// Where the package comes from: hosted, git, path or sdk
+	Source string
+	// How the package is used: "direct main", "direct dev", "direct overridden" or "transitive"
+	Dependency string
+}
+
+type lockfile struct {
+	Packages map[string]struct {
+		Dependency string `yaml:"dependency"`
+		Source     string `yaml:"source"`
+		Version    string `yaml:"version"`
+	} `yaml:"packages"`
+}
+
+// ReadLockfile parses pubspec.lock and returns the packages keyed by name.
+func ReadLockfile(path string) (map[string]LockEntry, error) {
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var lock lockfile
+	if err := yaml.Unmarshal(file, &lock); err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+
+	entries := make(map[string]LockEntry, len(lock.Packages))
+	for name, pkg := range lock.Packages {
+		entries[name] = LockEntry{
+			Name:       name,
+			Version:    pkg.Version,
+			Source:     pkg.Source,
+			Dependency: pkg.Dependency,
+		}
+	}
+
+	return entries, nil
+}
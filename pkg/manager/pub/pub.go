@@ -0,0 +1,179 @@
+package pub
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/depshubhq/depshub/pkg/types"
+	"gopkg.in/yaml.v3"
+)
+
+type Pub struct{}
+
+func (Pub) GetType() types.ManagerType {
+	return types.Pub
+}
+
+func (Pub) Managed(path string) bool {
+	return filepath.Base(path) == "pubspec.yaml"
+}
+
+func (Pub) Dependencies(path string) ([]types.Dependency, error) {
+	var dependencies []types.Dependency
+
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var document yaml.Node
+	if err := yaml.Unmarshal(file, &document); err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+
+	if len(document.Content) == 0 {
+		return nil, nil
+	}
+
+	lines := strings.Split(string(file), "\n")
+	root := document.Content[0]
+
+	sections := []struct {
+		name string
+		dev  bool
+	}{
+		{name: "dependencies", dev: false},
+		{name: "dev_dependencies", dev: true},
+		{name: "dependency_overrides", dev: false},
+	}
+
+	for _, section := range sections {
+		packages := mappingValue(root, section.name)
+		if packages == nil || packages.Kind != yaml.MappingNode {
+			continue
+		}
+
+		for i := 0; i+1 < len(packages.Content); i += 2 {
+			key, value := packages.Content[i], packages.Content[i+1]
+
+			version, registry, ok := hostedVersion(value)
+			if !ok {
+				continue
+			}
+
+			dependencies = append(dependencies, types.Dependency{
+				Manager:  types.Pub,
+				Name:     key.Value,
+				Version:  cleanVersion(version),
+				Registry: registry,
+				Dev:      section.dev,
+				Definition: types.Definition{
+					Path:    path,
+					RawLine: strings.TrimSpace(lines[key.Line-1]),
+					Line:    key.Line,
+				},
+			})
+		}
+	}
+
+	// Some of the rules require the original order of dependencies
+	// Sort dependencies by line number
+	sort.Slice(dependencies, func(i, j int) bool {
+		return dependencies[i].Line < dependencies[j].Line
+	})
+
+	// Use the versions from pubspec.lock when there is one
+	if lockfilePath, err := (Pub{}).LockfilePath(path); err == nil {
+		entries, err := ReadLockfile(lockfilePath)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, dep := range dependencies {
+			if entry, ok := entries[dep.Name]; ok && entry.Source == "hosted" {
+				dependencies[i].Version = entry.Version
+			}
+		}
+	}
+
+	return dependencies, nil
+}
+
+// hostedVersion returns the version constraint of a package hosted on a pub
+// repository and the URL of the repository, if it isn't the default one. It's
+// either a string or a map like `{version: ^1.0.0, hosted: ...}`, where hosted
+// is the URL or a map like `{name: ..., url: ...}` in older manifests.
+// Packages from the SDK, git or a path aren't hosted, so they are skipped.
+func hostedVersion(value *yaml.Node) (version string, registry string, ok bool) {
+	switch value.Kind {
+	case yaml.ScalarNode:
+		return value.Value, "", true
+	case yaml.MappingNode:
+		for _, source := range []string{"sdk", "git", "path"} {
+			if mappingValue(value, source) != nil {
+				return "", "", false
+			}
+		}
+
+		if hosted := mappingValue(value, "hosted"); hosted != nil {
+			if url := mappingValue(hosted, "url"); url != nil {
+				hosted = url
+			}
+			if hosted.Kind == yaml.ScalarNode {
+				registry = hosted.Value
+			}
+		}
+
+		if version := mappingValue(value, "version"); version != nil {
+			return version.Value, registry, true
+		}
+
+		return "", registry, true
+	}
+
+	return "", "", false
+}
+
+// mappingValue returns the value of key in a YAML mapping, if any.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+
+	return nil
+}
+
+func (Pub) LockfilePath(path string) (string, error) {
+	lockfilePath := filepath.Join(filepath.Dir(path), "pubspec.lock")
+
+	if _, err := os.Stat(lockfilePath); os.IsNotExist(err) {
+		return "", fmt.Errorf("lockfile not found")
+	}
+
+	return lockfilePath, nil
+}
+
+// Returns the version without any prefix or suffix
+func cleanVersion(version string) string {
+	fields := strings.Fields(version)
+	if len(fields) == 0 || fields[0] == "any" {
+		return ""
+	}
+
+	// Use the lower bound of ">=1.0.0 <2.0.0", the operator may be followed by a space
+	version = fields[0]
+	if strings.Trim(version, "^><=") == "" && len(fields) > 1 {
+		version += fields[1]
+	}
+
+	return strings.TrimLeft(version, "^><=")
+}
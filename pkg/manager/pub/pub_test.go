@@ -0,0 +1,168 @@
+package pub
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/depshubhq/depshub/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPub_GetType(t *testing.T) {
+	manager := Pub{}
+	assert.Equal(t, types.Pub, manager.GetType())
+}
+
+func TestPub_Managed(t *testing.T) {
+	manager := Pub{}
+	tests := []struct {
+		name     string
+		path     string
+		expected bool
+	}{
+		{name: "pubspec", path: "path/to/pubspec.yaml", expected: true},
+		{name: "lockfile", path: "path/to/pubspec.lock", expected: false},
+		{name: "other yaml file", path: "path/to/analysis_options.yaml", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := manager.Managed(tt.path)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestPub_Dependencies(t *testing.T) {
+	manager := Pub{}
+
+	dependency := func(path, name, version string, dev bool, line int, rawLine string) types.Dependency {
+		return types.Dependency{
+			Manager: types.Pub,
+			Name:    name,
+			Version: version,
+			Dev:     dev,
+			Definition: types.Definition{
+				Path:    path,
+				RawLine: rawLine,
+				Line:    line,
+			},
+		}
+	}
+
+	hosted := func(dep types.Dependency, registry string) types.Dependency {
+		dep.Registry = registry
+		return dep
+	}
+
+	tests := []struct {
+		name     string
+		path     string
+		expected func(path string) []types.Dependency
+	}{
+		{
+			name: "with lockfile",
+			path: filepath.Join("testdata", "pubspec.yaml"),
+			expected: func(path string) []types.Dependency {
+				return []types.Dependency{
+					dependency(path, "http", "1.2.1", false, 13, "http: ^1.2.1"),
+					dependency(path, "collection", "1.18.0", false, 14, "collection: any"),
+					dependency(path, "intl", "0.19.0", false, 15, `intl: ">=0.18.0 <0.20.0"`),
+					dependency(path, "provider", "6.1.2", false, 16, "provider:"),
+					hosted(dependency(path, "internal_sdk", "2.0.0", false, 24, "internal_sdk:"), "https://pub.example.com"),
+					dependency(path, "build_runner", "2.4.9", true, 31, "build_runner: ^2.4.9"),
+					dependency(path, "mocktail", "1.0.3", true, 32, "mocktail: 1.0.3"),
+					dependency(path, "collection", "1.18.0", false, 35, "collection: 1.18.0"),
+				}
+			},
+		},
+		{
+			name: "without lockfile",
+			path: filepath.Join("testdata", "nolock", "pubspec.yaml"),
+			expected: func(path string) []types.Dependency {
+				return []types.Dependency{
+					dependency(path, "path", "1.9.0", false, 4, "path: ^1.9.0"),
+					dependency(path, "args", "2.4.0", false, 5, "args: '>= 2.4.0 < 3.0.0'"),
+					hosted(dependency(path, "internal_logging", "0.3.1", false, 6, "internal_logging:"), "https://dart.internal.example.com/"),
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dependencies, err := manager.Dependencies(tt.path)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected(tt.path), dependencies)
+		})
+	}
+}
+
+func TestReadLockfile(t *testing.T) {
+	entries, err := ReadLockfile(filepath.Join("testdata", "pubspec.lock"))
+	assert.NoError(t, err)
+
+	assert.Equal(t, LockEntry{
+		Name:       "http_parser",
+		Version:    "4.0.2",
+		Source:     "hosted",
+		Dependency: "transitive",
+	}, entries["http_parser"])
+	assert.Equal(t, "sdk", entries["flutter"].Source)
+}
+
+func TestPub_LockfilePath(t *testing.T) {
+	manager := Pub{}
+	tests := []struct {
+		name        string
+		inputPath   string
+		expected    string
+		expectError bool
+	}{
+		{
+			name:      "existing lockfile",
+			inputPath: filepath.Join("testdata", "pubspec.yaml"),
+			expected:  filepath.Join("testdata", "pubspec.lock"),
+		},
+		{
+			name:        "missing lockfile",
+			inputPath:   filepath.Join("testdata", "nolock", "pubspec.yaml"),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lockfilePath, err := manager.LockfilePath(tt.inputPath)
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Empty(t, lockfilePath)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, lockfilePath)
+			}
+		})
+	}
+}
+
+func TestCleanVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  string
+		expected string
+	}{
+		{name: "exact version", version: "1.0.3", expected: "1.0.3"},
+		{name: "caret", version: "^1.2.1", expected: "1.2.1"},
+		{name: "range", version: ">=0.18.0 <0.20.0", expected: "0.18.0"},
+		{name: "range with spaces", version: ">= 2.4.0 < 3.0.0", expected: "2.4.0"},
+		{name: "any", version: "any", expected: ""},
+		{name: "empty", version: "", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := cleanVersion(tt.version)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
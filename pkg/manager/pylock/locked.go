@@ -0,0 +1,122 @@
+package pylock
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+
+	"github.com/BurntSushi/toml"
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+// Matches the name of a PEP 508 requirement
+var requirementName = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*`)
+
+// lockedPackage is a package of poetry.lock, pdm.lock or uv.lock with its
+// dependencies, which are a table in Poetry, PEP 508 requirements in PDM and
+// tables with a name in uv.
+type lockedPackage struct {
+	Name            string           `toml:"name"`
+	Version         string           `toml:"version"`
+	Source          map[string]any   `toml:"source"`
+	Dependencies    any              `toml:"dependencies"`
+	DevDependencies map[string][]any `toml:"dev-dependencies"`
+}
+
+// Locked returns the packages of a Poetry, PDM or uv lockfile with their
+// dependencies. The dependencies of the project, which uv locks as an editable
+// or virtual package, are recorded as direct. Other lockfiles don't record the
+// dependencies of the packages, so no packages are returned for them.
+func Locked(path string, manager types.ManagerType) ([]types.LockedPackage, error) {
+	switch filepath.Base(path) {
+	case "poetry.lock", "pdm.lock", "uv.lock":
+	default:
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var lockfile struct {
+		Package []lockedPackage `toml:"package"`
+	}
+	if err := toml.Unmarshal(data, &lockfile); err != nil {
+		return nil, fmt.Errorf("error parsing lockfile: %w", err)
+	}
+
+	ids := make(map[string]string)
+	for _, pkg := range lockfile.Package {
+		ids[Normalize(pkg.Name)] = types.LockedID(pkg.Name, pkg.Version)
+	}
+
+	direct := make(map[string]bool)
+	for _, pkg := range lockfile.Package {
+		if pkg.Source["editable"] != "." && pkg.Source["virtual"] != "." {
+			continue
+		}
+
+		names := dependencyNames(pkg.Dependencies)
+		for _, deps := range pkg.DevDependencies {
+			names = append(names, dependencyNames(deps)...)
+		}
+
+		for _, name := range names {
+			direct[Normalize(name)] = true
+		}
+	}
+
+	var packages []types.LockedPackage
+
+	for _, pkg := range lockfile.Package {
+		locked := types.LockedPackage{
+			Manager: manager,
+			Name:    pkg.Name,
+			Version: pkg.Version,
+			Direct:  direct[Normalize(pkg.Name)],
+		}
+
+		// Optional dependencies of extras that aren't used aren't locked
+		for _, name := range dependencyNames(pkg.Dependencies) {
+			if id, ok := ids[Normalize(name)]; ok && !slices.Contains(locked.Dependencies, id) {
+				locked.Dependencies = append(locked.Dependencies, id)
+			}
+		}
+
+		packages = append(packages, locked)
+	}
+
+	return packages, nil
+}
+
+// dependencyNames returns the names of the dependencies of a locked package.
+// Packages can depend on the same package with different markers.
+func dependencyNames(dependencies any) []string {
+	var names []string
+
+	switch deps := dependencies.(type) {
+	case map[string]any:
+		for name := range deps {
+			names = append(names, name)
+		}
+		slices.Sort(names)
+	case []any:
+		for _, dep := range deps {
+			switch dep := dep.(type) {
+			case string:
+				if match := requirementName.FindString(dep); match != "" {
+					names = append(names, match)
+				}
+			case map[string]any:
+				if name, ok := dep["name"].(string); ok {
+					names = append(names, name)
+				}
+			}
+		}
+	}
+
+	return names
+}
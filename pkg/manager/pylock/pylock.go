@@ -0,0 +1,145 @@
+// Package pylock reads the lockfiles of the Python package managers:
+// poetry.lock, pdm.lock, uv.lock, Pipfile.lock and pip-compile output.
+package pylock
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+// Packages maps normalized package names to their locked versions.
+type Packages map[string]string
+
+type tomlLockfile struct {
+	Package []struct {
+		Name    string `toml:"name"`
+		Version string `toml:"version"`
+	} `toml:"package"`
+}
+
+type pipfileLock struct {
+	Default map[string]pipfileLockEntry `json:"default"`
+	Develop map[string]pipfileLockEntry `json:"develop"`
+}
+
+type pipfileLockEntry struct {
+	Version string `json:"version"`
+}
+
+var separatorPattern = regexp.MustCompile(`[-_.]+`)
+
+// Matches pinned requirements like `requests[socks]==2.32.3 \`
+var pinnedPattern = regexp.MustCompile(`^([A-Za-z0-9][A-Za-z0-9._-]*)\s*(\[[^\]]*\])?\s*===?\s*([^\s;\\#]+)`)
+
+// Read parses the lockfile at the given path. The format is detected from the file name,
+// anything that isn't a known lockfile is read as pip-compile output.
+func Read(path string) (Packages, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch filepath.Base(path) {
+	case "poetry.lock", "pdm.lock", "uv.lock":
+		return readTOML(data)
+	case "Pipfile.lock":
+		return readPipfileLock(data)
+	default:
+		return readRequirements(data)
+	}
+}
+
+// Normalize returns the PEP 503 normalized form of a package name.
+func Normalize(name string) string {
+	return separatorPattern.ReplaceAllString(strings.ToLower(strings.TrimSpace(name)), "-")
+}
+
+// Version returns the locked version of the package.
+func (p Packages) Version(name string) (string, bool) {
+	version, ok := p[Normalize(name)]
+	return version, ok
+}
+
+// Resolve replaces the declared versions of the dependencies with the locked ones.
+func (p Packages) Resolve(dependencies []types.Dependency) {
+	for i, dep := range dependencies {
+		if version, ok := p.Version(dep.Name); ok {
+			dependencies[i].Version = version
+		}
+	}
+}
+
+// IsPinned reports whether the requirements file is pip-compile output with hashes.
+func IsPinned(path string) bool {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Contains(data, []byte("--hash="))
+}
+
+func readTOML(data []byte) (Packages, error) {
+	var lockfile tomlLockfile
+	if err := toml.Unmarshal(data, &lockfile); err != nil {
+		return nil, fmt.Errorf("error parsing lockfile: %w", err)
+	}
+
+	packages := make(Packages)
+	for _, pkg := range lockfile.Package {
+		packages[Normalize(pkg.Name)] = pkg.Version
+	}
+
+	return packages, nil
+}
+
+func readPipfileLock(data []byte) (Packages, error) {
+	var lockfile pipfileLock
+	if err := json.Unmarshal(data, &lockfile); err != nil {
+		return nil, fmt.Errorf("error parsing Pipfile.lock: %w", err)
+	}
+
+	packages := make(Packages)
+	for _, entries := range []map[string]pipfileLockEntry{lockfile.Develop, lockfile.Default} {
+		for name, entry := range entries {
+			if entry.Version == "" {
+				// Git and path dependencies aren't pinned to a version
+				continue
+			}
+			packages[Normalize(name)] = strings.TrimLeft(entry.Version, "=")
+		}
+	}
+
+	return packages, nil
+}
+
+func readRequirements(data []byte) (Packages, error) {
+	packages := make(Packages)
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		matches := pinnedPattern.FindStringSubmatch(line)
+		if matches == nil {
+			continue
+		}
+
+		packages[Normalize(matches[1])] = matches[3]
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return packages, nil
+}
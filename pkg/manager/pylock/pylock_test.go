@@ -0,0 +1,141 @@
+package pylock
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/depshubhq/depshub/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRead(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		expected Packages
+	}{
+		{
+			name:     "poetry.lock",
+			path:     filepath.Join("testdata", "poetry.lock"),
+			expected: Packages{"django": "5.1.2", "sqlparse": "0.5.1"},
+		},
+		{
+			name:     "pdm.lock",
+			path:     filepath.Join("testdata", "pdm.lock"),
+			expected: Packages{"httpx": "0.27.2", "mkdocs": "1.6.1"},
+		},
+		{
+			name:     "uv.lock",
+			path:     filepath.Join("testdata", "uv.lock"),
+			expected: Packages{"example-project": "0.1.0", "ruamel-yaml": "0.18.6", "pytest": "8.3.3"},
+		},
+		{
+			name:     "Pipfile.lock",
+			path:     filepath.Join("testdata", "Pipfile.lock"),
+			expected: Packages{"flask": "3.0.3", "pytest": "8.3.3"},
+		},
+		{
+			name:     "pip-compile output",
+			path:     filepath.Join("testdata", "requirements.txt"),
+			expected: Packages{"certifi": "2024.8.30", "requests": "2.32.3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			packages, err := Read(tt.path)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, packages)
+		})
+	}
+}
+
+func TestRead_MissingFile(t *testing.T) {
+	_, err := Read(filepath.Join("testdata", "missing.lock"))
+	assert.Error(t, err)
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{"requests", "requests"},
+		{"Django", "django"},
+		{"ruamel.yaml", "ruamel-yaml"},
+		{"Foo__Bar-.baz", "foo-bar-baz"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Normalize(tt.name))
+		})
+	}
+}
+
+func TestPackages_Resolve(t *testing.T) {
+	packages := Packages{"django": "5.1.2", "ruamel-yaml": "0.18.6"}
+	dependencies := []types.Dependency{
+		{Name: "Django", Version: "5.1"},
+		{Name: "ruamel_yaml", Version: "0.18"},
+		{Name: "missing", Version: "1.0"},
+	}
+
+	packages.Resolve(dependencies)
+
+	assert.Equal(t, "5.1.2", dependencies[0].Version)
+	assert.Equal(t, "0.18.6", dependencies[1].Version)
+	assert.Equal(t, "1.0", dependencies[2].Version)
+}
+
+func TestIsPinned(t *testing.T) {
+	assert.True(t, IsPinned(filepath.Join("testdata", "requirements.txt")))
+	assert.False(t, IsPinned(filepath.Join("testdata", "poetry.lock")))
+	assert.False(t, IsPinned(filepath.Join("testdata", "missing.txt")))
+}
+
+func TestLocked(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		expected []types.LockedPackage
+	}{
+		{
+			name: "poetry.lock",
+			path: filepath.Join("testdata", "poetry.lock"),
+			expected: []types.LockedPackage{
+				{Manager: types.Pip, Name: "Django", Version: "5.1.2", Dependencies: []string{"sqlparse@0.5.1"}},
+				{Manager: types.Pip, Name: "sqlparse", Version: "0.5.1"},
+			},
+		},
+		{
+			name: "pdm.lock",
+			path: filepath.Join("testdata", "pdm.lock"),
+			expected: []types.LockedPackage{
+				{Manager: types.Pip, Name: "httpx", Version: "0.27.2"},
+				{Manager: types.Pip, Name: "mkdocs", Version: "1.6.1", Dependencies: []string{"httpx@0.27.2"}},
+			},
+		},
+		{
+			name: "uv.lock",
+			path: filepath.Join("testdata", "uv.lock"),
+			expected: []types.LockedPackage{
+				{Manager: types.Pip, Name: "example-project", Version: "0.1.0", Dependencies: []string{"ruamel-yaml@0.18.6"}},
+				{Manager: types.Pip, Name: "ruamel-yaml", Version: "0.18.6", Direct: true},
+				{Manager: types.Pip, Name: "pytest", Version: "8.3.3", Direct: true},
+			},
+		},
+		{
+			name: "Pipfile.lock",
+			path: filepath.Join("testdata", "Pipfile.lock"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			packages, err := Locked(tt.path, types.Pip)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, packages)
+		})
+	}
+}
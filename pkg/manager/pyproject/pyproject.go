@@ -1,17 +1,26 @@
 package pyproject
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 	"sort"
 	"strings"
 
+	"github.com/depshubhq/depshub/pkg/manager/pylock"
 	"github.com/depshubhq/depshub/pkg/types"
 	"github.com/pelletier/go-toml"
 )
 
 type Pyproject struct{}
 
+// Lockfiles produced by the tools that read pyproject.toml, in order of preference.
+var lockfiles = []string{"poetry.lock", "pdm.lock", "uv.lock"}
+
+// Matches a PEP 508 requirement: the name, optional extras and the rest of the specifier.
+var requirementPattern = regexp.MustCompile(`^\s*([A-Za-z0-9][A-Za-z0-9._-]*)\s*(\[[^\]]*\])?\s*(.*)$`)
+
 func (Pyproject) GetType() types.ManagerType {
 	return types.Pyproject
 }
@@ -21,52 +30,246 @@ func (Pyproject) Managed(path string) bool {
 }
 
 func (Pyproject) Dependencies(path string) ([]types.Dependency, error) {
-	var dependencies []types.Dependency
-
-	file, err := os.Open(path)
+	file, err := os.ReadFile(path)
 	if err != nil {
 		return nil, err
 	}
-	defer file.Close()
 
-	tree, err := toml.LoadReader(file)
+	tree, err := toml.LoadBytes(file)
 	if err != nil {
 		return nil, err
 	}
 
-	// Parse [project.dependencies]
-	if deps, ok := tree.Get("project.dependencies").(*toml.Tree); ok {
-		for name, value := range deps.ToMap() {
-			version, ok := value.(string)
-			if !ok {
-				continue // Skip non-string values like nested tables
-			}
+	p := parser{
+		path:  path,
+		lines: strings.Split(string(file), "\n"),
+	}
+
+	// PEP 621 [project] table. Older files declare project.dependencies as a
+	// Poetry-style table, so both forms are accepted.
+	switch deps := tree.GetPath([]string{"project", "dependencies"}).(type) {
+	case []interface{}:
+		p.addRequirements(deps, tree.GetPositionPath([]string{"project", "dependencies"}), "project.dependencies", false)
+	case *toml.Tree:
+		p.addTable(deps, "project.dependencies", false)
+	}
+
+	if groups, ok := tree.GetPath([]string{"project", "optional-dependencies"}).(*toml.Tree); ok {
+		p.addRequirementGroups(groups, "project.optional-dependencies", false)
+	}
+
+	// PEP 735 [dependency-groups]
+	if groups, ok := tree.Get("dependency-groups").(*toml.Tree); ok {
+		p.addRequirementGroups(groups, "dependency-groups", true)
+	}
 
-			dependencies = append(dependencies, types.Dependency{
-				Manager: types.Pyproject,
-				Name:    name,
-				Version: cleanVersion(version),
-				Dev:     false,
-				Definition: types.Definition{
-					Path:    path,
-					RawLine: name + " = \"" + version + "\"",
-					Line:    deps.GetPosition(name).Line,
-				},
-			})
+	// Poetry
+	if deps, ok := tree.GetPath([]string{"tool", "poetry", "dependencies"}).(*toml.Tree); ok {
+		p.addTable(deps, "tool.poetry.dependencies", false)
+	}
+
+	if deps, ok := tree.GetPath([]string{"tool", "poetry", "dev-dependencies"}).(*toml.Tree); ok {
+		p.addTable(deps, "tool.poetry.dev-dependencies", true)
+	}
+
+	if groups, ok := tree.GetPath([]string{"tool", "poetry", "group"}).(*toml.Tree); ok {
+		for _, group := range groups.Keys() {
+			if deps, ok := groups.GetPath([]string{group, "dependencies"}).(*toml.Tree); ok {
+				p.addTable(deps, "tool.poetry.group."+group, true)
+			}
 		}
 	}
 
+	// PDM
+	if groups, ok := tree.GetPath([]string{"tool", "pdm", "dev-dependencies"}).(*toml.Tree); ok {
+		p.addRequirementGroups(groups, "tool.pdm.dev-dependencies", true)
+	}
+
+	// uv
+	if deps, ok := tree.GetPath([]string{"tool", "uv", "dev-dependencies"}).([]interface{}); ok {
+		p.addRequirements(deps, tree.GetPositionPath([]string{"tool", "uv", "dev-dependencies"}), "tool.uv.dev-dependencies", true)
+	}
+
 	// github.com/pelletier/go-toml V1 emits struct fields order alphabetically by default.
 	// Source: https://github.com/pelletier/go-toml?tab=readme-ov-file#default-struct-fields-order
 	// We need to sort the dependencies by line number to keep the original order.
 
-	dependencies = sortDependencies(dependencies)
+	dependencies := sortDependencies(p.dependencies)
+
+	// Use the versions from the lockfile when there is one. The declared versions
+	// are kept when it can't be read, the scanner reports the error.
+	if lockfilePath, err := (Pyproject{}).LockfilePath(path); err == nil {
+		if packages, err := pylock.Read(lockfilePath); err == nil {
+			packages.Resolve(dependencies)
+		}
+	}
 
 	return dependencies, nil
 }
 
+type parser struct {
+	path         string
+	lines        []string
+	dependencies []types.Dependency
+}
+
+// addTable adds dependencies declared as `name = "version"` or `name = { version = "..." }`.
+func (p *parser) addTable(deps *toml.Tree, group string, dev bool) {
+	for _, name := range deps.Keys() {
+		// Poetry declares the supported Python version next to the dependencies
+		if strings.EqualFold(name, "python") {
+			continue
+		}
+
+		var version string
+		line := deps.GetPositionPath([]string{name}).Line
+
+		switch value := deps.GetPath([]string{name}).(type) {
+		case string:
+			version = value
+		case *toml.Tree:
+			v, ok := value.Get("version").(string)
+			if !ok {
+				// Skip git, path and url dependencies
+				continue
+			}
+			version = v
+			// go-toml doesn't keep the key position for inline tables
+			line = p.findKey(name, deps.Position().Line)
+		default:
+			continue
+		}
+
+		p.add(name, cleanVersion(version), version, group, dev, line)
+	}
+}
+
+// addRequirementGroups adds every group of a table whose values are arrays of
+// PEP 508 strings. The groups are named after the table, like dependency-groups.test.
+func (p *parser) addRequirementGroups(groups *toml.Tree, table string, dev bool) {
+	for _, group := range groups.Keys() {
+		if deps, ok := groups.GetPath([]string{group}).([]interface{}); ok {
+			p.addRequirements(deps, groups.GetPositionPath([]string{group}), table+"."+group, dev)
+		}
+	}
+}
+
+// addRequirements adds an array of PEP 508 strings, starting at the position of its key.
+func (p *parser) addRequirements(deps []interface{}, position toml.Position, group string, dev bool) {
+	line := position.Line
+
+	for _, value := range deps {
+		// Skip entries like {include-group = "test"}
+		requirement, ok := value.(string)
+		if !ok {
+			continue
+		}
+
+		name, specifier, ok := splitRequirement(requirement)
+		if !ok {
+			continue
+		}
+
+		if l := p.findLine(requirement, line); l != 0 {
+			line = l
+		}
+
+		p.add(name, cleanVersion(specifier), specifier, group, dev, line)
+	}
+}
+
+func (p *parser) add(name string, version string, constraint string, group string, dev bool, line int) {
+	rawLine := ""
+	if line > 0 && line <= len(p.lines) {
+		rawLine = strings.TrimSpace(p.lines[line-1])
+	}
+
+	p.dependencies = append(p.dependencies, types.Dependency{
+		Manager:    types.Pyproject,
+		Name:       name,
+		Version:    version,
+		Constraint: constraint,
+		Dev:        dev,
+		Group:      group,
+		Definition: types.Definition{
+			Path:    p.path,
+			RawLine: rawLine,
+			Line:    line,
+		},
+	})
+}
+
+// findLine returns the first line starting from `from` that contains the quoted value.
+func (p *parser) findLine(value string, from int) int {
+	if from < 1 {
+		from = 1
+	}
+
+	for i := from - 1; i < len(p.lines); i++ {
+		if strings.Contains(p.lines[i], `"`+value+`"`) || strings.Contains(p.lines[i], `'`+value+`'`) {
+			return i + 1
+		}
+	}
+
+	return 0
+}
+
+// findKey returns the first line starting from `from` that assigns the given key.
+func (p *parser) findKey(key string, from int) int {
+	if from < 1 {
+		from = 1
+	}
+
+	for i := from - 1; i < len(p.lines); i++ {
+		trimmed := strings.TrimSpace(p.lines[i])
+
+		for _, k := range []string{key, `"` + key + `"`} {
+			if rest, ok := strings.CutPrefix(trimmed, k); ok && strings.HasPrefix(strings.TrimSpace(rest), "=") {
+				return i + 1
+			}
+		}
+	}
+
+	return 0
+}
+
+// ParseRequirement splits a PEP 508 requirement like `requests[socks] >=2.8.1 ; python_version < "3.8"`
+// into the package name and its version.
+func ParseRequirement(requirement string) (name string, version string, ok bool) {
+	name, specifier, ok := splitRequirement(requirement)
+	if !ok {
+		return "", "", false
+	}
+
+	return name, cleanVersion(specifier), true
+}
+
+// splitRequirement splits a PEP 508 requirement into the package name and its
+// version specifier, like ">=2.8.1, <3".
+func splitRequirement(requirement string) (name string, specifier string, ok bool) {
+	// Drop environment markers
+	if idx := strings.Index(requirement, ";"); idx != -1 {
+		requirement = requirement[:idx]
+	}
+
+	matches := requirementPattern.FindStringSubmatch(requirement)
+	if matches == nil {
+		return "", "", false
+	}
+
+	name = matches[1]
+	specifier = strings.TrimSpace(matches[3])
+
+	// Direct references (`name @ https://...`) don't have a version
+	if strings.HasPrefix(specifier, "@") {
+		return name, "", true
+	}
+
+	return name, strings.TrimSuffix(strings.TrimPrefix(specifier, "("), ")"), true
+}
+
 func sortDependencies(dependencies []types.Dependency) []types.Dependency {
-	sort.Slice(dependencies, func(i, j int) bool {
+	sort.SliceStable(dependencies, func(i, j int) bool {
 		return dependencies[i].Line < dependencies[j].Line
 	})
 	return dependencies
@@ -101,7 +304,22 @@ func cleanVersion(version string) string {
 	return version
 }
 
+// LockfilePath returns the path of the Poetry, PDM or uv lockfile next to the pyproject.toml file.
 func (Pyproject) LockfilePath(path string) (string, error) {
-	// We don't have a lockfile for pyproject.toml
-	return "", nil
+	for _, name := range lockfiles {
+		lockfilePath := filepath.Join(filepath.Dir(path), name)
+
+		if _, err := os.Stat(lockfilePath); err == nil {
+			return lockfilePath, nil
+		} else if !os.IsNotExist(err) {
+			return "", fmt.Errorf("error checking %s: %v", name, err)
+		}
+	}
+
+	return "", fmt.Errorf("lockfile not found")
+}
+
+// LockedPackages returns the packages of the Poetry, PDM or uv lockfile with their dependencies.
+func (Pyproject) LockedPackages(lockfilePath string) ([]types.LockedPackage, error) {
+	return pylock.Locked(lockfilePath, types.Pyproject)
 }
@@ -92,6 +92,13 @@ func TestPyproject_Dependencies(t *testing.T) {
 				Line:    21,
 			},
 		},
+		{Manager: types.Pyproject, Name: "pytest", Definition: types.Definition{RawLine: "\"pytest\",", Line: 42}},
+		{Manager: types.Pyproject, Name: "black", Definition: types.Definition{RawLine: "\"black\",", Line: 43}},
+		{Manager: types.Pyproject, Name: "mypy", Definition: types.Definition{RawLine: "\"mypy\",", Line: 44}},
+		{Manager: types.Pyproject, Name: "sphinx", Definition: types.Definition{RawLine: "\"sphinx\",", Line: 48}},
+		{Manager: types.Pyproject, Name: "sphinx-rtd-theme", Definition: types.Definition{RawLine: "\"sphinx-rtd-theme\",", Line: 49}},
+		{Manager: types.Pyproject, Name: "matplotlib", Version: "3.4", Definition: types.Definition{RawLine: "\"matplotlib >=3.4,<4.0\",", Line: 54}},
+		{Manager: types.Pyproject, Name: "seaborn", Definition: types.Definition{RawLine: "\"seaborn\",", Line: 55}},
 	}
 
 	assert.Equal(t, len(expected), len(dependencies))
@@ -103,3 +110,135 @@ func TestPyproject_Dependencies(t *testing.T) {
 		assert.Equal(t, exp.RawLine, dependencies[i].RawLine)
 	}
 }
+
+func TestPyproject_DependenciesPEP621(t *testing.T) {
+	manager := Pyproject{}
+	testPath := filepath.Join("testdata", "pep621", "pyproject.toml")
+
+	dependencies, err := manager.Dependencies(testPath)
+	assert.NoError(t, err)
+
+	expected := []types.Dependency{
+		{Name: "httpx", Version: "0.27.2", Dev: false, Group: "project.dependencies", Definition: types.Definition{RawLine: "\"httpx>=0.27.0\",", Line: 10}},
+		{Name: "requests", Version: "2.32.3", Dev: false, Group: "project.dependencies", Definition: types.Definition{RawLine: "\"requests[socks] ==2.32.3\",", Line: 11}},
+		{Name: "tomli", Version: "2.0.1", Dev: false, Group: "project.dependencies", Definition: types.Definition{RawLine: "\"tomli >=2.0.1 ; python_version < '3.11'\",", Line: 12}},
+		{Name: "attrs", Version: "", Dev: false, Group: "project.dependencies", Definition: types.Definition{RawLine: "\"attrs\",", Line: 13}},
+		{Name: "click", Version: "8.1", Dev: false, Group: "project.optional-dependencies.cli", Definition: types.Definition{RawLine: "\"click (>=8.1,<9)\",", Line: 18}},
+		{Name: "pytest", Version: "8.3.2", Dev: true, Group: "dependency-groups.test", Definition: types.Definition{RawLine: "\"pytest>=8.3.2\",", Line: 23}},
+		{Name: "ruff", Version: "0.6.1", Dev: true, Group: "dependency-groups.lint", Definition: types.Definition{RawLine: "lint = [\"ruff==0.6.1\"]", Line: 26}},
+		{Name: "mypy", Version: "1.11", Dev: true, Group: "tool.uv.dev-dependencies", Definition: types.Definition{RawLine: "\"mypy~=1.11\",", Line: 30}},
+		{Name: "mkdocs", Version: "1.6", Dev: true, Group: "tool.pdm.dev-dependencies.docs", Definition: types.Definition{RawLine: "docs = [\"mkdocs>=1.6\"]", Line: 34}},
+	}
+
+	assert.Equal(t, len(expected), len(dependencies))
+	for i, exp := range expected {
+		assert.Equal(t, types.Pyproject, dependencies[i].Manager)
+		assert.Equal(t, exp.Name, dependencies[i].Name)
+		assert.Equal(t, exp.Version, dependencies[i].Version)
+		assert.Equal(t, exp.Dev, dependencies[i].Dev)
+		assert.Equal(t, exp.Group, dependencies[i].Group)
+		assert.Equal(t, exp.Line, dependencies[i].Line)
+		assert.Equal(t, exp.RawLine, dependencies[i].RawLine)
+		assert.Equal(t, testPath, dependencies[i].Path)
+	}
+}
+
+func TestPyproject_DependenciesPoetry(t *testing.T) {
+	manager := Pyproject{}
+	testPath := filepath.Join("testdata", "poetry", "pyproject.toml")
+
+	dependencies, err := manager.Dependencies(testPath)
+	assert.NoError(t, err)
+
+	expected := []types.Dependency{
+		{Name: "django", Version: "5.1.2", Dev: false, Definition: types.Definition{RawLine: "django = \"^5.1\"", Line: 9}},
+		{Name: "celery", Version: "5.4.0", Dev: false, Definition: types.Definition{RawLine: "celery = { version = \"~5.4.0\", extras = [\"redis\"] }", Line: 10}},
+		{Name: "black", Version: "24.8.0", Dev: true, Definition: types.Definition{RawLine: "black = \"^24.8.0\"", Line: 14}},
+		{Name: "pytest", Version: "8.3.3", Dev: true, Definition: types.Definition{RawLine: "pytest = \">=8.0,<9.0\"", Line: 17}},
+	}
+
+	assert.Equal(t, len(expected), len(dependencies))
+	for i, exp := range expected {
+		assert.Equal(t, exp.Name, dependencies[i].Name)
+		assert.Equal(t, exp.Version, dependencies[i].Version)
+		assert.Equal(t, exp.Dev, dependencies[i].Dev)
+		assert.Equal(t, exp.Line, dependencies[i].Line)
+		assert.Equal(t, exp.RawLine, dependencies[i].RawLine)
+	}
+}
+
+func TestPyproject_LockfilePath(t *testing.T) {
+	manager := Pyproject{}
+	tests := []struct {
+		name        string
+		inputPath   string
+		expected    string
+		expectError bool
+	}{
+		{
+			name:      "poetry lockfile",
+			inputPath: filepath.Join("testdata", "poetry", "pyproject.toml"),
+			expected:  filepath.Join("testdata", "poetry", "poetry.lock"),
+		},
+		{
+			name:      "uv lockfile",
+			inputPath: filepath.Join("testdata", "pep621", "pyproject.toml"),
+			expected:  filepath.Join("testdata", "pep621", "uv.lock"),
+		},
+		{
+			name:        "missing lockfile",
+			inputPath:   filepath.Join("testdata", "pyproject.toml"),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lockfilePath, err := manager.LockfilePath(tt.inputPath)
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Empty(t, lockfilePath)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, lockfilePath)
+			}
+		})
+	}
+}
+
+func TestParseRequirement(t *testing.T) {
+	tests := []struct {
+		requirement string
+		name        string
+		version     string
+		ok          bool
+	}{
+		{"requests", "requests", "", true},
+		{"requests>=2.8.1", "requests", "2.8.1", true},
+		{"requests [security,tests] >= 2.8.1, == 2.8.*", "requests", "2.8.1", true},
+		{"name@ https://example.com/name.zip", "name", "", true},
+		{"urllib3 (>=1.21.1,<3)", "urllib3", "1.21.1", true},
+		{"tomli>=1.1.0; python_version < \"3.11\"", "tomli", "1.1.0", true},
+		{"", "", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.requirement, func(t *testing.T) {
+			name, version, ok := ParseRequirement(tt.requirement)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.name, name)
+			assert.Equal(t, tt.version, version)
+		})
+	}
+}
+
+func TestPyproject_LockedPackages(t *testing.T) {
+	packages, err := Pyproject{}.LockedPackages(filepath.Join("testdata", "pep621", "uv.lock"))
+	assert.NoError(t, err)
+
+	assert.Equal(t, []types.LockedPackage{
+		{Manager: types.Pyproject, Name: "example-project", Version: "0.1.0"},
+		{Manager: types.Pyproject, Name: "httpx", Version: "0.27.2"},
+		{Manager: types.Pyproject, Name: "ruff", Version: "0.6.1"},
+	}, packages)
+}
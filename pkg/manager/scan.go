@@ -1,20 +1,35 @@
 package manager
 
 import (
+	"fmt"
 	"io/fs"
 	"log"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/depshubhq/depshub/internal/config"
+	"github.com/depshubhq/depshub/pkg/manager/actions"
+	"github.com/depshubhq/depshub/pkg/manager/bundler"
 	"github.com/depshubhq/depshub/pkg/manager/cargo"
+	"github.com/depshubhq/depshub/pkg/manager/composer"
+	"github.com/depshubhq/depshub/pkg/manager/conda"
+	"github.com/depshubhq/depshub/pkg/manager/deno"
+	"github.com/depshubhq/depshub/pkg/manager/docker"
 	gomanager "github.com/depshubhq/depshub/pkg/manager/go"
+	"github.com/depshubhq/depshub/pkg/manager/gradle"
+	"github.com/depshubhq/depshub/pkg/manager/helm"
 	"github.com/depshubhq/depshub/pkg/manager/hex"
 	"github.com/depshubhq/depshub/pkg/manager/maven"
 	"github.com/depshubhq/depshub/pkg/manager/npm"
+	"github.com/depshubhq/depshub/pkg/manager/nuget"
 	"github.com/depshubhq/depshub/pkg/manager/pip"
+	"github.com/depshubhq/depshub/pkg/manager/pipfile"
+	"github.com/depshubhq/depshub/pkg/manager/pub"
 	"github.com/depshubhq/depshub/pkg/manager/pyproject"
+	"github.com/depshubhq/depshub/pkg/manager/swiftpm"
+	"github.com/depshubhq/depshub/pkg/manager/terraform"
 	"github.com/depshubhq/depshub/pkg/types"
 	ignore "github.com/sabhiram/go-gitignore"
 )
@@ -36,6 +51,21 @@ func New(config config.Config) scanner {
 			hex.Hex{},
 			pyproject.Pyproject{},
 			maven.Maven{},
+			pipfile.Pipfile{},
+			gradle.Gradle{},
+			bundler.Bundler{},
+			composer.Composer{},
+			nuget.NuGet{},
+			actions.Actions{},
+			docker.Docker{},
+			terraform.Terraform{},
+			pub.Pub{},
+			swiftpm.SwiftPM{},
+			helm.Helm{},
+			conda.Conda{},
+			deno.Deno{},
+			// Reads any YAML file, so it must come after the other managers
+			docker.Kubernetes{},
 		},
 	}
 }
@@ -83,11 +113,23 @@ func (s scanner) Scan(pathToScan string) ([]types.Manifest, error) {
 		lockfilePath, err := s.lockfilePath(path)
 
 		if err == nil {
+			// The dependencies are still checked when the lockfile can't be read
+			packages, err := s.lockedPackages(path, lockfilePath)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error reading lockfile %s: %s\n", lockfilePath, err)
+			}
+
 			lockfile = &types.Lockfile{
-				Path: lockfilePath,
+				Path:     lockfilePath,
+				Packages: packages,
 			}
 		}
 
+		var graph *types.Graph
+		if lockfile != nil && len(lockfile.Packages) > 0 {
+			graph = types.NewGraph(dependencies, lockfile.Packages)
+		}
+
 		// log.Println("Dependencies: ", dependencies)
 		if len(dependencies) != 0 {
 			manifests = append(manifests, types.Manifest{
@@ -95,6 +137,7 @@ func (s scanner) Scan(pathToScan string) ([]types.Manifest, error) {
 				Path:         path,
 				Dependencies: dependencies,
 				Lockfile:     lockfile,
+				Graph:        graph,
 			})
 		}
 
@@ -104,12 +147,14 @@ func (s scanner) Scan(pathToScan string) ([]types.Manifest, error) {
 	return manifests, err
 }
 
+// UniqueDependencies returns the dependencies of the manifests once per
+// package and version, since some sources fetch information per version.
 func (s scanner) UniqueDependencies(manifests []types.Manifest) (result []types.Dependency) {
 	uniqueDependencies := make(map[string]types.Dependency)
 
 	for _, manifest := range manifests {
 		for _, dep := range manifest.Dependencies {
-			uniqueDependencies[dep.Name] = dep
+			uniqueDependencies[dep.Registry+types.PackageKey(dep.Manager, dep.Name)+"@"+dep.Version] = dep
 		}
 	}
 
@@ -146,6 +191,34 @@ func (s scanner) lockfilePath(path string) (string, error) {
 	return "", nil
 }
 
+// lockedPackages returns the packages of the lockfile, sorted by ID, when the
+// manager of the manifest reads them.
+func (s scanner) lockedPackages(path string, lockfilePath string) ([]types.LockedPackage, error) {
+	for _, m := range s.managers {
+		if !m.Managed(path) {
+			continue
+		}
+
+		reader, ok := m.(LockfileReader)
+		if !ok {
+			return nil, nil
+		}
+
+		packages, err := reader.LockedPackages(lockfilePath)
+		if err != nil {
+			return nil, err
+		}
+
+		slices.SortFunc(packages, func(a, b types.LockedPackage) int {
+			return strings.Compare(a.ID(), b.ID())
+		})
+
+		return packages, nil
+	}
+
+	return nil, nil
+}
+
 func (s *scanner) loadGitignore(path string) error {
 	// Ignore if gitignore is already loaded
 	if s.gitignore != nil {
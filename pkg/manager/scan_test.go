@@ -0,0 +1,27 @@
+package manager
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/depshubhq/depshub/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScan_InvalidLockfile(t *testing.T) {
+	dir := t.TempDir()
+
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "Cargo.toml"), []byte("[package]\nname = \"app\"\n\n[dependencies]\nserde = \"1.0\"\n"), 0o644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "Cargo.lock"), []byte("[[package]\nname = "), 0o644))
+
+	manifests, err := New(config.Config{}).Scan(dir)
+	assert.NoError(t, err)
+
+	// The manifest is still checked without the packages of its lockfile
+	assert.Len(t, manifests, 1)
+	assert.Equal(t, "serde", manifests[0].Dependencies[0].Name)
+	assert.Equal(t, filepath.Join(dir, "Cargo.lock"), manifests[0].Lockfile.Path)
+	assert.Nil(t, manifests[0].Lockfile.Packages)
+	assert.Nil(t, manifests[0].Graph)
+}
@@ -0,0 +1,74 @@
+package swiftpm
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Pin is a package resolved in Package.resolved.
+type Pin struct {
+	Identity string
+	Location string
+	Version  string
+	Revision string
+	Branch   string
+}
+
+type pinState struct {
+	Version  string `json:"version"`
+	Revision string `json:"revision"`
+	Branch   string `json:"branch"`
+}
+
+// Package.resolved version 2 and 3
+type resolved struct {
+	Pins []struct {
+		Identity string   `json:"identity"`
+		Location string   `json:"location"`
+		State    pinState `json:"state"`
+	} `json:"pins"`
+	// Package.resolved version 1
+	Object struct {
+		Pins []struct {
+			Package       string   `json:"package"`
+			RepositoryURL string   `json:"repositoryURL"`
+			State         pinState `json:"state"`
+		} `json:"pins"`
+	} `json:"object"`
+}
+
+// ReadLockfile parses Package.resolved and returns the pins keyed by package name.
+func ReadLockfile(path string) (map[string]Pin, error) {
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var lock resolved
+	if err := json.Unmarshal(file, &lock); err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+
+	pins := make(map[string]Pin)
+
+	add := func(identity, location string, state pinState) {
+		pins[PackageName(location)] = Pin{
+			Identity: identity,
+			Location: location,
+			Version:  state.Version,
+			Revision: state.Revision,
+			Branch:   state.Branch,
+		}
+	}
+
+	for _, pin := range lock.Pins {
+		add(pin.Identity, pin.Location, pin.State)
+	}
+
+	for _, pin := range lock.Object.Pins {
+		add(pin.Package, pin.RepositoryURL, pin.State)
+	}
+
+	return pins, nil
+}
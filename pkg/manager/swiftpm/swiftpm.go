@@ -0,0 +1,169 @@
+package swiftpm
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+var (
+	urlPattern = regexp.MustCompile(`url\s*:\s*"([^"]+)"`)
+	// Matches `from: "1.0.0"`, `exact: "1.0.0"`, `.upToNextMajor(from: "1.0.0")` and `.exact("1.0.0")`
+	versionPattern = regexp.MustCompile(`(?:\b(?:from|exact)\s*:|\.exact\()\s*"([^"]+)"`)
+	// Matches `"1.0.0"..<"2.0.0"` and `"1.0.0"..."1.2.0"`
+	rangePattern = regexp.MustCompile(`"([^"]+)"\s*\.\.[.<]`)
+	// Packages pinned to a branch or a commit don't have a version
+	unversionedPattern = regexp.MustCompile(`(?:branch|revision)\s*:|\.branch\(|\.revision\(`)
+)
+
+type SwiftPM struct{}
+
+func (SwiftPM) GetType() types.ManagerType {
+	return types.SwiftPM
+}
+
+func (SwiftPM) Managed(path string) bool {
+	return filepath.Base(path) == "Package.swift"
+}
+
+func (SwiftPM) Dependencies(path string) ([]types.Dependency, error) {
+	var dependencies []types.Dependency
+
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	source := string(file)
+	lines := strings.Split(source, "\n")
+
+	for _, declaration := range packageDeclarations(source) {
+		matches := urlPattern.FindStringSubmatch(declaration.arguments)
+		if matches == nil || unversionedPattern.MatchString(declaration.arguments) {
+			continue
+		}
+
+		var version string
+		if matches := versionPattern.FindStringSubmatch(declaration.arguments); matches != nil {
+			version = matches[1]
+		} else if matches := rangePattern.FindStringSubmatch(declaration.arguments); matches != nil {
+			version = matches[1]
+		}
+
+		dependencies = append(dependencies, types.Dependency{
+			Manager: types.SwiftPM,
+			Name:    PackageName(matches[1]),
+			Version: version,
+			Definition: types.Definition{
+				Path:    path,
+				RawLine: strings.TrimSpace(lines[declaration.line-1]),
+				Line:    declaration.line,
+			},
+		})
+	}
+
+	// Use the versions from Package.resolved when there is one, or keep the declared ones
+	if lockfilePath, err := (SwiftPM{}).LockfilePath(path); err == nil {
+		pins, err := ReadLockfile(lockfilePath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading lockfile %s: %s\n", lockfilePath, err)
+		}
+
+		for i, dep := range dependencies {
+			if pin, ok := pins[dep.Name]; ok && pin.Version != "" {
+				dependencies[i].Version = pin.Version
+			}
+		}
+	}
+
+	return dependencies, nil
+}
+
+// declaration is a `.package(...)` call of the package manifest.
+type declaration struct {
+	arguments string
+	line      int
+}
+
+// packageDeclarations finds the `.package(...)` calls of Package.swift, which may
+// span multiple lines. Comments aren't removed, so commented out calls are skipped
+// only when the whole line is a comment.
+func packageDeclarations(source string) []declaration {
+	var declarations []declaration
+
+	lines := strings.Split(source, "\n")
+
+	for i, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "//") {
+			continue
+		}
+
+		start := strings.Index(line, ".package(")
+		if start == -1 {
+			continue
+		}
+
+		// Collect the arguments until the parenthesis is closed
+		var arguments strings.Builder
+		depth := 0
+		rest := line[start+len(".package"):]
+
+	collect:
+		for j := i; j < len(lines); j++ {
+			if j > i {
+				rest = lines[j]
+			}
+
+			for _, c := range rest {
+				switch c {
+				case '(':
+					depth++
+				case ')':
+					depth--
+				}
+
+				arguments.WriteRune(c)
+
+				if depth == 0 {
+					break collect
+				}
+			}
+
+			arguments.WriteRune('\n')
+		}
+
+		declarations = append(declarations, declaration{arguments: arguments.String(), line: i + 1})
+	}
+
+	return declarations
+}
+
+// PackageName returns the location of a package without the scheme and the .git
+// suffix, like github.com/apple/swift-argument-parser.
+func PackageName(url string) string {
+	name := url
+	if _, after, found := strings.Cut(name, "://"); found {
+		name = after
+	}
+
+	// SSH locations like git@github.com:apple/swift-nio.git
+	if user, after, found := strings.Cut(name, "@"); found && !strings.Contains(user, "/") {
+		name = strings.Replace(after, ":", "/", 1)
+	}
+
+	return strings.ToLower(strings.TrimSuffix(strings.TrimSuffix(name, "/"), ".git"))
+}
+
+func (SwiftPM) LockfilePath(path string) (string, error) {
+	lockfilePath := filepath.Join(filepath.Dir(path), "Package.resolved")
+
+	if _, err := os.Stat(lockfilePath); os.IsNotExist(err) {
+		return "", fmt.Errorf("lockfile not found")
+	}
+
+	return lockfilePath, nil
+}
@@ -0,0 +1,179 @@
+package swiftpm
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/depshubhq/depshub/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSwiftPM_GetType(t *testing.T) {
+	manager := SwiftPM{}
+	assert.Equal(t, types.SwiftPM, manager.GetType())
+}
+
+func TestSwiftPM_Managed(t *testing.T) {
+	manager := SwiftPM{}
+	tests := []struct {
+		name     string
+		path     string
+		expected bool
+	}{
+		{name: "package manifest", path: "path/to/Package.swift", expected: true},
+		{name: "lockfile", path: "path/to/Package.resolved", expected: false},
+		{name: "other swift file", path: "path/to/Sources/App.swift", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := manager.Managed(tt.path)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestSwiftPM_Dependencies(t *testing.T) {
+	manager := SwiftPM{}
+
+	dependency := func(path, name, version string, line int, rawLine string) types.Dependency {
+		return types.Dependency{
+			Manager: types.SwiftPM,
+			Name:    name,
+			Version: version,
+			Definition: types.Definition{
+				Path:    path,
+				RawLine: rawLine,
+				Line:    line,
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		path     string
+		expected func(path string) []types.Dependency
+	}{
+		{
+			name: "with lockfile",
+			path: filepath.Join("testdata", "Package.swift"),
+			expected: func(path string) []types.Dependency {
+				return []types.Dependency{
+					dependency(path, "github.com/alamofire/alamofire", "5.10.1", 11,
+						`.package(url: "https://github.com/Alamofire/Alamofire.git", from: "5.8.0"),`),
+					dependency(path, "github.com/apple/swift-argument-parser", "1.3.1", 12,
+						`.package(url: "https://github.com/apple/swift-argument-parser", .upToNextMinor(from: "1.3.0")),`),
+					dependency(path, "github.com/pointfreeco/swift-snapshot-testing", "1.15.4", 13, ".package("),
+					dependency(path, "github.com/apple/swift-log", "1.6.1", 17,
+						`.package(url: "git@github.com:apple/swift-log.git", "1.5.0"..<"2.0.0"),`),
+				}
+			},
+		},
+		{
+			name: "without lockfile",
+			path: filepath.Join("testdata", "nolock", "Package.swift"),
+			expected: func(path string) []types.Dependency {
+				return []types.Dependency{
+					dependency(path, "github.com/nicklockwood/swiftformat", "0.53.0", 7,
+						`.package(name: "SwiftFormat", url: "https://github.com/nicklockwood/SwiftFormat", .exact("0.53.0")),`),
+				}
+			},
+		},
+		{
+			// The declared versions are kept when Package.resolved can't be read
+			name: "with invalid lockfile",
+			path: filepath.Join("testdata", "invalidlock", "Package.swift"),
+			expected: func(path string) []types.Dependency {
+				return []types.Dependency{
+					dependency(path, "github.com/nicklockwood/swiftformat", "0.53.0", 7,
+						`.package(name: "SwiftFormat", url: "https://github.com/nicklockwood/SwiftFormat", .exact("0.53.0")),`),
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dependencies, err := manager.Dependencies(tt.path)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected(tt.path), dependencies)
+		})
+	}
+}
+
+func TestReadLockfile(t *testing.T) {
+	t.Run("version 3", func(t *testing.T) {
+		pins, err := ReadLockfile(filepath.Join("testdata", "Package.resolved"))
+		assert.NoError(t, err)
+		assert.Len(t, pins, 5)
+		assert.Equal(t, Pin{
+			Identity: "feature-flags",
+			Location: "https://github.com/example/feature-flags.git",
+			Revision: "8a1b2c3d4e5f60718293a4b5c6d7e8f901234567",
+			Branch:   "main",
+		}, pins["github.com/example/feature-flags"])
+	})
+
+	t.Run("version 1", func(t *testing.T) {
+		pins, err := ReadLockfile(filepath.Join("testdata", "v1", "Package.resolved"))
+		assert.NoError(t, err)
+		assert.Equal(t, Pin{
+			Identity: "Kingfisher",
+			Location: "https://github.com/onevcat/Kingfisher.git",
+			Version:  "7.10.2",
+			Revision: "3ec0ab0bca4feb56e8b33e289c9496e89059dd08",
+		}, pins["github.com/onevcat/kingfisher"])
+	})
+}
+
+func TestPackageName(t *testing.T) {
+	tests := []struct {
+		url      string
+		expected string
+	}{
+		{url: "https://github.com/Alamofire/Alamofire.git", expected: "github.com/alamofire/alamofire"},
+		{url: "https://github.com/apple/swift-argument-parser/", expected: "github.com/apple/swift-argument-parser"},
+		{url: "git@github.com:apple/swift-log.git", expected: "github.com/apple/swift-log"},
+		{url: "ssh://git@gitlab.example.com/mobile/core.git", expected: "gitlab.example.com/mobile/core"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			assert.Equal(t, tt.expected, PackageName(tt.url))
+		})
+	}
+}
+
+func TestSwiftPM_LockfilePath(t *testing.T) {
+	manager := SwiftPM{}
+	tests := []struct {
+		name        string
+		inputPath   string
+		expected    string
+		expectError bool
+	}{
+		{
+			name:      "existing lockfile",
+			inputPath: filepath.Join("testdata", "Package.swift"),
+			expected:  filepath.Join("testdata", "Package.resolved"),
+		},
+		{
+			name:        "missing lockfile",
+			inputPath:   filepath.Join("testdata", "nolock", "Package.swift"),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lockfilePath, err := manager.LockfilePath(tt.inputPath)
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Empty(t, lockfilePath)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, lockfilePath)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+// swift-tools-version:5.9
+import PackageDescription
+
+let package = Package(
+    name: "MobileKit",
+    platforms: [.iOS(.v16), .macOS(.v13)],
+    products: [
+        .library(name: "MobileKit", targets: ["MobileKit"]),
+    ],
+    dependencies: [
+        .package(url: "https://github.com/Alamofire/Alamofire.git", from: "5.8.0"),
+        .package(url: "https://github.com/apple/swift-argument-parser", .upToNextMinor(from: "1.3.0")),
+        .package(
+            url: "https://github.com/pointfreeco/swift-snapshot-testing.git",
+            exact: "1.15.4"
+        ),
+        .package(url: "git@github.com:apple/swift-log.git", "1.5.0"..<"2.0.0"),
+        .package(url: "https://github.com/example/feature-flags.git", branch: "main"),
+        // .package(url: "https://github.com/example/disabled.git", from: "1.0.0"),
+        .package(path: "../DesignSystem"),
+    ],
+    targets: [
+        .target(name: "MobileKit", dependencies: [
+            .product(name: "Alamofire", package: "Alamofire"),
+            .product(name: "Logging", package: "swift-log"),
+        ]),
+    ]
+)
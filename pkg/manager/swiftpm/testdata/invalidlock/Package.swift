@@ -0,0 +1,10 @@
+// swift-tools-version:5.7
+import PackageDescription
+
+let package = Package(
+    name: "Tools",
+    dependencies: [
+        .package(name: "SwiftFormat", url: "https://github.com/nicklockwood/SwiftFormat", .exact("0.53.0")),
+    ],
+    targets: [.executableTarget(name: "Tools", dependencies: ["SwiftFormat"])]
+)
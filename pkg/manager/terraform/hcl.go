@@ -0,0 +1,190 @@
+package terraform
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	// Matches `module "vpc" {` and `required_providers {`
+	blockPattern = regexp.MustCompile(`^([\w-]+)((?:\s+"[^"]*")*)\s*\{$`)
+	// Matches `aws = {`
+	objectPattern = regexp.MustCompile(`^([\w-]+)\s*=\s*\{$`)
+	// Matches `aws = { source = "hashicorp/aws", version = "~> 5.0" }`
+	inlineObjectPattern = regexp.MustCompile(`^([\w-]+)\s*=\s*\{(.*)\}$`)
+	attributePattern    = regexp.MustCompile(`^([\w-]+)\s*=\s*(.+)$`)
+	stringPattern       = regexp.MustCompile(`([\w-]+)\s*=\s*"([^"]*)"`)
+	labelPattern        = regexp.MustCompile(`"([^"]*)"`)
+)
+
+// block is a block or an object of an HCL file, like `module "vpc" { ... }`.
+type block struct {
+	kind       string
+	labels     []string
+	line       int
+	attributes map[string]string
+	// The lines of the string attributes
+	lines map[string]int
+}
+
+func newBlock(kind string, labels []string, line int) block {
+	return block{kind: kind, labels: labels, line: line, attributes: map[string]string{}, lines: map[string]int{}}
+}
+
+// visitor is called with the blocks of the file once they are closed.
+// path contains the kinds of the enclosing blocks.
+type visitor func(path []string, b block)
+
+// parseHCL walks through the blocks and string attributes of an HCL file. It
+// only understands the subset of HCL used by Terraform configuration blocks.
+func parseHCL(source string, visit visitor) {
+	var stack []block
+
+	pop := func() {
+		if len(stack) == 0 {
+			return
+		}
+
+		b := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		path := make([]string, len(stack))
+		for i, parent := range stack {
+			path[i] = parent.kind
+		}
+		visit(path, b)
+	}
+
+	inComment := false
+
+	for i, rawLine := range strings.Split(source, "\n") {
+		line := rawLine
+
+		// Skip block comments
+		if inComment {
+			end := strings.Index(line, "*/")
+			if end == -1 {
+				continue
+			}
+			line = line[end+2:]
+			inComment = false
+		}
+
+		if start := strings.Index(line, "/*"); start != -1 && !inString(line, start) {
+			if end := strings.Index(line[start:], "*/"); end != -1 {
+				line = line[:start] + line[start+end+2:]
+			} else {
+				line = line[:start]
+				inComment = true
+			}
+		}
+
+		line = strings.TrimSpace(stripComment(line))
+		if line == "" {
+			continue
+		}
+
+		if matches := blockPattern.FindStringSubmatch(line); matches != nil {
+			var labels []string
+			for _, label := range labelPattern.FindAllStringSubmatch(matches[2], -1) {
+				labels = append(labels, label[1])
+			}
+
+			stack = append(stack, newBlock(matches[1], labels, i+1))
+			continue
+		}
+
+		if matches := objectPattern.FindStringSubmatch(line); matches != nil {
+			stack = append(stack, newBlock(matches[1], nil, i+1))
+			continue
+		}
+
+		if matches := inlineObjectPattern.FindStringSubmatch(line); matches != nil {
+			b := newBlock(matches[1], nil, i+1)
+			for _, attribute := range stringPattern.FindAllStringSubmatch(matches[2], -1) {
+				b.attributes[attribute[1]] = attribute[2]
+				b.lines[attribute[1]] = i + 1
+			}
+
+			stack = append(stack, b)
+			pop()
+			continue
+		}
+
+		if line == "}" {
+			pop()
+			continue
+		}
+
+		if matches := attributePattern.FindStringSubmatch(line); matches != nil && len(stack) > 0 {
+			value := strings.TrimSpace(matches[2])
+			if len(value) >= 2 && value[0] == '"' && value[len(value)-1] == '"' {
+				stack[len(stack)-1].attributes[matches[1]] = value[1 : len(value)-1]
+				stack[len(stack)-1].lines[matches[1]] = i + 1
+			}
+		}
+
+		// Keep track of other braces, like in `tags = merge(local.tags, {`
+		for depth := braces(line); depth != 0; {
+			if depth > 0 {
+				stack = append(stack, newBlock("", nil, i+1))
+				depth--
+			} else {
+				pop()
+				depth++
+			}
+		}
+	}
+}
+
+// braces returns the number of opened minus closed braces outside of strings.
+func braces(line string) int {
+	depth := 0
+
+	for i, c := range line {
+		if inString(line, i) {
+			continue
+		}
+
+		switch c {
+		case '{':
+			depth++
+		case '}':
+			depth--
+		}
+	}
+
+	return depth
+}
+
+// inString checks if the byte at index i is inside a string.
+func inString(line string, i int) bool {
+	quoted := false
+
+	for j := 0; j < i && j < len(line); j++ {
+		if line[j] == '\\' {
+			j++
+			continue
+		}
+		if line[j] == '"' {
+			quoted = !quoted
+		}
+	}
+
+	return quoted
+}
+
+// stripComment removes a trailing # or // comment that isn't part of a string.
+func stripComment(line string) string {
+	for i := 0; i < len(line); i++ {
+		if line[i] != '#' && !strings.HasPrefix(line[i:], "//") {
+			continue
+		}
+
+		if !inString(line, i) {
+			return line[:i]
+		}
+	}
+
+	return line
+}
@@ -0,0 +1,24 @@
+package terraform
+
+import (
+	"os"
+)
+
+// ReadLockfile parses .terraform.lock.hcl and returns the locked version of
+// each provider, keyed like the provider names of the dependencies.
+func ReadLockfile(path string) (map[string]string, error) {
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	locked := make(map[string]string)
+
+	parseHCL(string(file), func(path []string, b block) {
+		if len(path) == 0 && b.kind == "provider" && len(b.labels) == 1 && b.attributes["version"] != "" {
+			locked[providerName(b.labels[0])] = b.attributes["version"]
+		}
+	})
+
+	return locked, nil
+}
@@ -0,0 +1,150 @@
+package terraform
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+// The host of providers and modules without an explicit registry
+const DefaultRegistry = "registry.terraform.io"
+
+type Terraform struct{}
+
+func (Terraform) GetType() types.ManagerType {
+	return types.Terraform
+}
+
+func (Terraform) Managed(path string) bool {
+	return filepath.Ext(path) == ".tf"
+}
+
+func (Terraform) Dependencies(path string) ([]types.Dependency, error) {
+	var dependencies []types.Dependency
+
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := strings.Split(string(file), "\n")
+
+	add := func(name, version string, line int) {
+		dependencies = append(dependencies, types.Dependency{
+			Manager: types.Terraform,
+			Name:    name,
+			Version: cleanVersion(version),
+			Definition: types.Definition{
+				Path:    path,
+				RawLine: strings.TrimSpace(lines[line-1]),
+				Line:    line,
+			},
+		})
+	}
+
+	parseHCL(string(file), func(path []string, b block) {
+		switch {
+		case slices.Equal(path, []string{"terraform"}) && b.kind == "required_providers":
+			// Providers declared with a version constraint like `aws = "~> 3.0"`
+			for name, version := range b.attributes {
+				add(providerName(name), version, b.lines[name])
+			}
+		case slices.Equal(path, []string{"terraform", "required_providers"}):
+			source := b.attributes["source"]
+			if source == "" {
+				source = b.kind
+			}
+
+			add(providerName(source), b.attributes["version"], b.line)
+		case len(path) == 0 && b.kind == "module" && len(b.labels) == 1:
+			if name, ok := moduleName(b.attributes["source"]); ok {
+				add(name, b.attributes["version"], b.line)
+			}
+		}
+	})
+
+	// Some of the rules require the original order of dependencies
+	// Sort dependencies by line number
+	sort.Slice(dependencies, func(i, j int) bool {
+		return dependencies[i].Line < dependencies[j].Line
+	})
+
+	// Use the versions from .terraform.lock.hcl when there is one, or keep the declared constraints
+	if lockfilePath, err := (Terraform{}).LockfilePath(path); err == nil {
+		lock, err := ReadLockfile(lockfilePath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error reading lockfile %s: %s\n", lockfilePath, err)
+		}
+
+		for i, dep := range dependencies {
+			if version, ok := lock[dep.Name]; ok {
+				dependencies[i].Version = version
+			}
+		}
+	}
+
+	return dependencies, nil
+}
+
+// providerName returns the source address of a provider without the default registry host.
+func providerName(source string) string {
+	source = strings.ToLower(strings.TrimPrefix(source, DefaultRegistry+"/"))
+
+	// Providers without a namespace belong to HashiCorp
+	if !strings.Contains(source, "/") {
+		source = "hashicorp/" + source
+	}
+
+	return source
+}
+
+// moduleName returns the address of registry modules like terraform-aws-modules/vpc/aws.
+// Modules from git, local paths or archives can't be checked.
+func moduleName(source string) (string, bool) {
+	if source == "" || strings.Contains(source, "::") || strings.HasPrefix(source, ".") ||
+		strings.HasPrefix(source, "/") || strings.Contains(source, "://") ||
+		strings.HasPrefix(source, "github.com/") || strings.HasPrefix(source, "bitbucket.org/") {
+		return "", false
+	}
+
+	// Remove the subdirectory of modules like terraform-aws-modules/iam/aws//modules/iam-role
+	if idx := strings.Index(source, "//"); idx != -1 {
+		source = source[:idx]
+	}
+
+	parts := strings.Split(strings.TrimPrefix(source, DefaultRegistry+"/"), "/")
+
+	switch {
+	case len(parts) == 3:
+		return strings.Join(parts, "/"), true
+	case len(parts) == 4 && strings.Contains(parts[0], "."):
+		return strings.Join(parts, "/"), true
+	}
+
+	return "", false
+}
+
+func (Terraform) LockfilePath(path string) (string, error) {
+	lockfilePath := filepath.Join(filepath.Dir(path), ".terraform.lock.hcl")
+
+	if _, err := os.Stat(lockfilePath); os.IsNotExist(err) {
+		return "", fmt.Errorf("lockfile not found")
+	}
+
+	return lockfilePath, nil
+}
+
+// Returns the version without any prefix or suffix
+func cleanVersion(version string) string {
+	// Use the first constraint of ">= 4.0, < 6.0"
+	if idx := strings.Index(version, ","); idx != -1 {
+		version = version[:idx]
+	}
+
+	return strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(version), "v~>=<!"))
+}
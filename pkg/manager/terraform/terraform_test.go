@@ -0,0 +1,178 @@
+package terraform
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/depshubhq/depshub/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTerraform_GetType(t *testing.T) {
+	manager := Terraform{}
+	assert.Equal(t, types.Terraform, manager.GetType())
+}
+
+func TestTerraform_Managed(t *testing.T) {
+	manager := Terraform{}
+	tests := []struct {
+		name     string
+		path     string
+		expected bool
+	}{
+		{name: "configuration", path: "path/to/main.tf", expected: true},
+		{name: "lockfile", path: "path/to/.terraform.lock.hcl", expected: false},
+		{name: "variables file", path: "path/to/prod.tfvars", expected: false},
+		{name: "JSON configuration", path: "path/to/main.tf.json", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := manager.Managed(tt.path)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestTerraform_Dependencies(t *testing.T) {
+	manager := Terraform{}
+
+	dependency := func(path, name, version string, line int, rawLine string) types.Dependency {
+		return types.Dependency{
+			Manager: types.Terraform,
+			Name:    name,
+			Version: version,
+			Definition: types.Definition{
+				Path:    path,
+				RawLine: rawLine,
+				Line:    line,
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		path     string
+		expected func(path string) []types.Dependency
+	}{
+		{
+			name: "with lockfile",
+			path: filepath.Join("testdata", "main.tf"),
+			expected: func(path string) []types.Dependency {
+				return []types.Dependency{
+					dependency(path, "hashicorp/aws", "5.57.0", 5, "aws = {"),
+					dependency(path, "cloudflare/cloudflare", "4.38.0", 9, `cloudflare = { source = "cloudflare/cloudflare", version = ">= 4.0, < 5.0" }`),
+					dependency(path, "hashicorp/random", "3.6.2", 10, "random = {"),
+					dependency(path, "terraform-aws-modules/vpc/aws", "5.8.1", 23, `module "vpc" {`),
+				}
+			},
+		},
+		{
+			name: "without lockfile",
+			path: filepath.Join("testdata", "modules", "network", "main.tf"),
+			expected: func(path string) []types.Dependency {
+				return []types.Dependency{
+					dependency(path, "hashicorp/aws", "4.67", 3, `aws = "~> 4.67"`),
+					dependency(path, "app.terraform.io/example-corp/subnets/aws", "1.2.0", 7, `module "subnets" {`),
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dependencies, err := manager.Dependencies(tt.path)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected(tt.path), dependencies)
+		})
+	}
+}
+
+func TestReadLockfile(t *testing.T) {
+	locked, err := ReadLockfile(filepath.Join("testdata", ".terraform.lock.hcl"))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"cloudflare/cloudflare": "4.38.0",
+		"hashicorp/aws":         "5.57.0",
+		"hashicorp/random":      "3.6.2",
+	}, locked)
+}
+
+func TestTerraform_LockfilePath(t *testing.T) {
+	manager := Terraform{}
+	tests := []struct {
+		name        string
+		inputPath   string
+		expected    string
+		expectError bool
+	}{
+		{
+			name:      "root module",
+			inputPath: filepath.Join("testdata", "main.tf"),
+			expected:  filepath.Join("testdata", ".terraform.lock.hcl"),
+		},
+		{
+			name:        "child module",
+			inputPath:   filepath.Join("testdata", "modules", "network", "main.tf"),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lockfilePath, err := manager.LockfilePath(tt.inputPath)
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Empty(t, lockfilePath)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, lockfilePath)
+			}
+		})
+	}
+}
+
+func TestModuleName(t *testing.T) {
+	tests := []struct {
+		source   string
+		expected string
+		ok       bool
+	}{
+		{source: "terraform-aws-modules/vpc/aws", expected: "terraform-aws-modules/vpc/aws", ok: true},
+		{source: "registry.terraform.io/terraform-aws-modules/vpc/aws", expected: "terraform-aws-modules/vpc/aws", ok: true},
+		{source: "app.terraform.io/example-corp/subnets/aws", expected: "app.terraform.io/example-corp/subnets/aws", ok: true},
+		{source: "./modules/network"},
+		{source: "github.com/cloudposse/terraform-null-label"},
+		{source: "git::https://example.com/consul.git"},
+		{source: "terraform-aws-modules/iam/aws//modules/iam-role", expected: "terraform-aws-modules/iam/aws", ok: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			name, ok := moduleName(tt.source)
+			assert.Equal(t, tt.expected, name)
+			assert.Equal(t, tt.ok, ok)
+		})
+	}
+}
+
+func TestCleanVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  string
+		expected string
+	}{
+		{name: "exact version", version: "5.8.1", expected: "5.8.1"},
+		{name: "pessimistic constraint", version: "~> 5.0", expected: "5.0"},
+		{name: "range", version: ">= 4.0, < 5.0", expected: "4.0"},
+		{name: "exact match", version: "= 1.2.0", expected: "1.2.0"},
+		{name: "any", version: "", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := cleanVersion(tt.version)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
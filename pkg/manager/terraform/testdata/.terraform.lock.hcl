@@ -0,0 +1,26 @@
+# This file is maintained automatically by "terraform init".
+# Manual edits may be lost in future updates.
+
+provider "registry.terraform.io/cloudflare/cloudflare" {
+  version     = "4.38.0"
+  constraints = ">= 4.0.0, < 5.0.0"
+  hashes = [
+    "h1:9RDYqUtnzmN7FBIHjUEx/QJXhlZP+9hmWpVXTpSqLJE=",
+  ]
+}
+
+provider "registry.terraform.io/hashicorp/aws" {
+  version     = "5.57.0"
+  constraints = "~> 5.0"
+  hashes = [
+    "h1:Ja8kQzmfe8BBqa9pEt2OifB1Ar1q1u1mCHhtgtu+ojE=",
+    "zh:0f2a1c4e8b0b4b5e6a5e0f0c1d2e3f4a5b6c7d8e9f0a1b2c3d4e5f6a7b8c9d0e",
+  ]
+}
+
+provider "registry.terraform.io/hashicorp/random" {
+  version = "3.6.2"
+  hashes = [
+    "h1:5lstwe/L8AZS/CP0lil2nPvmbbjAu8kCaU/ogSGNbxk=",
+  ]
+}
@@ -0,0 +1,43 @@
+terraform {
+  required_version = ">= 1.5"
+
+  required_providers {
+    aws = {
+      source  = "hashicorp/aws"
+      version = "~> 5.0"
+    }
+    cloudflare = { source = "cloudflare/cloudflare", version = ">= 4.0, < 5.0" }
+    random = {
+      source = "registry.terraform.io/hashicorp/random"
+    }
+  }
+}
+
+/*
+module "disabled" {
+  source  = "terraform-aws-modules/s3-bucket/aws"
+  version = "4.1.0"
+}
+*/
+
+module "vpc" {
+  source  = "terraform-aws-modules/vpc/aws" # Registry module
+  version = "5.8.1"
+
+  tags = merge(local.tags, {
+    Name = "main"
+  })
+}
+
+module "network" {
+  source = "./modules/network"
+}
+
+module "consul" {
+  source = "git::https://example.com/consul.git?ref=v1.2.0"
+}
+
+resource "aws_instance" "web" {
+  ami           = "ami-0c55b159cbfafe1f0"
+  instance_type = "t3.micro"
+}
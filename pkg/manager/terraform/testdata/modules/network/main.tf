@@ -0,0 +1,14 @@
+terraform {
+  required_providers {
+    aws = "~> 4.67"
+  }
+}
+
+module "subnets" {
+  source  = "app.terraform.io/example-corp/subnets/aws"
+  version = ">= 1.2.0"
+}
+
+module "labels" {
+  source = "github.com/cloudposse/terraform-null-label"
+}
@@ -0,0 +1,239 @@
+package conda
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+const DefaultChannelURL = "https://conda.anaconda.org"
+
+// The defaults channel is hosted by Anaconda, separately from the other channels
+const defaultsURL = "https://repo.anaconda.com/pkgs/main"
+
+// CondaSource reads conda packages from the repodata.json of channels.
+type CondaSource struct {
+	// The host of named channels like conda-forge, or a mirror like https://repo.prefix.dev
+	ChannelURL string
+	// The platforms to read, like noarch and linux-64
+	Subdirs []string
+	Client  *http.Client
+
+	repodata *repodataCache
+}
+
+// NewCondaSource uses the channels of DEPSHUB_CONDA_CHANNEL_URL, if any, and
+// reads the packages of the current platform.
+func NewCondaSource() CondaSource {
+	return CondaSource{
+		ChannelURL: os.Getenv("DEPSHUB_CONDA_CHANNEL_URL"),
+		Subdirs:    []string{"noarch", platform()},
+		repodata:   &repodataCache{repodata: make(map[string]*cachedRepodata)},
+	}
+}
+
+type Record struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	License string `json:"license"`
+	// Milliseconds since the epoch, missing for old packages
+	Timestamp int64 `json:"timestamp"`
+}
+
+// https://docs.conda.io/projects/conda-build/en/stable/concepts/generating-index.html#repodata-json
+type Repodata struct {
+	Packages      map[string]Record `json:"packages"`
+	PackagesConda map[string]Record `json:"packages.conda"`
+}
+
+// FetchPackageData returns the versions of a package named like the dependencies
+// of the conda manager, conda-forge/numpy.
+func (s CondaSource) FetchPackageData(ctx context.Context, name string) (types.Package, error) {
+	idx := strings.LastIndex(name, "/")
+	if idx == -1 {
+		return types.Package{}, types.ErrPackageNotFound
+	}
+	channel, packageName := name[:idx], name[idx+1:]
+
+	var result types.Package
+	result.Name = name
+	result.Versions = make(map[string]types.PackageVersion)
+	result.Time = make(map[string]time.Time)
+	result.Downloads = []types.Download{}
+
+	var latest time.Time
+	found := false
+
+	for _, subdir := range s.subdirs() {
+		records, err := s.records(ctx, name, s.channelURL(channel)+"/"+subdir)
+		if err == types.ErrPackageNotFound {
+			// Channels don't have packages for every platform
+			continue
+		}
+		if err != nil {
+			return types.Package{}, err
+		}
+
+		for _, record := range records[packageName] {
+			found = true
+
+			pv, ok := result.Versions[record.Version]
+			if !ok {
+				pv = types.PackageVersion{Name: name, Version: record.Version}
+			}
+
+			if pv.License == "" {
+				pv.License = record.License
+			}
+			result.Versions[record.Version] = pv
+
+			if record.Timestamp == 0 {
+				continue
+			}
+
+			// Packages have a build per platform, the first one is the release date
+			released := time.UnixMilli(record.Timestamp).UTC()
+			if t, ok := result.Time[record.Version]; !ok || released.Before(t) {
+				result.Time[record.Version] = released
+			}
+
+			// Use the license of the latest build
+			if released.After(latest) {
+				latest = released
+				result.License = record.License
+			}
+		}
+	}
+
+	if !found {
+		return types.Package{}, types.ErrPackageNotFound
+	}
+
+	return result, nil
+}
+
+func (s CondaSource) subdirs() []string {
+	if len(s.Subdirs) == 0 {
+		return []string{"noarch", platform()}
+	}
+	return s.Subdirs
+}
+
+// channelURL returns the URL of a channel, which is either a name or a URL.
+func (s CondaSource) channelURL(channel string) string {
+	if strings.HasPrefix(channel, "http://") || strings.HasPrefix(channel, "https://") {
+		return channel
+	}
+
+	if channel == "defaults" {
+		return defaultsURL
+	}
+
+	baseURL := s.ChannelURL
+	if baseURL == "" {
+		baseURL = DefaultChannelURL
+	}
+
+	return strings.TrimSuffix(baseURL, "/") + "/" + channel
+}
+
+// records downloads the repodata of a channel subdir once, since it's shared by
+// all the packages of the channel and can be large. Records are grouped by name.
+func (s CondaSource) records(ctx context.Context, name string, subdirURL string) (map[string][]Record, error) {
+	if s.repodata == nil {
+		return s.fetchRepodata(ctx, name, subdirURL)
+	}
+
+	s.repodata.mu.Lock()
+	cached, ok := s.repodata.repodata[subdirURL]
+	if !ok {
+		cached = &cachedRepodata{}
+		s.repodata.repodata[subdirURL] = cached
+	}
+	s.repodata.mu.Unlock()
+
+	cached.once.Do(func() {
+		cached.records, cached.err = s.fetchRepodata(ctx, name, subdirURL)
+	})
+
+	return cached.records, cached.err
+}
+
+func (s CondaSource) fetchRepodata(ctx context.Context, name string, subdirURL string) (map[string][]Record, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, subdirURL+"/repodata.json", nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request for %s information from conda channel: %w", name, err)
+	}
+
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error getting %s information from conda channel: %w", name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == 404 || resp.StatusCode == 405 {
+		return nil, types.ErrPackageNotFound
+	}
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("error getting %s information from conda channel: %s", name, resp.Status)
+	}
+
+	var repodata Repodata
+	if err := json.NewDecoder(resp.Body).Decode(&repodata); err != nil {
+		return nil, fmt.Errorf("error parsing the repodata of %s: %w", subdirURL, err)
+	}
+
+	records := make(map[string][]Record)
+	for _, packages := range []map[string]Record{repodata.Packages, repodata.PackagesConda} {
+		for _, record := range packages {
+			records[record.Name] = append(records[record.Name], record)
+		}
+	}
+
+	return records, nil
+}
+
+type repodataCache struct {
+	mu       sync.Mutex
+	repodata map[string]*cachedRepodata
+}
+
+type cachedRepodata struct {
+	once    sync.Once
+	records map[string][]Record
+	err     error
+}
+
+// platform returns the conda subdir of the current platform, like linux-64.
+func platform() string {
+	system := map[string]string{"darwin": "osx", "windows": "win"}[runtime.GOOS]
+	if system == "" {
+		system = runtime.GOOS
+	}
+
+	arch := map[string]string{"amd64": "64", "386": "32", "arm64": "aarch64", "ppc64le": "ppc64le", "s390x": "s390x"}[runtime.GOARCH]
+	if arch == "" {
+		arch = runtime.GOARCH
+	}
+
+	// macOS and Windows name ARM platforms differently than Linux
+	if arch == "aarch64" && system != "linux" {
+		arch = "arm64"
+	}
+
+	return system + "-" + arch
+}
@@ -0,0 +1,73 @@
+package conda
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/depshubhq/depshub/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCondaSource_FetchPackageData(t *testing.T) {
+	requests := 0
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/conda-forge/noarch/repodata.json", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(`{
+			"packages": {
+				"requests-2.31.0-pyhd8ed1ab_0.tar.bz2": {"name": "requests", "version": "2.31.0", "license": "Apache-2.0", "timestamp": 1684774206000}
+			},
+			"packages.conda": {}
+		}`))
+	})
+	mux.HandleFunc("/conda-forge/linux-64/repodata.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{
+			"packages": {
+				"numpy-1.26.3-py311h64a7726_0.tar.bz2": {"name": "numpy", "version": "1.26.3", "license": "BSD", "timestamp": 1704280000000}
+			},
+			"packages.conda": {
+				"numpy-1.26.4-py311h64a7726_0.conda": {"name": "numpy", "version": "1.26.4", "license": "BSD-3-Clause", "timestamp": 1707225421000},
+				"numpy-1.26.4-py312h8753938_0.conda": {"name": "numpy", "version": "1.26.4", "license": "BSD-3-Clause", "timestamp": 1707225900000}
+			}
+		}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	source := NewCondaSource()
+	source.ChannelURL = server.URL
+	source.Subdirs = []string{"noarch", "linux-64", "win-64"}
+
+	pkg, err := source.FetchPackageData(context.Background(), "conda-forge/numpy")
+	assert.NoError(t, err)
+
+	assert.Equal(t, types.Package{
+		Name: "conda-forge/numpy",
+		Versions: map[string]types.PackageVersion{
+			"1.26.3": {Name: "conda-forge/numpy", Version: "1.26.3", License: "BSD"},
+			"1.26.4": {Name: "conda-forge/numpy", Version: "1.26.4", License: "BSD-3-Clause"},
+		},
+		Time: map[string]time.Time{
+			"1.26.3": time.UnixMilli(1704280000000).UTC(),
+			"1.26.4": time.UnixMilli(1707225421000).UTC(),
+		},
+		License:   "BSD-3-Clause",
+		Downloads: []types.Download{},
+	}, pkg)
+
+	// Channels given as URLs don't use the channel host
+	pkg, err = source.FetchPackageData(context.Background(), server.URL+"/conda-forge/requests")
+	assert.NoError(t, err)
+	assert.Equal(t, "Apache-2.0", pkg.License)
+
+	_, err = source.FetchPackageData(context.Background(), "conda-forge/missing")
+	assert.ErrorIs(t, err, types.ErrPackageNotFound)
+
+	// The repodata is shared by the packages of the channel
+	assert.Equal(t, 1, requests)
+}
@@ -69,6 +69,7 @@ func (s CratesSource) FetchPackageData(ctx context.Context, name string) (types.
 			Name:       target.Name,
 			Version:    version.Num,
 			Deprecated: deprecated,
+			License:    version.License,
 		}
 
 		if result.Versions == nil {
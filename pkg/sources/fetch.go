@@ -3,15 +3,29 @@ package sources
 import (
 	"context"
 	"fmt"
+	"os"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
+	condasource "github.com/depshubhq/depshub/pkg/sources/conda"
 	"github.com/depshubhq/depshub/pkg/sources/crates"
+	"github.com/depshubhq/depshub/pkg/sources/git"
+	"github.com/depshubhq/depshub/pkg/sources/github"
 	"github.com/depshubhq/depshub/pkg/sources/go"
+	"github.com/depshubhq/depshub/pkg/sources/helm"
 	"github.com/depshubhq/depshub/pkg/sources/hex"
+	"github.com/depshubhq/depshub/pkg/sources/jsr"
 	"github.com/depshubhq/depshub/pkg/sources/maven"
 	"github.com/depshubhq/depshub/pkg/sources/npm"
+	nugetsource "github.com/depshubhq/depshub/pkg/sources/nuget"
+	"github.com/depshubhq/depshub/pkg/sources/oci"
+	"github.com/depshubhq/depshub/pkg/sources/packagist"
+	pubsource "github.com/depshubhq/depshub/pkg/sources/pub"
 	"github.com/depshubhq/depshub/pkg/sources/pypi"
+	"github.com/depshubhq/depshub/pkg/sources/rubygems"
+	"github.com/depshubhq/depshub/pkg/sources/terraform"
 	"github.com/depshubhq/depshub/pkg/types"
 )
 
@@ -23,9 +37,18 @@ func NewFetcher() fetcher {
 
 const MaxConcurrent = 30
 
+// Managers whose sources only fetch some information for the version they are
+// given, like the creation date of a container image tag or the license of a
+// module. Their packages are fetched once per version in use and the versions
+// are merged.
+var versionedManagers = []types.ManagerType{
+	types.Docker, types.Terraform, types.Helm, types.Go, types.Maven, types.Pip, types.Pyproject, types.Pipfile, types.Bundler,
+}
+
 func (f fetcher) Fetch(uniqueDependencies []types.Dependency) (types.PackagesInfo, error) {
 	// Create channels for results and errors
 	type packageResult struct {
+		key string
 		pkg types.Package
 		err error
 	}
@@ -38,6 +61,17 @@ func (f fetcher) Fetch(uniqueDependencies []types.Dependency) (types.PackagesInf
 	pypiSource := pypi.PyPISource{}
 	hexSource := hex.HexSource{}
 	mavenSource := maven.MavenSource{}
+	rubygemsSource := rubygems.RubyGemsSource{}
+	packagistSource := packagist.PackagistSource{}
+	nugetSource := nugetsource.NuGetSource{}
+	githubSource := github.NewGitHubSource()
+	ociSource := oci.NewOCISource()
+	terraformSource := terraform.NewTerraformSource()
+	pubSource := pubsource.NewPubSource()
+	gitSource := git.GitSource{}
+	helmSource := helm.NewHelmSource()
+	condaSource := condasource.NewCondaSource()
+	jsrSource := jsr.JSRSource{}
 
 	background := context.Background()
 
@@ -49,8 +83,22 @@ func (f fetcher) Fetch(uniqueDependencies []types.Dependency) (types.PackagesInf
 		return nil, err
 	}
 
-	var wg sync.WaitGroup
+	// Other sources return all the versions of a package at once
+	requests := make(map[string]types.Dependency)
 	for _, dep := range uniqueDependencies {
+		key := fmt.Sprintf("%d-%s", dep.Manager, dep.Name)
+		if dep.Registry != "" {
+			key = fmt.Sprintf("%d-%s/%s", dep.Manager, strings.TrimSuffix(dep.Registry, "/"), dep.Name)
+		}
+		if slices.Contains(versionedManagers, dep.Manager) {
+			key += "@" + dep.Version
+		}
+
+		requests[key] = dep
+	}
+
+	var wg sync.WaitGroup
+	for key, dep := range requests {
 		wg.Add(1)
 
 		go func() {
@@ -64,12 +112,10 @@ func (f fetcher) Fetch(uniqueDependencies []types.Dependency) (types.PackagesInf
 			var packageInfo types.Package
 			var err error
 
-			key := fmt.Sprintf("%d-%s", dep.Manager, dep.Name)
-
 			exists, err := c.Get(key, &packageInfo)
 
 			if err != nil {
-				fmt.Printf("Error getting cache: %s\n", err)
+				fmt.Fprintf(os.Stderr, "Error getting cache: %s\n", err)
 			}
 
 			if !exists {
@@ -80,22 +126,53 @@ func (f fetcher) Fetch(uniqueDependencies []types.Dependency) (types.PackagesInf
 					packageInfo, err = goSource.FetchPackageData(dep.Name, dep.Version)
 				case types.Cargo:
 					packageInfo, err = cratesSource.FetchPackageData(background, dep.Name)
-				case types.Pip, types.Pyproject:
-					packageInfo, err = pypiSource.FetchPackageData(background, dep.Name)
+				case types.Pip, types.Pyproject, types.Pipfile:
+					packageInfo, err = pypiSource.FetchPackageData(background, dep.Name, dep.Version)
 				case types.Hex:
 					packageInfo, err = hexSource.FetchPackageData(background, dep.Name)
 				case types.Maven:
 					packageInfo, err = mavenSource.FetchPackageData(dep.Name, dep.Version)
+				case types.Bundler:
+					lockedVersion := ""
+					if dep.Locked {
+						lockedVersion = dep.Version
+					}
+					packageInfo, err = rubygemsSource.FetchPackageData(background, dep.Name, lockedVersion)
+				case types.Composer:
+					packageInfo, err = packagistSource.FetchPackageData(background, dep.Name)
+				case types.NuGet:
+					packageInfo, err = nugetSource.FetchPackageData(background, dep.Name)
+				case types.GitHubActions:
+					packageInfo, err = githubSource.FetchPackageData(background, dep.Name)
+				case types.Docker:
+					packageInfo, err = ociSource.FetchPackageData(background, dep.Name, dep.Version)
+				case types.Terraform:
+					packageInfo, err = terraformSource.FetchPackageData(background, dep.Name, dep.Version)
+				case types.Pub:
+					source := pubSource
+					if dep.Registry != "" {
+						source.BaseURL = dep.Registry
+					}
+					packageInfo, err = source.FetchPackageData(background, dep.Name)
+				case types.SwiftPM:
+					packageInfo, err = gitSource.FetchPackageData(background, dep.Name)
+				case types.Helm:
+					packageInfo, err = helmSource.FetchPackageData(background, dep.Name, dep.Version)
+				case types.Conda:
+					packageInfo, err = condaSource.FetchPackageData(background, dep.Name)
+				case types.Deno:
+					packageInfo, err = jsrSource.FetchPackageData(background, dep.Name)
 				}
 
 				if err != nil {
-					fmt.Printf("Error fetching package data: %s\n", err)
+					fmt.Fprintf(os.Stderr, "Error fetching package data: %s\n", err)
 				} else {
 					c.Set(key, packageInfo, 48*time.Hour)
 				}
 			}
 
 			resultChan <- packageResult{
+				key: types.PackageKey(dep.Manager, dep.Name),
 				pkg: packageInfo,
 				err: err,
 			}
@@ -113,11 +190,45 @@ func (f fetcher) Fetch(uniqueDependencies []types.Dependency) (types.PackagesInf
 
 	for result := range resultChan {
 		if result.err != nil {
-			fmt.Printf("Error fetching package data: %s\n", result.err)
+			fmt.Fprintf(os.Stderr, "Error fetching package data: %s\n", result.err)
 			continue
 		}
-		packagesData[result.pkg.Name] = result.pkg
+
+		if pkg, ok := packagesData[result.key]; ok {
+			packagesData[result.key] = mergeVersions(pkg, result.pkg)
+		} else {
+			packagesData[result.key] = result.pkg
+		}
 	}
 
 	return packagesData, nil
 }
+
+// mergeVersions adds the information of the versions of a package fetched for
+// another version of it.
+func mergeVersions(pkg types.Package, other types.Package) types.Package {
+	if pkg.Versions == nil {
+		pkg.Versions = make(map[string]types.PackageVersion)
+	}
+	if pkg.Time == nil {
+		pkg.Time = make(map[string]time.Time)
+	}
+
+	for version, v := range other.Versions {
+		if current, ok := pkg.Versions[version]; !ok || (current.License == "" && current.Deprecated == "") {
+			pkg.Versions[version] = v
+		}
+	}
+
+	if pkg.License == "" {
+		pkg.License = other.License
+	}
+
+	for version, t := range other.Time {
+		if _, ok := pkg.Time[version]; !ok {
+			pkg.Time[version] = t
+		}
+	}
+
+	return pkg
+}
@@ -0,0 +1,69 @@
+package sources
+
+import (
+	"testing"
+	"time"
+
+	"github.com/depshubhq/depshub/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeVersions(t *testing.T) {
+	created := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+
+	// The same image fetched for the tags 1.0 and 1.1
+	first := types.Package{
+		Name: "redis",
+		Versions: map[string]types.PackageVersion{
+			"1.0": {Name: "redis", Version: "1.0", License: "BSD-3-Clause"},
+			"1.1": {Name: "redis", Version: "1.1"},
+		},
+		Time: map[string]time.Time{"1.0": created.AddDate(0, -1, 0)},
+	}
+	second := types.Package{
+		Name: "redis",
+		Versions: map[string]types.PackageVersion{
+			"1.0": {Name: "redis", Version: "1.0"},
+			"1.1": {Name: "redis", Version: "1.1", License: "RSALv2"},
+		},
+		Time: map[string]time.Time{"1.1": created},
+	}
+
+	assert.Equal(t, types.Package{
+		Name: "redis",
+		Versions: map[string]types.PackageVersion{
+			"1.0": {Name: "redis", Version: "1.0", License: "BSD-3-Clause"},
+			"1.1": {Name: "redis", Version: "1.1", License: "RSALv2"},
+		},
+		Time: map[string]time.Time{
+			"1.0": created.AddDate(0, -1, 0),
+			"1.1": created,
+		},
+	}, mergeVersions(first, second))
+}
+
+func TestMergeVersions_License(t *testing.T) {
+	// A module fetched for an old version and the default one, which has the
+	// license of the package
+	old := types.Package{
+		Name: "example.com/module",
+		Versions: map[string]types.PackageVersion{
+			"v1.0.0": {Name: "example.com/module", Version: "v1.0.0", License: "MIT"},
+			"v2.0.0": {Name: "example.com/module", Version: "v2.0.0"},
+		},
+	}
+	latest := types.Package{
+		Name:    "example.com/module",
+		License: "Apache-2.0",
+		Versions: map[string]types.PackageVersion{
+			"v1.0.0": {Name: "example.com/module", Version: "v1.0.0"},
+			"v2.0.0": {Name: "example.com/module", Version: "v2.0.0", License: "Apache-2.0"},
+		},
+	}
+
+	for _, merged := range []types.Package{mergeVersions(old, latest), mergeVersions(latest, old)} {
+		assert.Equal(t, "Apache-2.0", merged.License)
+		assert.Equal(t, "MIT", merged.LicenseOf("v1.0.0"))
+		assert.Equal(t, "Apache-2.0", merged.LicenseOf("v2.0.0"))
+	}
+}
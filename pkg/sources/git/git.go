@@ -0,0 +1,150 @@
+package git
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+// GitSource reads the tags of packages hosted in git repositories, like Swift
+// packages. It speaks the smart HTTP protocol, so it works with any git host.
+type GitSource struct {
+	Client *http.Client
+}
+
+// FetchPackageData returns the tags of the repository as versions. The name is
+// the location of the repository without a scheme, like github.com/apple/swift-log.
+// Tags don't have a publication date, so only the versions are known.
+func (s GitSource) FetchPackageData(ctx context.Context, name string) (types.Package, error) {
+	tags, err := s.tags(ctx, name)
+	if err != nil {
+		return types.Package{}, err
+	}
+
+	var result types.Package
+	result.Name = name
+	result.Versions = make(map[string]types.PackageVersion)
+	result.Time = make(map[string]time.Time)
+	result.Downloads = []types.Download{}
+
+	for _, tag := range tags {
+		// Tags like v1.2.0 are resolved as 1.2.0
+		version := strings.TrimPrefix(tag, "v")
+
+		result.Versions[version] = types.PackageVersion{
+			Name:    name,
+			Version: version,
+		}
+	}
+
+	return result, nil
+}
+
+// tags lists the tags advertised by the repository, like `git ls-remote --tags`.
+func (s GitSource) tags(ctx context.Context, name string) ([]string, error) {
+	url := repositoryURL(name) + "/info/refs?service=git-upload-pack"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request for %s information from git repository: %w", name, err)
+	}
+
+	// Some hosts only serve the smart protocol to git clients
+	req.Header.Set("User-Agent", "git/depshub")
+
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error getting %s information from git repository: %w", name, err)
+	}
+	defer resp.Body.Close()
+
+	// Private repositories ask for credentials instead of returning 404
+	if resp.StatusCode == 401 || resp.StatusCode == 404 || resp.StatusCode == 405 {
+		return nil, types.ErrPackageNotFound
+	}
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("error getting %s information from git repository: %s", name, resp.Status)
+	}
+
+	refs, err := readRefs(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s information from git repository: %w", name, err)
+	}
+
+	var tags []string
+	for _, ref := range refs {
+		tag, ok := strings.CutPrefix(ref, "refs/tags/")
+
+		// Skip the commits of annotated tags like refs/tags/v1.0.0^{}
+		if !ok || strings.HasSuffix(tag, "^{}") {
+			continue
+		}
+
+		tags = append(tags, tag)
+	}
+
+	return tags, nil
+}
+
+// readRefs parses the pkt-lines of a ref advertisement and returns the ref names.
+// https://git-scm.com/docs/http-protocol#_smart_clients
+func readRefs(r io.Reader) ([]string, error) {
+	var refs []string
+
+	reader := bufio.NewReader(r)
+	for {
+		var size [4]byte
+		if _, err := io.ReadFull(reader, size[:]); err == io.EOF {
+			return refs, nil
+		} else if err != nil {
+			return nil, err
+		}
+
+		length, err := strconv.ParseUint(string(size[:]), 16, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pkt-line length %q", size)
+		}
+
+		// Flush packets separate the service announcement from the refs
+		if length < 4 {
+			continue
+		}
+
+		line := make([]byte, length-4)
+		if _, err := io.ReadFull(reader, line); err != nil {
+			return nil, err
+		}
+
+		// The first ref is followed by the capabilities of the server
+		ref, _, _ := strings.Cut(strings.TrimSuffix(string(line), "\n"), "\x00")
+		if strings.HasPrefix(ref, "#") {
+			continue
+		}
+
+		if _, name, found := strings.Cut(ref, " "); found {
+			refs = append(refs, name)
+		}
+	}
+}
+
+// repositoryURL returns the URL of a repository, using http for local hosts.
+func repositoryURL(name string) string {
+	if strings.HasPrefix(name, "localhost") || strings.HasPrefix(name, "127.0.0.1") {
+		return "http://" + name
+	}
+
+	return "https://" + name
+}
@@ -0,0 +1,57 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/depshubhq/depshub/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// pktLine encodes a line of the git protocol
+func pktLine(line string) string {
+	return fmt.Sprintf("%04x%s", len(line)+4, line)
+}
+
+func TestGitSource_FetchPackageData(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/apple/swift-log/info/refs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "git-upload-pack", r.URL.Query().Get("service"))
+
+		w.Header().Set("Content-Type", "application/x-git-upload-pack-advertisement")
+		w.Write([]byte(strings.Join([]string{
+			pktLine("# service=git-upload-pack\n"),
+			"0000",
+			pktLine("9cb486020ebf03bfa5b5df985387a14a98744537 HEAD\x00multi_ack thin-pack side-band symref=HEAD:refs/heads/main\n"),
+			pktLine("9cb486020ebf03bfa5b5df985387a14a98744537 refs/heads/main\n"),
+			pktLine("e97c1a3e6a1b63ec8cb7c46bd0a9b8e60f7e3f2c refs/tags/1.5.4\n"),
+			pktLine("0fd5b2b4f8f0fbd4df6d0d3c5f7a0d6b1d9e2c3a refs/tags/1.6.1\n"),
+			pktLine("9cb486020ebf03bfa5b5df985387a14a98744537 refs/tags/1.6.1^{}\n"),
+			pktLine("1d9e2c3a0fd5b2b4f8f0fbd4df6d0d3c5f7a0d6b refs/tags/v2.0.0-beta.1\n"),
+			"0000",
+		}, "")))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	source := GitSource{}
+	name := strings.TrimPrefix(server.URL, "http://") + "/apple/swift-log"
+
+	pkg, err := source.FetchPackageData(context.Background(), name)
+	assert.NoError(t, err)
+
+	assert.Equal(t, map[string]types.PackageVersion{
+		"1.5.4":        {Name: name, Version: "1.5.4"},
+		"1.6.1":        {Name: name, Version: "1.6.1"},
+		"2.0.0-beta.1": {Name: name, Version: "2.0.0-beta.1"},
+	}, pkg.Versions)
+	assert.Empty(t, pkg.Time)
+
+	_, err = source.FetchPackageData(context.Background(), strings.TrimPrefix(server.URL, "http://")+"/apple/missing")
+	assert.ErrorIs(t, err, types.ErrPackageNotFound)
+}
@@ -0,0 +1,197 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+const DefaultBaseURL = "https://api.github.com"
+
+// GitHubSource reads the tags and releases of actions from the GitHub API.
+// BaseURL points to GitHub Enterprise Server or any compatible API, like
+// https://github.example.com/api/v3. The GITHUB_TOKEN is used when it's set.
+type GitHubSource struct {
+	BaseURL string
+	Token   string
+	Client  *http.Client
+}
+
+// NewGitHubSource uses the API of the GitHub instance running the workflow, if any.
+func NewGitHubSource() GitHubSource {
+	return GitHubSource{
+		BaseURL: os.Getenv("GITHUB_API_URL"),
+		Token:   os.Getenv("GITHUB_TOKEN"),
+	}
+}
+
+type Commit struct {
+	SHA string `json:"sha"`
+}
+
+type Tag struct {
+	Name   string `json:"name"`
+	Commit Commit `json:"commit"`
+}
+
+type Release struct {
+	TagName     string    `json:"tag_name"`
+	Draft       bool      `json:"draft"`
+	PublishedAt time.Time `json:"published_at"`
+}
+
+type Repository struct {
+	FullName string `json:"full_name"`
+	Archived bool   `json:"archived"`
+	License  *struct {
+		SPDXID string `json:"spdx_id"`
+	} `json:"license"`
+}
+
+// FetchPackageData returns the tags of the repository as versions. Release dates
+// are only known for tags with a GitHub release.
+func (s GitHubSource) FetchPackageData(ctx context.Context, name string) (types.Package, error) {
+	var repository Repository
+	var result types.Package
+
+	if err := s.fetch(ctx, name, fmt.Sprintf("/repos/%s", name), &repository); err != nil {
+		return types.Package{}, err
+	}
+
+	tags, err := fetchAll[Tag](ctx, s, name, fmt.Sprintf("/repos/%s/tags?per_page=100", name))
+	if err != nil {
+		return types.Package{}, err
+	}
+
+	releases, err := fetchAll[Release](ctx, s, name, fmt.Sprintf("/repos/%s/releases?per_page=100", name))
+	if err != nil {
+		return types.Package{}, err
+	}
+
+	// Convert the repository to the generic types.Package
+	result.Name = name
+	result.Versions = make(map[string]types.PackageVersion)
+	result.Time = make(map[string]time.Time)
+	result.Downloads = []types.Download{}
+
+	if repository.License != nil && repository.License.SPDXID != "NOASSERTION" {
+		result.License = repository.License.SPDXID
+	}
+
+	deprecated := ""
+	if repository.Archived {
+		deprecated = "archived"
+	}
+
+	for _, tag := range tags {
+		result.Versions[tag.Name] = types.PackageVersion{
+			Name:       name,
+			Version:    tag.Name,
+			Deprecated: deprecated,
+		}
+	}
+
+	for _, release := range releases {
+		if release.Draft || release.PublishedAt.IsZero() {
+			continue
+		}
+
+		result.Time[release.TagName] = release.PublishedAt
+	}
+
+	return result, nil
+}
+
+// fetchAll returns the items of every page of a list, following the next links
+// of the Link headers.
+func fetchAll[T any](ctx context.Context, s GitHubSource, name string, path string) ([]T, error) {
+	var items []T
+
+	for url := s.url(path); url != ""; {
+		var page []T
+
+		next, err := s.get(ctx, name, url, &page)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, page...)
+		url = next
+	}
+
+	return items, nil
+}
+
+func (s GitHubSource) fetch(ctx context.Context, name string, path string, target any) error {
+	_, err := s.get(ctx, name, s.url(path), target)
+	return err
+}
+
+func (s GitHubSource) url(path string) string {
+	baseURL := s.BaseURL
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+
+	return strings.TrimSuffix(baseURL, "/") + path
+}
+
+// get decodes the response of the URL into the target and returns the URL of
+// the next page, if any.
+func (s GitHubSource) get(ctx context.Context, name string, url string, target any) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", fmt.Errorf("error creating request for %s information from GitHub: %w", name, err)
+	}
+
+	req.Header.Set("Accept", "application/vnd.github+json")
+	if s.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.Token)
+	}
+
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error getting %s information from GitHub: %w", name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == 404 || resp.StatusCode == 405 {
+		return "", types.ErrPackageNotFound
+	}
+
+	if resp.StatusCode != 200 {
+		return "", fmt.Errorf("error getting %s information from GitHub: %s", name, resp.Status)
+	}
+
+	return nextLink(resp.Header.Get("Link")), json.NewDecoder(resp.Body).Decode(target)
+}
+
+// nextLink returns the URL of the next page from a Link header like
+// `<https://api.github.com/repositories/1/tags?page=2>; rel="next", <...>; rel="last"`.
+func nextLink(header string) string {
+	for _, link := range strings.Split(header, ",") {
+		url, params, ok := strings.Cut(link, ";")
+		if !ok {
+			continue
+		}
+
+		for _, param := range strings.Split(params, ";") {
+			if strings.TrimSpace(param) == `rel="next"` {
+				return strings.Trim(strings.TrimSpace(url), "<>")
+			}
+		}
+	}
+
+	return ""
+}
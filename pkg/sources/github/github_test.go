@@ -0,0 +1,69 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/depshubhq/depshub/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGitHubSource_FetchPackageData(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v3/repos/actions/checkout", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		w.Write([]byte(`{"full_name": "actions/checkout", "archived": false, "license": {"spdx_id": "MIT"}}`))
+	})
+	mux.HandleFunc("/api/v3/repos/actions/checkout/tags", func(w http.ResponseWriter, r *http.Request) {
+		// Repositories with more than 100 tags are paginated
+		if r.URL.Query().Get("page") == "2" {
+			w.Header().Set("Link", fmt.Sprintf(`<%[1]s/api/v3/repositories/1/tags?per_page=100&page=1>; rel="prev", <%[1]s/api/v3/repositories/1/tags?per_page=100&page=1>; rel="first"`, server.URL))
+			w.Write([]byte(`[{"name": "v1.0.0", "commit": {"sha": "af513c7a016048ae468971c52ed77d9562c7c819"}}]`))
+			return
+		}
+
+		w.Header().Set("Link", fmt.Sprintf(`<%[1]s/api/v3/repos/actions/checkout/tags?per_page=100&page=2>; rel="next", <%[1]s/api/v3/repos/actions/checkout/tags?per_page=100&page=2>; rel="last"`, server.URL))
+		w.Write([]byte(`[
+			{"name": "v4.2.2", "commit": {"sha": "11bd71901bbe5b1630ceea73d27597364c9af683"}},
+			{"name": "v4", "commit": {"sha": "11bd71901bbe5b1630ceea73d27597364c9af683"}}
+		]`))
+	})
+	mux.HandleFunc("/api/v3/repositories/1/tags", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("the previous page was fetched")
+	})
+	mux.HandleFunc("/api/v3/repos/actions/checkout/releases", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[
+			{"tag_name": "v4.2.2", "draft": false, "published_at": "2024-10-23T14:46:00Z"},
+			{"tag_name": "v5.0.0", "draft": true, "published_at": null}
+		]`))
+	})
+
+	source := GitHubSource{BaseURL: server.URL + "/api/v3/", Token: "token"}
+
+	pkg, err := source.FetchPackageData(context.Background(), "actions/checkout")
+	assert.NoError(t, err)
+
+	assert.Equal(t, types.Package{
+		Name: "actions/checkout",
+		Versions: map[string]types.PackageVersion{
+			"v4.2.2": {Name: "actions/checkout", Version: "v4.2.2"},
+			"v4":     {Name: "actions/checkout", Version: "v4"},
+			"v1.0.0": {Name: "actions/checkout", Version: "v1.0.0"},
+		},
+		Time: map[string]time.Time{
+			"v4.2.2": time.Date(2024, 10, 23, 14, 46, 0, 0, time.UTC),
+		},
+		License:   "MIT",
+		Downloads: []types.Download{},
+	}, pkg)
+
+	_, err = source.FetchPackageData(context.Background(), "actions/missing")
+	assert.ErrorIs(t, err, types.ErrPackageNotFound)
+}
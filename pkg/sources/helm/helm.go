@@ -0,0 +1,238 @@
+package helm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/depshubhq/depshub/pkg/sources/oci"
+	"github.com/depshubhq/depshub/pkg/types"
+	"gopkg.in/yaml.v3"
+)
+
+// HelmSource reads the versions of charts from the index.yaml of chart
+// repositories, or from the tags of OCI registries.
+type HelmSource struct {
+	// Repositories added with `helm repo add`, keyed by name
+	Repositories map[string]string
+	// A repository serving the charts of all the other ones, like a virtual
+	// repository of Artifactory or Nexus. The index of the mirror is used
+	// instead of the index of each repository.
+	Mirror string
+	OCI    oci.OCISource
+	Client *http.Client
+
+	indexes *indexCache
+}
+
+// NewHelmSource uses the repositories added with `helm repo add` and the
+// mirror from DEPSHUB_HELM_REPOSITORY, if any.
+func NewHelmSource() HelmSource {
+	return HelmSource{
+		Repositories: helmRepositories(),
+		Mirror:       os.Getenv("DEPSHUB_HELM_REPOSITORY"),
+		OCI:          oci.NewOCISource(),
+		indexes:      &indexCache{indexes: make(map[string]*cachedIndex)},
+	}
+}
+
+type ChartVersion struct {
+	Version    string `yaml:"version"`
+	Created    string `yaml:"created"`
+	Deprecated bool   `yaml:"deprecated"`
+}
+
+// https://helm.sh/docs/topics/chart_repository/#the-index-file
+type IndexFile struct {
+	Entries map[string][]ChartVersion `yaml:"entries"`
+}
+
+// FetchPackageData returns the versions of a chart named like the dependencies
+// of the helm manager, https://charts.bitnami.com/bitnami/postgresql.
+func (s HelmSource) FetchPackageData(ctx context.Context, name string, version string) (types.Package, error) {
+	idx := strings.LastIndex(name, "/")
+	if idx == -1 {
+		return types.Package{}, types.ErrPackageNotFound
+	}
+	repository, chart := name[:idx], name[idx+1:]
+
+	// Charts stored in OCI registries are tagged with their versions
+	if reference, ok := strings.CutPrefix(name, "oci://"); ok {
+		return s.fetchOCI(ctx, name, reference, version)
+	}
+
+	repositoryURL := repository
+	if alias, ok := strings.CutPrefix(repository, "@"); ok {
+		url, found := s.Repositories[alias]
+		if !found {
+			return types.Package{}, fmt.Errorf("error getting %s information: repository %s isn't added with helm repo add", name, alias)
+		}
+		repositoryURL = url
+	}
+
+	if s.Mirror != "" {
+		repositoryURL = s.Mirror
+	}
+
+	index, err := s.index(ctx, name, repositoryURL)
+	if err != nil {
+		return types.Package{}, err
+	}
+
+	versions, ok := index.Entries[chart]
+	if !ok {
+		return types.Package{}, types.ErrPackageNotFound
+	}
+
+	var result types.Package
+	result.Name = name
+	result.Versions = make(map[string]types.PackageVersion)
+	result.Time = make(map[string]time.Time)
+	result.Downloads = []types.Download{}
+
+	for _, v := range versions {
+		deprecated := ""
+		if v.Deprecated {
+			deprecated = "deprecated"
+		}
+
+		result.Versions[v.Version] = types.PackageVersion{
+			Name:       name,
+			Version:    v.Version,
+			Deprecated: deprecated,
+		}
+
+		if created, err := time.Parse(time.RFC3339Nano, v.Created); err == nil {
+			result.Time[v.Version] = created
+		}
+	}
+
+	return result, nil
+}
+
+func (s HelmSource) fetchOCI(ctx context.Context, name string, reference string, version string) (types.Package, error) {
+	result, err := s.OCI.FetchPackageData(ctx, reference, version)
+	if err != nil {
+		return types.Package{}, err
+	}
+
+	result.Name = name
+	for key, v := range result.Versions {
+		v.Name = name
+		result.Versions[key] = v
+	}
+
+	return result, nil
+}
+
+// index downloads the index of a repository once, since it's shared by all
+// the charts of the repository and can be large.
+func (s HelmSource) index(ctx context.Context, name string, repositoryURL string) (IndexFile, error) {
+	url := strings.TrimSuffix(repositoryURL, "/") + "/index.yaml"
+
+	if s.indexes == nil {
+		return s.fetchIndex(ctx, name, url)
+	}
+
+	s.indexes.mu.Lock()
+	cached, ok := s.indexes.indexes[url]
+	if !ok {
+		cached = &cachedIndex{}
+		s.indexes.indexes[url] = cached
+	}
+	s.indexes.mu.Unlock()
+
+	cached.once.Do(func() {
+		cached.index, cached.err = s.fetchIndex(ctx, name, url)
+	})
+
+	return cached.index, cached.err
+}
+
+func (s HelmSource) fetchIndex(ctx context.Context, name string, url string) (IndexFile, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return IndexFile{}, fmt.Errorf("error creating request for %s information from chart repository: %w", name, err)
+	}
+
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return IndexFile{}, fmt.Errorf("error getting %s information from chart repository: %w", name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == 404 || resp.StatusCode == 405 {
+		return IndexFile{}, types.ErrPackageNotFound
+	}
+
+	if resp.StatusCode != 200 {
+		return IndexFile{}, fmt.Errorf("error getting %s information from chart repository: %s", name, resp.Status)
+	}
+
+	var index IndexFile
+	if err := yaml.NewDecoder(resp.Body).Decode(&index); err != nil {
+		return IndexFile{}, fmt.Errorf("error parsing the index of %s: %w", url, err)
+	}
+
+	return index, nil
+}
+
+type indexCache struct {
+	mu      sync.Mutex
+	indexes map[string]*cachedIndex
+}
+
+type cachedIndex struct {
+	once  sync.Once
+	index IndexFile
+	err   error
+}
+
+// helmRepositories reads the repositories added with `helm repo add`.
+func helmRepositories() map[string]string {
+	repositories := make(map[string]string)
+
+	path := os.Getenv("HELM_REPOSITORY_CONFIG")
+	if path == "" {
+		dir := os.Getenv("XDG_CONFIG_HOME")
+		if dir == "" {
+			home, err := os.UserHomeDir()
+			if err != nil {
+				return repositories
+			}
+			dir = filepath.Join(home, ".config")
+		}
+		path = filepath.Join(dir, "helm", "repositories.yaml")
+	}
+
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return repositories
+	}
+
+	var config struct {
+		Repositories []struct {
+			Name string `yaml:"name"`
+			URL  string `yaml:"url"`
+		} `yaml:"repositories"`
+	}
+	if err := yaml.Unmarshal(file, &config); err != nil {
+		return repositories
+	}
+
+	for _, repository := range config.Repositories {
+		repositories[repository.Name] = repository.URL
+	}
+
+	return repositories
+}
@@ -0,0 +1,106 @@
+package helm
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/depshubhq/depshub/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const index = `apiVersion: v1
+entries:
+  postgresql:
+  - version: 15.5.38
+    appVersion: 16.3.0
+    created: "2024-07-19T08:48:53.264436545Z"
+  - version: 15.5.0
+    created: "2024-06-11T10:16:40.031237186Z"
+  legacy:
+  - version: 1.0.0
+    deprecated: true
+generated: "2024-08-01T10:12:45Z"
+`
+
+func TestHelmSource_FetchPackageData(t *testing.T) {
+	requests := 0
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/bitnami/index.yaml", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte(index))
+	})
+	mux.HandleFunc("/v2/charts/rabbitmq/tags/list", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"name": "charts/rabbitmq", "tags": ["14.6.5", "14.6.6"]}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	source := NewHelmSource()
+	source.Repositories = map[string]string{"bitnami": server.URL + "/bitnami"}
+	source.Mirror = ""
+
+	t.Run("repository", func(t *testing.T) {
+		name := server.URL + "/bitnami/postgresql"
+
+		pkg, err := source.FetchPackageData(context.Background(), name, "15.5.0")
+		assert.NoError(t, err)
+		assert.Equal(t, types.Package{
+			Name: name,
+			Versions: map[string]types.PackageVersion{
+				"15.5.38": {Name: name, Version: "15.5.38"},
+				"15.5.0":  {Name: name, Version: "15.5.0"},
+			},
+			Time: map[string]time.Time{
+				"15.5.38": time.Date(2024, 7, 19, 8, 48, 53, 264436545, time.UTC),
+				"15.5.0":  time.Date(2024, 6, 11, 10, 16, 40, 31237186, time.UTC),
+			},
+			Downloads: []types.Download{},
+		}, pkg)
+	})
+
+	t.Run("repository alias", func(t *testing.T) {
+		pkg, err := source.FetchPackageData(context.Background(), "@bitnami/legacy", "1.0.0")
+		assert.NoError(t, err)
+		assert.Equal(t, "deprecated", pkg.Versions["1.0.0"].Deprecated)
+
+		// The index is shared by the charts of the repository
+		assert.Equal(t, 1, requests)
+	})
+
+	t.Run("mirror", func(t *testing.T) {
+		mirrored := source
+		mirrored.Mirror = server.URL + "/bitnami"
+
+		pkg, err := mirrored.FetchPackageData(context.Background(), "https://charts.example.com/postgresql", "15.5.0")
+		assert.NoError(t, err)
+		assert.Len(t, pkg.Versions, 2)
+	})
+
+	t.Run("OCI registry", func(t *testing.T) {
+		name := "oci://" + strings.TrimPrefix(server.URL, "http://") + "/charts/rabbitmq"
+
+		pkg, err := source.FetchPackageData(context.Background(), name, "14.6.6")
+		assert.NoError(t, err)
+		assert.Equal(t, name, pkg.Name)
+		assert.Equal(t, map[string]types.PackageVersion{
+			"14.6.5": {Name: name, Version: "14.6.5"},
+			"14.6.6": {Name: name, Version: "14.6.6"},
+		}, pkg.Versions)
+	})
+
+	t.Run("missing chart", func(t *testing.T) {
+		_, err := source.FetchPackageData(context.Background(), "@bitnami/missing", "1.0.0")
+		assert.ErrorIs(t, err, types.ErrPackageNotFound)
+	})
+
+	t.Run("unknown alias", func(t *testing.T) {
+		_, err := source.FetchPackageData(context.Background(), "@stable/mysql", "1.6.0")
+		assert.Error(t, err)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/depshubhq/depshub/pkg/licenses"
 	"github.com/depshubhq/depshub/pkg/types"
 	"net/http"
 	"time"
@@ -47,9 +48,8 @@ func (s HexSource) FetchPackageData(ctx context.Context, name string) (types.Pac
 	result.Versions = make(map[string]types.PackageVersion)
 	result.Time = make(map[string]time.Time)
 
-	if len(target.Metadata.Licenses) > 0 {
-		result.License = target.Metadata.Licenses[0]
-	}
+	// Hex only has the licenses of the latest release
+	result.License = licenses.Or(target.Metadata.Licenses)
 
 	result.Downloads = []types.Download{
 		{Day: time.Now().Format("2006-01-02"), Downloads: target.Downloads.Week},
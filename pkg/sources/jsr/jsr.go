@@ -0,0 +1,126 @@
+package jsr
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+const (
+	DefaultBaseURL = "https://jsr.io"
+	DefaultAPIURL  = "https://api.jsr.io"
+)
+
+// JSRSource reads packages from the JSR registry. BaseURL serves the package
+// metadata and APIURL the publish dates, they point to jsr.io by default.
+type JSRSource struct {
+	BaseURL string
+	APIURL  string
+	Client  *http.Client
+}
+
+type Version struct {
+	Yanked bool `json:"yanked"`
+}
+
+// https://jsr.io/docs/api#package-metadata
+type JSRPackage struct {
+	Scope    string             `json:"scope"`
+	Name     string             `json:"name"`
+	Latest   string             `json:"latest"`
+	Versions map[string]Version `json:"versions"`
+}
+
+// PackageVersion is a version listed by the management API
+type PackageVersion struct {
+	Version   string    `json:"version"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (s JSRSource) FetchPackageData(ctx context.Context, name string) (types.Package, error) {
+	var target JSRPackage
+	var result types.Package
+
+	scope, pkg, found := strings.Cut(strings.TrimPrefix(name, "@"), "/")
+	if !found || !strings.HasPrefix(name, "@") {
+		return types.Package{}, types.ErrPackageNotFound
+	}
+
+	baseURL := s.BaseURL
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+
+	if err := s.fetch(ctx, name, fmt.Sprintf("%s/@%s/%s/meta.json", strings.TrimSuffix(baseURL, "/"), scope, pkg), &target); err != nil {
+		return types.Package{}, err
+	}
+
+	// Convert JSRPackage to the generic types.Package
+	result.Name = name
+	result.Versions = make(map[string]types.PackageVersion)
+	result.Time = make(map[string]time.Time)
+	result.Downloads = []types.Download{}
+
+	for version, v := range target.Versions {
+		deprecated := ""
+		if v.Yanked {
+			deprecated = "yanked"
+		}
+
+		result.Versions[version] = types.PackageVersion{
+			Name:       name,
+			Version:    version,
+			Deprecated: deprecated,
+		}
+	}
+
+	apiURL := s.APIURL
+	if apiURL == "" {
+		apiURL = DefaultAPIURL
+	}
+
+	// The metadata doesn't have publish dates, they come from the management API
+	var versions []PackageVersion
+	if err := s.fetch(ctx, name, fmt.Sprintf("%s/scopes/%s/packages/%s/versions", strings.TrimSuffix(apiURL, "/"), scope, pkg), &versions); err == nil {
+		for _, v := range versions {
+			if !v.CreatedAt.IsZero() {
+				result.Time[v.Version] = v.CreatedAt
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func (s JSRSource) fetch(ctx context.Context, name string, url string, target any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request for %s information from JSR: %w", name, err)
+	}
+
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error getting %s information from JSR: %w", name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == 404 || resp.StatusCode == 405 {
+		return types.ErrPackageNotFound
+	}
+
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("error getting %s information from JSR: %s", name, resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(target)
+}
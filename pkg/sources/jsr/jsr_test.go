@@ -0,0 +1,69 @@
+package jsr
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/depshubhq/depshub/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSRSource_FetchPackageData(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/@std/path/meta.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{
+			"scope": "std",
+			"name": "path",
+			"latest": "1.0.8",
+			"versions": {
+				"1.0.8": {},
+				"1.0.7": {"yanked": true}
+			}
+		}`))
+	})
+	mux.HandleFunc("/scopes/std/packages/path/versions", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[
+			{"scope": "std", "package": "path", "version": "1.0.8", "createdAt": "2024-10-28T09:14:52.283Z"},
+			{"scope": "std", "package": "path", "version": "1.0.7", "yanked": true, "createdAt": "2024-10-17T04:02:48Z"}
+		]`))
+	})
+	mux.HandleFunc("/@luca/flag/meta.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"scope": "luca", "name": "flag", "latest": "1.0.1", "versions": {"1.0.1": {}}}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	source := JSRSource{BaseURL: server.URL, APIURL: server.URL}
+
+	pkg, err := source.FetchPackageData(context.Background(), "@std/path")
+	assert.NoError(t, err)
+
+	assert.Equal(t, types.Package{
+		Name: "@std/path",
+		Versions: map[string]types.PackageVersion{
+			"1.0.7": {Name: "@std/path", Version: "1.0.7", Deprecated: "yanked"},
+			"1.0.8": {Name: "@std/path", Version: "1.0.8"},
+		},
+		Time: map[string]time.Time{
+			"1.0.7": time.Date(2024, 10, 17, 4, 2, 48, 0, time.UTC),
+			"1.0.8": time.Date(2024, 10, 28, 9, 14, 52, 283000000, time.UTC),
+		},
+		Downloads: []types.Download{},
+	}, pkg)
+
+	// The publish dates are optional
+	pkg, err = source.FetchPackageData(context.Background(), "@luca/flag")
+	assert.NoError(t, err)
+	assert.Contains(t, pkg.Versions, "1.0.1")
+	assert.Empty(t, pkg.Time)
+
+	_, err = source.FetchPackageData(context.Background(), "@std/missing")
+	assert.ErrorIs(t, err, types.ErrPackageNotFound)
+
+	_, err = source.FetchPackageData(context.Background(), "unscoped")
+	assert.ErrorIs(t, err, types.ErrPackageNotFound)
+}
@@ -3,6 +3,7 @@ package maven
 import (
 	"time"
 
+	"github.com/depshubhq/depshub/pkg/licenses"
 	"github.com/depshubhq/depshub/pkg/types"
 	"github.com/edoardottt/depsdev/pkg/depsdev"
 )
@@ -18,14 +19,7 @@ func (MavenSource) FetchPackageData(name string, version string) (types.Package,
 		return target, err
 	}
 
-	v, err := depsdev.NewAPI().GetVersion("maven", name, version)
-
-	if err != nil {
-		return target, err
-	}
-
 	target.Name = name
-	target.License = v.Licenses[0]
 	target.Versions = make(map[string]types.PackageVersion)
 	target.Time = make(map[string]time.Time)
 
@@ -44,5 +38,18 @@ func (MavenSource) FetchPackageData(name string, version string) (types.Package,
 		}
 	}
 
+	// deps.dev only has the licenses of a single version, they are optional.
+	// The license of the package is the one of the default version.
+	if version != "" {
+		if v, err := depsdev.NewAPI().GetVersion("maven", name, version); err == nil {
+			license := licenses.And(v.Licenses)
+
+			target.SetVersionLicense(version, license)
+			if v.IsDefault {
+				target.License = license
+			}
+		}
+	}
+
 	return target, nil
 }
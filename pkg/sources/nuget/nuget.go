@@ -0,0 +1,159 @@
+package nuget
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+const registrationBaseURL = "https://api.nuget.org/v3/registration5-gz-semver2"
+
+type NuGetSource struct{}
+
+type AlternatePackage struct {
+	ID    string `json:"id"`
+	Range string `json:"range"`
+}
+
+type Deprecation struct {
+	Reasons          []string          `json:"reasons"`
+	Message          string            `json:"message"`
+	AlternatePackage *AlternatePackage `json:"alternatePackage"`
+}
+
+type CatalogEntry struct {
+	ID                string       `json:"id"`
+	Version           string       `json:"version"`
+	Listed            *bool        `json:"listed"`
+	Published         time.Time    `json:"published"`
+	LicenseExpression string       `json:"licenseExpression"`
+	Deprecation       *Deprecation `json:"deprecation"`
+}
+
+type Leaf struct {
+	CatalogEntry CatalogEntry `json:"catalogEntry"`
+}
+
+// Pages of large packages don't include their leaves, which have to be fetched from @id
+type Page struct {
+	ID    string `json:"@id"`
+	Items []Leaf `json:"items"`
+}
+
+// https://learn.microsoft.com/en-us/nuget/api/registration-base-url-resource
+type RegistrationIndex struct {
+	Items []Page `json:"items"`
+}
+
+func (s NuGetSource) FetchPackageData(ctx context.Context, name string) (types.Package, error) {
+	var index RegistrationIndex
+	var result types.Package
+
+	url := fmt.Sprintf("%s/%s/index.json", registrationBaseURL, strings.ToLower(name))
+	if err := s.fetch(ctx, name, url, &index); err != nil {
+		return types.Package{}, err
+	}
+
+	// Convert the registration to the generic types.Package
+	// Keep the requested name since NuGet package IDs are case insensitive
+	result.Name = name
+	result.Versions = make(map[string]types.PackageVersion)
+	result.Time = make(map[string]time.Time)
+
+	// NuGet doesn't provide download counts in the registration
+	result.Downloads = []types.Download{}
+
+	var latest time.Time
+
+	for _, page := range index.Items {
+		leaves := page.Items
+
+		if leaves == nil && page.ID != "" {
+			var p Page
+			if err := s.fetch(ctx, name, page.ID, &p); err != nil {
+				return types.Package{}, err
+			}
+			leaves = p.Items
+		}
+
+		for _, leaf := range leaves {
+			entry := leaf.CatalogEntry
+			version := entry.Version
+
+			result.Versions[version] = types.PackageVersion{
+				Name:       name,
+				Version:    version,
+				Deprecated: deprecation(entry),
+				License:    entry.LicenseExpression,
+			}
+
+			// Unlisted packages used to be published in 1900
+			if entry.Published.Year() > 1900 {
+				result.Time[version] = entry.Published
+			}
+
+			// Use the license of the latest release
+			if entry.LicenseExpression != "" && entry.Published.After(latest) {
+				latest = entry.Published
+				result.License = entry.LicenseExpression
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// deprecation describes why a version shouldn't be used, or returns an empty string.
+func deprecation(entry CatalogEntry) string {
+	if entry.Deprecation != nil {
+		message := "deprecated"
+
+		if len(entry.Deprecation.Reasons) > 0 {
+			message = fmt.Sprintf("deprecated (%s)", strings.Join(entry.Deprecation.Reasons, ", "))
+		}
+
+		if entry.Deprecation.Message != "" {
+			message = fmt.Sprintf("%s: %s", message, entry.Deprecation.Message)
+		}
+
+		if entry.Deprecation.AlternatePackage != nil && entry.Deprecation.AlternatePackage.ID != "" {
+			message = fmt.Sprintf("%s, use %s instead", message, entry.Deprecation.AlternatePackage.ID)
+		}
+
+		return message
+	}
+
+	if entry.Listed != nil && !*entry.Listed {
+		return "unlisted"
+	}
+
+	return ""
+}
+
+func (NuGetSource) fetch(ctx context.Context, name string, url string, target any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request for %s information from NuGet registry: %w", name, err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error getting %s information from NuGet registry: %w", name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == 404 || resp.StatusCode == 405 {
+		return types.ErrPackageNotFound
+	}
+
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("error getting %s information from NuGet registry: %s", name, resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(target)
+}
@@ -0,0 +1,378 @@
+package oci
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+const DockerHub = "registry-1.docker.io"
+
+// Pages of tags to follow, large repositories have thousands of tags
+const maxPages = 10
+
+var (
+	linkPattern      = regexp.MustCompile(`<([^>]+)>;\s*rel="next"`)
+	challengePattern = regexp.MustCompile(`(\w+)="([^"]*)"`)
+)
+
+var manifestTypes = []string{
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+}
+
+type Credential struct {
+	Username string
+	Password string
+}
+
+// OCISource reads the tags of container images from registries implementing
+// the OCI distribution specification.
+type OCISource struct {
+	// The registry of images without a host like node:20, Docker Hub by default
+	DefaultRegistry string
+	// Credentials keyed by registry host
+	Credentials map[string]Credential
+	Client      *http.Client
+}
+
+// NewOCISource uses the registry from DEPSHUB_REGISTRY, if any, and the
+// credentials stored by `docker login`.
+func NewOCISource() OCISource {
+	return OCISource{
+		DefaultRegistry: os.Getenv("DEPSHUB_REGISTRY"),
+		Credentials:     dockerCredentials(),
+	}
+}
+
+type TagList struct {
+	Name string   `json:"name"`
+	Tags []string `json:"tags"`
+}
+
+type Descriptor struct {
+	MediaType string `json:"mediaType"`
+	Digest    string `json:"digest"`
+	Platform  *struct {
+		Architecture string `json:"architecture"`
+		OS           string `json:"os"`
+	} `json:"platform"`
+}
+
+type Manifest struct {
+	MediaType string       `json:"mediaType"`
+	Config    Descriptor   `json:"config"`
+	Manifests []Descriptor `json:"manifests"`
+}
+
+type ImageConfig struct {
+	Created time.Time `json:"created"`
+	Config  struct {
+		Labels map[string]string `json:"Labels"`
+	} `json:"config"`
+}
+
+// FetchPackageData returns the tags of the image. The creation date and the
+// license are only fetched for the tag in use, since it takes several requests
+// per tag.
+func (s OCISource) FetchPackageData(ctx context.Context, name string, version string) (types.Package, error) {
+	host, repository := s.split(name)
+	r := &registry{source: s, host: host, repository: repository}
+
+	var result types.Package
+	result.Name = name
+	result.Versions = make(map[string]types.PackageVersion)
+	result.Time = make(map[string]time.Time)
+	result.Downloads = []types.Download{}
+
+	next := fmt.Sprintf("/v2/%s/tags/list?n=1000", repository)
+	for page := 0; next != "" && page < maxPages; page++ {
+		var tags TagList
+
+		resp, err := r.get(ctx, next, "", &tags)
+		if err != nil {
+			return types.Package{}, err
+		}
+
+		for _, tag := range tags.Tags {
+			result.Versions[tag] = types.PackageVersion{Name: name, Version: tag}
+		}
+
+		next = ""
+		if matches := linkPattern.FindStringSubmatch(resp.Header.Get("Link")); matches != nil {
+			next = matches[1]
+		}
+	}
+
+	if _, ok := result.Versions[version]; ok {
+		if config, err := r.config(ctx, version); err == nil {
+			if !config.Created.IsZero() {
+				result.Time[version] = config.Created
+			}
+			// Tags are fetched one at a time, so the license is only known for this one
+			result.SetVersionLicense(version, config.Config.Labels["org.opencontainers.image.licenses"])
+		}
+	}
+
+	return result, nil
+}
+
+// split returns the registry host and the repository of an image name.
+func (s OCISource) split(name string) (host string, repository string) {
+	first, rest, found := strings.Cut(name, "/")
+	if found && (strings.ContainsAny(first, ".:") || first == "localhost") {
+		if first == "docker.io" || first == "index.docker.io" {
+			first = DockerHub
+		}
+		host, repository = first, rest
+	} else {
+		host = s.DefaultRegistry
+		if host == "" {
+			host = DockerHub
+		}
+		repository = name
+	}
+
+	// Official images live in the library namespace, on Docker Hub and its mirrors
+	if (host == DockerHub || host == s.DefaultRegistry) && !strings.Contains(repository, "/") {
+		repository = "library/" + repository
+	}
+
+	return host, repository
+}
+
+// registry sends authenticated requests for a single repository.
+type registry struct {
+	source     OCISource
+	host       string
+	repository string
+	token      string
+}
+
+// config returns the image configuration of a tag, using the linux/amd64 image of multi-platform tags.
+func (r *registry) config(ctx context.Context, tag string) (ImageConfig, error) {
+	var manifest Manifest
+	var config ImageConfig
+
+	accept := strings.Join(manifestTypes, ", ")
+
+	if _, err := r.get(ctx, fmt.Sprintf("/v2/%s/manifests/%s", r.repository, tag), accept, &manifest); err != nil {
+		return config, err
+	}
+
+	if len(manifest.Manifests) > 0 {
+		digest := manifest.Manifests[0].Digest
+		for _, m := range manifest.Manifests {
+			if m.Platform != nil && m.Platform.OS == "linux" && m.Platform.Architecture == "amd64" {
+				digest = m.Digest
+				break
+			}
+		}
+
+		manifest = Manifest{}
+		if _, err := r.get(ctx, fmt.Sprintf("/v2/%s/manifests/%s", r.repository, digest), accept, &manifest); err != nil {
+			return config, err
+		}
+	}
+
+	if manifest.Config.Digest == "" {
+		return config, fmt.Errorf("no image configuration for %s:%s", r.repository, tag)
+	}
+
+	_, err := r.get(ctx, fmt.Sprintf("/v2/%s/blobs/%s", r.repository, manifest.Config.Digest), "", &config)
+	return config, err
+}
+
+func (r *registry) get(ctx context.Context, path string, accept string, target any) (*http.Response, error) {
+	client := r.source.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	// Links to the next page of tags are usually relative to the registry
+	endpoint := path
+	if !strings.HasPrefix(path, "http://") && !strings.HasPrefix(path, "https://") {
+		endpoint = r.baseURL() + path
+	}
+
+	for attempt := 0; attempt < 2; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error creating request for %s information from %s: %w", r.repository, r.host, err)
+		}
+
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+
+		credential, hasCredential := r.source.Credentials[r.host]
+		if r.token != "" {
+			req.Header.Set("Authorization", "Bearer "+r.token)
+		} else if hasCredential {
+			req.SetBasicAuth(credential.Username, credential.Password)
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("error getting %s information from %s: %w", r.repository, r.host, err)
+		}
+
+		// Get a token for the repository and try again
+		if resp.StatusCode == http.StatusUnauthorized && attempt == 0 && r.token == "" {
+			challenge := resp.Header.Get("WWW-Authenticate")
+			resp.Body.Close()
+
+			if !strings.HasPrefix(strings.ToLower(challenge), "bearer ") {
+				return nil, fmt.Errorf("error getting %s information from %s: %s", r.repository, r.host, resp.Status)
+			}
+
+			if err := r.authenticate(ctx, client, challenge); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		defer resp.Body.Close()
+
+		if resp.StatusCode == 404 || resp.StatusCode == 405 {
+			return nil, types.ErrPackageNotFound
+		}
+
+		if resp.StatusCode != 200 {
+			return nil, fmt.Errorf("error getting %s information from %s: %s", r.repository, r.host, resp.Status)
+		}
+
+		if err := json.NewDecoder(resp.Body).Decode(target); err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	}
+
+	return nil, fmt.Errorf("error getting %s information from %s: unauthorized", r.repository, r.host)
+}
+
+// authenticate gets a bearer token as described by the WWW-Authenticate challenge.
+func (r *registry) authenticate(ctx context.Context, client *http.Client, challenge string) error {
+	params := make(map[string]string)
+	for _, match := range challengePattern.FindAllStringSubmatch(challenge, -1) {
+		params[match[1]] = match[2]
+	}
+
+	realm, err := url.Parse(params["realm"])
+	if err != nil || params["realm"] == "" {
+		return fmt.Errorf("invalid authentication challenge from %s", r.host)
+	}
+
+	query := realm.Query()
+	if params["service"] != "" {
+		query.Set("service", params["service"])
+	}
+	query.Set("scope", fmt.Sprintf("repository:%s:pull", r.repository))
+	realm.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	if credential, ok := r.source.Credentials[r.host]; ok {
+		req.SetBasicAuth(credential.Username, credential.Password)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error authenticating to %s: %w", r.host, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("error authenticating to %s: %s", r.host, resp.Status)
+	}
+
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return err
+	}
+
+	r.token = token.Token
+	if r.token == "" {
+		r.token = token.AccessToken
+	}
+
+	return nil
+}
+
+func (r *registry) baseURL() string {
+	// Local registries usually don't use TLS
+	if strings.HasPrefix(r.host, "localhost") || strings.HasPrefix(r.host, "127.0.0.1") {
+		return "http://" + r.host
+	}
+	return "https://" + r.host
+}
+
+// dockerCredentials reads the credentials stored in ~/.docker/config.json.
+// Credential helpers aren't supported.
+func dockerCredentials() map[string]Credential {
+	credentials := make(map[string]Credential)
+
+	dir := os.Getenv("DOCKER_CONFIG")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return credentials
+		}
+		dir = filepath.Join(home, ".docker")
+	}
+
+	file, err := os.ReadFile(filepath.Join(dir, "config.json"))
+	if err != nil {
+		return credentials
+	}
+
+	var config struct {
+		Auths map[string]struct {
+			Auth string `json:"auth"`
+		} `json:"auths"`
+	}
+	if err := json.Unmarshal(file, &config); err != nil {
+		return credentials
+	}
+
+	for server, auth := range config.Auths {
+		decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+		if err != nil {
+			continue
+		}
+
+		username, password, ok := strings.Cut(string(decoded), ":")
+		if !ok {
+			continue
+		}
+
+		host := strings.TrimPrefix(strings.TrimPrefix(server, "https://"), "http://")
+		host, _, _ = strings.Cut(host, "/")
+		if host == "index.docker.io" || host == "docker.io" {
+			host = DockerHub
+		}
+
+		credentials[host] = Credential{Username: username, Password: password}
+	}
+
+	return credentials
+}
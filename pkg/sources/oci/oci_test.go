@@ -0,0 +1,107 @@
+package oci
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/depshubhq/depshub/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOCISource_FetchPackageData(t *testing.T) {
+	var server *httptest.Server
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "user", username)
+		assert.Equal(t, "secret", password)
+		assert.Regexp(t, `^repository:team/\w+:pull$`, r.URL.Query().Get("scope"))
+		w.Write([]byte(`{"token": "abc"}`))
+	})
+	mux.HandleFunc("/v2/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer abc" {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="`+server.URL+`/token",service="registry"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		switch {
+		case r.URL.Path == "/v2/team/app/tags/list" && r.URL.Query().Get("last") == "":
+			w.Header().Set("Link", `</v2/team/app/tags/list?n=1000&last=1.0>; rel="next"`)
+			w.Write([]byte(`{"name": "team/app", "tags": ["1.0"]}`))
+		case r.URL.Path == "/v2/team/app/tags/list":
+			w.Write([]byte(`{"name": "team/app", "tags": ["1.1", "latest"]}`))
+		case r.URL.Path == "/v2/team/app/manifests/1.1":
+			assert.True(t, strings.Contains(r.Header.Get("Accept"), "application/vnd.oci.image.index.v1+json"))
+			w.Write([]byte(`{"mediaType": "application/vnd.oci.image.index.v1+json", "manifests": [
+				{"digest": "sha256:arm", "platform": {"os": "linux", "architecture": "arm64"}},
+				{"digest": "sha256:amd", "platform": {"os": "linux", "architecture": "amd64"}}
+			]}`))
+		case r.URL.Path == "/v2/team/app/manifests/sha256:amd":
+			w.Write([]byte(`{"mediaType": "application/vnd.oci.image.manifest.v1+json", "config": {"digest": "sha256:config"}}`))
+		case r.URL.Path == "/v2/team/app/blobs/sha256:config":
+			w.Write([]byte(`{"created": "2024-09-01T12:00:00Z", "config": {"Labels": {"org.opencontainers.image.licenses": "Apache-2.0"}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	server = httptest.NewServer(mux)
+	defer server.Close()
+
+	host := strings.TrimPrefix(server.URL, "http://")
+	source := OCISource{
+		Credentials: map[string]Credential{host: {Username: "user", Password: "secret"}},
+	}
+
+	name := host + "/team/app"
+	pkg, err := source.FetchPackageData(context.Background(), name, "1.1")
+	assert.NoError(t, err)
+
+	assert.Equal(t, types.Package{
+		Name: name,
+		Versions: map[string]types.PackageVersion{
+			"1.0":    {Name: name, Version: "1.0"},
+			"1.1":    {Name: name, Version: "1.1", License: "Apache-2.0"},
+			"latest": {Name: name, Version: "latest"},
+		},
+		Time: map[string]time.Time{
+			"1.1": time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC),
+		},
+		Downloads: []types.Download{},
+	}, pkg)
+
+	_, err = source.FetchPackageData(context.Background(), host+"/team/missing", "1.0")
+	assert.ErrorIs(t, err, types.ErrPackageNotFound)
+}
+
+func TestOCISource_split(t *testing.T) {
+	tests := []struct {
+		name            string
+		defaultRegistry string
+		host            string
+		repository      string
+	}{
+		{name: "node", host: DockerHub, repository: "library/node"},
+		{name: "bitnami/redis", host: DockerHub, repository: "bitnami/redis"},
+		{name: "docker.io/library/node", host: DockerHub, repository: "library/node"},
+		{name: "ghcr.io/example/app", host: "ghcr.io", repository: "example/app"},
+		{name: "localhost:5000/tools", host: "localhost:5000", repository: "tools"},
+		{name: "team/app", defaultRegistry: "registry.example.com", host: "registry.example.com", repository: "team/app"},
+		{name: "node", defaultRegistry: "mirror.example.com", host: "mirror.example.com", repository: "library/node"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			host, repository := OCISource{DefaultRegistry: tt.defaultRegistry}.split(tt.name)
+			assert.Equal(t, tt.host, host)
+			assert.Equal(t, tt.repository, repository)
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package packagist
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/depshubhq/depshub/pkg/licenses"
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+type PackagistSource struct{}
+
+type Downloads struct {
+	Total   int `json:"total"`
+	Monthly int `json:"monthly"`
+	Daily   int `json:"daily"`
+}
+
+type Version struct {
+	Version string   `json:"version"`
+	License []string `json:"license"`
+	Time    string   `json:"time"`
+}
+
+type Package struct {
+	Name      string             `json:"name"`
+	Downloads Downloads          `json:"downloads"`
+	Versions  map[string]Version `json:"versions"`
+	// Either false or the name of the suggested replacement, which may be empty
+	Abandoned any `json:"abandoned"`
+}
+
+// https://packagist.org/apidoc#get-package-data
+type PackagistPackage struct {
+	Package Package `json:"package"`
+}
+
+func (s PackagistSource) FetchPackageData(ctx context.Context, name string) (types.Package, error) {
+	var target PackagistPackage
+	var result types.Package
+
+	if err := s.fetchPackageInfo(ctx, name, &target); err != nil {
+		return types.Package{}, err
+	}
+
+	// Convert PackagistPackage to the generic types.Package
+	// Keep the requested name since Composer package names are case insensitive
+	result.Name = name
+	result.Versions = make(map[string]types.PackageVersion)
+	result.Time = make(map[string]time.Time)
+
+	// Packagist provides daily and monthly downloads, estimate the weekly ones from the last 30 days
+	result.Downloads = []types.Download{
+		{Day: time.Now().Format("2006-01-02"), Downloads: target.Package.Downloads.Monthly * 7 / 30},
+	}
+
+	deprecated := ""
+	switch abandoned := target.Package.Abandoned.(type) {
+	case bool:
+		if abandoned {
+			deprecated = "abandoned"
+		}
+	case string:
+		deprecated = "abandoned"
+		if abandoned != "" {
+			deprecated = fmt.Sprintf("abandoned, use %s instead", abandoned)
+		}
+	}
+
+	var latest time.Time
+
+	for _, v := range target.Package.Versions {
+		// Skip branches like dev-main or 1.x-dev
+		if strings.HasPrefix(v.Version, "dev-") || strings.HasSuffix(v.Version, "-dev") {
+			continue
+		}
+
+		version := strings.TrimPrefix(v.Version, "v")
+
+		// Composer lists the licenses the package can be used under
+		result.Versions[version] = types.PackageVersion{
+			Name:       name,
+			Version:    version,
+			Deprecated: deprecated,
+			License:    licenses.Or(v.License),
+		}
+
+		releaseTime, err := time.Parse(time.RFC3339, v.Time)
+		if err != nil {
+			continue
+		}
+
+		result.Time[version] = releaseTime
+
+		// Use the license of the latest release
+		if releaseTime.After(latest) && len(v.License) > 0 {
+			latest = releaseTime
+			result.License = licenses.Or(v.License)
+		}
+	}
+
+	return result, nil
+}
+
+func (PackagistSource) fetchPackageInfo(ctx context.Context, name string, target *PackagistPackage) error {
+	url := fmt.Sprintf("https://packagist.org/packages/%s.json", strings.ToLower(name))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request for %s information from Packagist registry: %w", name, err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error getting %s information from Packagist registry: %w", name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == 404 || resp.StatusCode == 405 {
+		return types.ErrPackageNotFound
+	}
+
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("error getting %s information from Packagist registry: %s", name, resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(target)
+}
@@ -0,0 +1,133 @@
+package pub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+const DefaultBaseURL = "https://pub.dev"
+
+// PubSource reads Dart and Flutter packages from repositories implementing the
+// hosted pub repository specification. BaseURL points to pub.dev by default.
+type PubSource struct {
+	BaseURL string
+	Client  *http.Client
+}
+
+// NewPubSource uses the repository from PUB_HOSTED_URL like the dart tool does.
+func NewPubSource() PubSource {
+	return PubSource{
+		BaseURL: os.Getenv("PUB_HOSTED_URL"),
+	}
+}
+
+type Version struct {
+	Version   string    `json:"version"`
+	Retracted bool      `json:"retracted"`
+	Published time.Time `json:"published"`
+}
+
+// https://github.com/dart-lang/pub/blob/master/doc/repository-spec-v2.md
+type PubPackage struct {
+	Name           string    `json:"name"`
+	IsDiscontinued bool      `json:"isDiscontinued"`
+	ReplacedBy     string    `json:"replacedBy"`
+	Versions       []Version `json:"versions"`
+}
+
+// Score is only available on pub.dev
+type Score struct {
+	DownloadCount30Days int `json:"downloadCount30Days"`
+}
+
+func (s PubSource) FetchPackageData(ctx context.Context, name string) (types.Package, error) {
+	var target PubPackage
+	var result types.Package
+
+	if err := s.fetch(ctx, name, fmt.Sprintf("/api/packages/%s", name), &target); err != nil {
+		return types.Package{}, err
+	}
+
+	// Convert PubPackage to the generic types.Package
+	result.Name = name
+	result.Versions = make(map[string]types.PackageVersion)
+	result.Time = make(map[string]time.Time)
+	result.Downloads = []types.Download{}
+
+	discontinued := ""
+	if target.IsDiscontinued {
+		discontinued = "discontinued"
+		if target.ReplacedBy != "" {
+			discontinued = fmt.Sprintf("discontinued, use %s instead", target.ReplacedBy)
+		}
+	}
+
+	for _, v := range target.Versions {
+		deprecated := discontinued
+		if v.Retracted {
+			deprecated = "retracted"
+		}
+
+		result.Versions[v.Version] = types.PackageVersion{
+			Name:       name,
+			Version:    v.Version,
+			Deprecated: deprecated,
+		}
+
+		if !v.Published.IsZero() {
+			result.Time[v.Version] = v.Published
+		}
+	}
+
+	// pub.dev provides the downloads of the last 30 days, estimate the weekly ones
+	var score Score
+	if err := s.fetch(ctx, name, fmt.Sprintf("/api/packages/%s/score", name), &score); err == nil && score.DownloadCount30Days > 0 {
+		result.Downloads = []types.Download{
+			{Day: time.Now().Format("2006-01-02"), Downloads: score.DownloadCount30Days * 7 / 30},
+		}
+	}
+
+	return result, nil
+}
+
+func (s PubSource) fetch(ctx context.Context, name string, path string, target any) error {
+	baseURL := s.BaseURL
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(baseURL, "/")+path, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request for %s information from pub repository: %w", name, err)
+	}
+
+	req.Header.Set("Accept", "application/vnd.pub.v2+json")
+
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error getting %s information from pub repository: %w", name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == 404 || resp.StatusCode == 405 {
+		return types.ErrPackageNotFound
+	}
+
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("error getting %s information from pub repository: %s", name, resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(target)
+}
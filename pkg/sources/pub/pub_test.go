@@ -0,0 +1,70 @@
+package pub
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/depshubhq/depshub/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPubSource_FetchPackageData(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/packages/http", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/vnd.pub.v2+json", r.Header.Get("Accept"))
+		w.Write([]byte(`{
+			"name": "http",
+			"isDiscontinued": false,
+			"versions": [
+				{"version": "1.2.0", "retracted": true, "published": "2024-01-23T18:20:11.000Z"},
+				{"version": "1.2.1", "published": "2024-02-14T21:02:05.000Z"}
+			]
+		}`))
+	})
+	mux.HandleFunc("/api/packages/http/score", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"downloadCount30Days": 3000}`))
+	})
+	mux.HandleFunc("/api/packages/pedantic", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{
+			"name": "pedantic",
+			"isDiscontinued": true,
+			"replacedBy": "lints",
+			"versions": [{"version": "1.11.1"}]
+		}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	source := PubSource{BaseURL: server.URL}
+
+	pkg, err := source.FetchPackageData(context.Background(), "http")
+	assert.NoError(t, err)
+
+	assert.Equal(t, types.Package{
+		Name: "http",
+		Versions: map[string]types.PackageVersion{
+			"1.2.0": {Name: "http", Version: "1.2.0", Deprecated: "retracted"},
+			"1.2.1": {Name: "http", Version: "1.2.1"},
+		},
+		Time: map[string]time.Time{
+			"1.2.0": time.Date(2024, 1, 23, 18, 20, 11, 0, time.UTC),
+			"1.2.1": time.Date(2024, 2, 14, 21, 2, 5, 0, time.UTC),
+		},
+		Downloads: []types.Download{
+			{Day: time.Now().Format("2006-01-02"), Downloads: 700},
+		},
+	}, pkg)
+
+	// Repositories other than pub.dev don't have scores
+	pkg, err = source.FetchPackageData(context.Background(), "pedantic")
+	assert.NoError(t, err)
+	assert.Equal(t, "discontinued, use lints instead", pkg.Versions["1.11.1"].Deprecated)
+	assert.Empty(t, pkg.Downloads)
+
+	_, err = source.FetchPackageData(context.Background(), "missing")
+	assert.ErrorIs(t, err, types.ErrPackageNotFound)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"github.com/depshubhq/depshub/pkg/types"
 	"net/http"
+	"strings"
 	"time"
 )
 
@@ -23,13 +24,16 @@ type Release struct {
 }
 
 type Info struct {
-	Author       string   `json:"author"`
-	Description  string   `json:"description"`
-	License      string   `json:"license"`
-	Name         string   `json:"name"`
-	Summary      string   `json:"summary"`
-	Version      string   `json:"version"`
-	RequiresDist []string `json:"requires_dist"`
+	Author      string `json:"author"`
+	Description string `json:"description"`
+	License     string `json:"license"`
+	// The SPDX expression of the packages using PEP 639
+	LicenseExpression string   `json:"license_expression"`
+	Classifiers       []string `json:"classifiers"`
+	Name              string   `json:"name"`
+	Summary           string   `json:"summary"`
+	Version           string   `json:"version"`
+	RequiresDist      []string `json:"requires_dist"`
 }
 
 type PyPIPackage struct {
@@ -37,17 +41,20 @@ type PyPIPackage struct {
 	Releases map[string][]Release `json:"releases"`
 }
 
-func (s PyPISource) FetchPackageData(ctx context.Context, name string) (types.Package, error) {
+// FetchPackageData returns the package information. The metadata of PyPI
+// packages only describes the latest version, the license of the used version
+// is fetched separately.
+func (s PyPISource) FetchPackageData(ctx context.Context, name string, version string) (types.Package, error) {
 	var target PyPIPackage
 	var result types.Package
 
-	if err := s.fetchPackageInfo(ctx, name, &target); err != nil {
+	if err := s.fetchPackageInfo(ctx, fmt.Sprintf("https://pypi.org/pypi/%s/json", name), name, &target); err != nil {
 		return types.Package{}, err
 	}
 
 	// Convert PyPIPackage to the generic types.Package
 	result.Name = target.Info.Name
-	result.License = target.Info.License
+	result.License = license(target.Info)
 	result.Versions = make(map[string]types.PackageVersion)
 	result.Time = make(map[string]time.Time)
 
@@ -76,6 +83,15 @@ func (s PyPISource) FetchPackageData(ctx context.Context, name string) (types.Pa
 		}
 	}
 
+	result.SetVersionLicense(target.Info.Version, license(target.Info))
+
+	if _, ok := result.Versions[version]; ok && version != target.Info.Version {
+		var release PyPIPackage
+		if err := s.fetchPackageInfo(ctx, fmt.Sprintf("https://pypi.org/pypi/%s/%s/json", name, version), name, &release); err == nil {
+			result.SetVersionLicense(version, license(release.Info))
+		}
+	}
+
 	// PyPI doesn't provide direct download counts in the API response
 	// You might want to fetch this separately if needed
 	result.Downloads = []types.Download{}
@@ -83,8 +99,43 @@ func (s PyPISource) FetchPackageData(ctx context.Context, name string) (types.Pa
 	return result, nil
 }
 
-func (PyPISource) fetchPackageInfo(ctx context.Context, name string, target *PyPIPackage) error {
-	url := fmt.Sprintf("https://pypi.org/pypi/%s/json", name)
+// license returns the license expression of a package. Older packages only have
+// a free text field, which sometimes contains the whole license text, and
+// license classifiers like "License :: OSI Approved :: MIT License".
+func license(info Info) string {
+	if info.LicenseExpression != "" {
+		return info.LicenseExpression
+	}
+
+	if l := strings.TrimSpace(info.License); l != "" && len(l) <= 100 && !strings.Contains(l, "\n") {
+		return l
+	}
+
+	var classifiers []string
+	for _, classifier := range info.Classifiers {
+		parts := strings.Split(classifier, "::")
+		if len(parts) < 2 || strings.TrimSpace(parts[0]) != "License" {
+			continue
+		}
+
+		// Skip the categories like "License :: OSI Approved"
+		name := strings.TrimSpace(parts[len(parts)-1])
+		if len(parts) == 2 && name == "OSI Approved" {
+			continue
+		}
+
+		classifiers = append(classifiers, name)
+	}
+
+	// Multiple classifiers are the licenses users can choose from
+	if len(classifiers) > 0 {
+		return strings.Join(classifiers, " OR ")
+	}
+
+	return strings.TrimSpace(info.License)
+}
+
+func (PyPISource) fetchPackageInfo(ctx context.Context, url string, name string, target *PyPIPackage) error {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return fmt.Errorf("error creating request for %s information from PyPI registry: %w", name, err)
@@ -0,0 +1,50 @@
+package pypi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLicense(t *testing.T) {
+	tests := []struct {
+		name     string
+		info     Info
+		expected string
+	}{
+		{
+			name:     "license expression",
+			info:     Info{LicenseExpression: "MIT OR Apache-2.0", License: "MIT"},
+			expected: "MIT OR Apache-2.0",
+		},
+		{
+			name:     "license field",
+			info:     Info{License: "BSD License", Classifiers: []string{"License :: OSI Approved :: BSD License"}},
+			expected: "BSD License",
+		},
+		{
+			name: "license text",
+			info: Info{
+				License: "Copyright (c) 2024\n\nPermission is hereby granted, free of charge...",
+				Classifiers: []string{
+					"Programming Language :: Python :: 3",
+					"License :: OSI Approved",
+					"License :: OSI Approved :: MIT License",
+					"License :: OSI Approved :: Apache Software License",
+				},
+			},
+			expected: "MIT License OR Apache Software License",
+		},
+		{
+			name:     "no license",
+			info:     Info{},
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, license(tt.info))
+		})
+	}
+}
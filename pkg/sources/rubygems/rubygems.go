@@ -0,0 +1,123 @@
+package rubygems
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/depshubhq/depshub/pkg/licenses"
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+const DefaultBaseURL = "https://rubygems.org"
+
+// RubyGemsSource reads gems from rubygems.org, or from BaseURL when it is set.
+type RubyGemsSource struct {
+	BaseURL string
+}
+
+// https://guides.rubygems.org/rubygems-org-api/#gem-methods
+type Gem struct {
+	Name      string   `json:"name"`
+	Downloads int      `json:"downloads"`
+	Version   string   `json:"version"`
+	Licenses  []string `json:"licenses"`
+}
+
+type Version struct {
+	Number     string    `json:"number"`
+	Platform   string    `json:"platform"`
+	Licenses   []string  `json:"licenses"`
+	Prerelease bool      `json:"prerelease"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// FetchPackageData returns the gem information. RubyGems doesn't list yanked
+// versions, so the version locked in Gemfile.lock, if any, is reported as
+// yanked when RubyGems confirms it doesn't exist anymore.
+func (s RubyGemsSource) FetchPackageData(ctx context.Context, name string, lockedVersion string) (types.Package, error) {
+	var gem Gem
+	var versions []Version
+	var result types.Package
+
+	if err := s.fetch(ctx, name, fmt.Sprintf("/api/v1/gems/%s.json", name), &gem); err != nil {
+		return types.Package{}, err
+	}
+
+	if err := s.fetch(ctx, name, fmt.Sprintf("/api/v1/versions/%s.json", name), &versions); err != nil {
+		return types.Package{}, err
+	}
+
+	// Convert the gem to the generic types.Package
+	result.Name = gem.Name
+	result.Versions = make(map[string]types.PackageVersion)
+	result.Time = make(map[string]time.Time)
+
+	result.License = licenses.Or(gem.Licenses)
+
+	// RubyGems only provides the total number of downloads
+	result.Downloads = []types.Download{
+		{Day: time.Now().Format("2006-01-02"), Downloads: gem.Downloads},
+	}
+
+	for _, v := range versions {
+		// Native gems are published once per platform
+		if _, ok := result.Versions[v.Number]; ok && v.Platform != "ruby" {
+			continue
+		}
+
+		result.Versions[v.Number] = types.PackageVersion{
+			Name:    gem.Name,
+			Version: v.Number,
+			License: licenses.Or(v.Licenses),
+		}
+
+		result.Time[v.Number] = v.CreatedAt
+	}
+
+	if _, ok := result.Versions[lockedVersion]; lockedVersion != "" && !ok {
+		var v Version
+		err := s.fetch(ctx, name, fmt.Sprintf("/api/v2/rubygems/%s/versions/%s.json", name, lockedVersion), &v)
+
+		if errors.Is(err, types.ErrPackageNotFound) {
+			result.Versions[lockedVersion] = types.PackageVersion{
+				Name:       gem.Name,
+				Version:    lockedVersion,
+				Deprecated: "yanked",
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func (s RubyGemsSource) fetch(ctx context.Context, name string, path string, target any) error {
+	baseURL := s.BaseURL
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+path, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request for %s information from RubyGems registry: %w", name, err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error getting %s information from RubyGems registry: %w", name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == 404 || resp.StatusCode == 405 {
+		return types.ErrPackageNotFound
+	}
+
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("error getting %s information from RubyGems registry: %s", name, resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(target)
+}
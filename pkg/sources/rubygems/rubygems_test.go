@@ -0,0 +1,72 @@
+package rubygems
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/depshubhq/depshub/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRubyGemsSource_FetchPackageData(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/gems/rails.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"name": "rails", "downloads": 1000, "version": "7.1.4", "licenses": ["MIT"]}`))
+	})
+	mux.HandleFunc("/api/v1/versions/rails.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[
+			{"number": "7.1.4", "platform": "ruby", "licenses": ["MIT"], "created_at": "2024-08-22T21:14:18.000Z"},
+			{"number": "7.1.3", "platform": "ruby", "licenses": ["MIT"], "created_at": "2024-01-16T22:56:20.000Z"}
+		]`))
+	})
+	mux.HandleFunc("/api/v2/rubygems/rails/versions/7.1.2.json", func(w http.ResponseWriter, r *http.Request) {
+		http.NotFound(w, r)
+	})
+	mux.HandleFunc("/api/v2/rubygems/rails/versions/7.1.1.json", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	source := RubyGemsSource{BaseURL: server.URL}
+
+	tests := []struct {
+		name          string
+		lockedVersion string
+		yanked        string
+	}{
+		{name: "locked version", lockedVersion: "7.1.4"},
+		// Versions cleaned from Gemfile requirements like "~> 7.1" aren't locked
+		{name: "without lockfile"},
+		{name: "yanked version", lockedVersion: "7.1.2", yanked: "7.1.2"},
+		{name: "unconfirmed yanked version", lockedVersion: "7.1.1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pkg, err := source.FetchPackageData(context.Background(), "rails", tt.lockedVersion)
+			assert.NoError(t, err)
+			assert.Equal(t, "MIT", pkg.License)
+
+			var deprecated []string
+			for version, v := range pkg.Versions {
+				if v.Deprecated != "" {
+					assert.Equal(t, "yanked", v.Deprecated)
+					deprecated = append(deprecated, version)
+				}
+			}
+
+			if tt.yanked == "" {
+				assert.Empty(t, deprecated)
+			} else {
+				assert.Equal(t, []string{tt.yanked}, deprecated)
+			}
+		})
+	}
+
+	_, err := source.FetchPackageData(context.Background(), "missing", "")
+	assert.ErrorIs(t, err, types.ErrPackageNotFound)
+}
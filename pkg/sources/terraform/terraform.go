@@ -0,0 +1,227 @@
+package terraform
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/depshubhq/depshub/pkg/types"
+)
+
+const DefaultRegistry = "registry.terraform.io"
+
+// Services used when the registry doesn't publish a discovery document
+var defaultServices = Services{
+	Providers: "/v1/providers/",
+	Modules:   "/v1/modules/",
+}
+
+// TerraformSource reads providers and modules from registries implementing the
+// Terraform registry protocol.
+type TerraformSource struct {
+	// The registry of providers and modules without a host, like hashicorp/aws.
+	// Either a host or a URL like http://localhost:8080 for a local mirror.
+	Host   string
+	Client *http.Client
+}
+
+// NewTerraformSource uses the registry from DEPSHUB_TERRAFORM_REGISTRY, if any.
+func NewTerraformSource() TerraformSource {
+	return TerraformSource{
+		Host: os.Getenv("DEPSHUB_TERRAFORM_REGISTRY"),
+	}
+}
+
+// Services is the discovery document served at /.well-known/terraform.json
+type Services struct {
+	Providers string `json:"providers.v1"`
+	Modules   string `json:"modules.v1"`
+}
+
+type Version struct {
+	Version string `json:"version"`
+}
+
+type ProviderVersions struct {
+	Versions []Version `json:"versions"`
+	Warnings []string  `json:"warnings"`
+}
+
+type ModuleVersions struct {
+	Modules []struct {
+		Versions []Version `json:"versions"`
+	} `json:"modules"`
+}
+
+// Release is returned by registry.terraform.io and compatible registries for a
+// single version. It isn't part of the protocol, so it's optional.
+type Release struct {
+	PublishedAt time.Time `json:"published_at"`
+}
+
+// FetchPackageData returns the versions of a provider like hashicorp/aws or of a
+// module like terraform-aws-modules/vpc/aws. The publication date is only
+// fetched for the version in use.
+func (s TerraformSource) FetchPackageData(ctx context.Context, name string, version string) (types.Package, error) {
+	host, address := s.split(name)
+	baseURL := s.baseURL(host)
+
+	services, err := s.discover(ctx, name, baseURL)
+	if err != nil {
+		return types.Package{}, err
+	}
+
+	var result types.Package
+	result.Name = name
+	result.Versions = make(map[string]types.PackageVersion)
+	result.Time = make(map[string]time.Time)
+	result.Downloads = []types.Download{}
+
+	// Providers have a namespace and a type, modules also have a target system
+	var service string
+	if strings.Count(address, "/") == 1 {
+		service = services.Providers
+
+		var versions ProviderVersions
+		if err := s.fetch(ctx, name, resolve(baseURL, service, address+"/versions"), &versions); err != nil {
+			return types.Package{}, err
+		}
+
+		for _, v := range versions.Versions {
+			result.Versions[v.Version] = types.PackageVersion{
+				Name:       name,
+				Version:    v.Version,
+				Deprecated: strings.Join(versions.Warnings, ", "),
+			}
+		}
+	} else {
+		service = services.Modules
+
+		var versions ModuleVersions
+		if err := s.fetch(ctx, name, resolve(baseURL, service, address+"/versions"), &versions); err != nil {
+			return types.Package{}, err
+		}
+
+		for _, module := range versions.Modules {
+			for _, v := range module.Versions {
+				result.Versions[v.Version] = types.PackageVersion{Name: name, Version: v.Version}
+			}
+		}
+	}
+
+	if _, ok := result.Versions[version]; ok {
+		var release Release
+		if err := s.fetch(ctx, name, resolve(baseURL, service, address+"/"+version), &release); err == nil && !release.PublishedAt.IsZero() {
+			result.Time[version] = release.PublishedAt
+		}
+	}
+
+	return result, nil
+}
+
+// split returns the registry host and the address of a provider or module.
+// The host is only part of the name when it isn't the default registry.
+func (s TerraformSource) split(name string) (string, string) {
+	first, rest, found := strings.Cut(name, "/")
+
+	if found && strings.ContainsAny(first, ".:") {
+		return first, rest
+	}
+
+	return s.Host, name
+}
+
+// baseURL returns the URL of a registry host, using http for local registries.
+func (s TerraformSource) baseURL(host string) string {
+	if host == "" {
+		host = DefaultRegistry
+	}
+
+	if strings.HasPrefix(host, "http://") || strings.HasPrefix(host, "https://") {
+		return strings.TrimSuffix(host, "/")
+	}
+
+	if strings.HasPrefix(host, "localhost") || strings.HasPrefix(host, "127.0.0.1") {
+		return "http://" + host
+	}
+
+	return "https://" + host
+}
+
+// discover reads the service URLs of the registry, falling back to the
+// defaults of registry.terraform.io.
+func (s TerraformSource) discover(ctx context.Context, name string, baseURL string) (Services, error) {
+	var services Services
+
+	err := s.fetch(ctx, name, baseURL+"/.well-known/terraform.json", &services)
+	if err == types.ErrPackageNotFound {
+		return defaultServices, nil
+	}
+	if err != nil {
+		return Services{}, err
+	}
+
+	if services.Providers == "" {
+		services.Providers = defaultServices.Providers
+	}
+	if services.Modules == "" {
+		services.Modules = defaultServices.Modules
+	}
+
+	return services, nil
+}
+
+// resolve returns the URL of path relative to a service, which is either
+// relative to the registry or an absolute URL.
+func resolve(baseURL string, service string, path string) string {
+	if !strings.HasSuffix(service, "/") {
+		service += "/"
+	}
+
+	base, err := url.Parse(baseURL + "/")
+	if err != nil {
+		return baseURL + service + path
+	}
+
+	ref, err := url.Parse(service + path)
+	if err != nil {
+		return baseURL + service + path
+	}
+
+	return base.ResolveReference(ref).String()
+}
+
+func (s TerraformSource) fetch(ctx context.Context, name string, endpoint string, target any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request for %s information from Terraform registry: %w", name, err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error getting %s information from Terraform registry: %w", name, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == 404 || resp.StatusCode == 405 {
+		return types.ErrPackageNotFound
+	}
+
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("error getting %s information from Terraform registry: %s", name, resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(target)
+}
@@ -0,0 +1,115 @@
+package terraform
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/depshubhq/depshub/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTerraformSource_FetchPackageData(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/.well-known/terraform.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"providers.v1": "/v1/providers/", "modules.v1": "` + server.URL + `/api/modules/"}`))
+	})
+	mux.HandleFunc("/v1/providers/hashicorp/aws/versions", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"versions": [{"version": "5.57.0"}, {"version": "5.58.0"}]}`))
+	})
+	mux.HandleFunc("/v1/providers/hashicorp/aws/5.57.0", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"version": "5.57.0", "published_at": "2024-07-04T21:54:03Z"}`))
+	})
+	mux.HandleFunc("/v1/providers/hashicorp/template/versions", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"versions": [{"version": "2.2.0"}], "warnings": ["This provider is archived"]}`))
+	})
+	mux.HandleFunc("/api/modules/terraform-aws-modules/vpc/aws/versions", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"modules": [{"versions": [{"version": "5.8.1"}, {"version": "5.9.0"}]}]}`))
+	})
+
+	source := TerraformSource{Host: server.URL}
+
+	t.Run("provider", func(t *testing.T) {
+		pkg, err := source.FetchPackageData(context.Background(), "hashicorp/aws", "5.57.0")
+		assert.NoError(t, err)
+
+		assert.Equal(t, types.Package{
+			Name: "hashicorp/aws",
+			Versions: map[string]types.PackageVersion{
+				"5.57.0": {Name: "hashicorp/aws", Version: "5.57.0"},
+				"5.58.0": {Name: "hashicorp/aws", Version: "5.58.0"},
+			},
+			Time: map[string]time.Time{
+				"5.57.0": time.Date(2024, 7, 4, 21, 54, 3, 0, time.UTC),
+			},
+			Downloads: []types.Download{},
+		}, pkg)
+	})
+
+	t.Run("provider with warnings", func(t *testing.T) {
+		pkg, err := source.FetchPackageData(context.Background(), "hashicorp/template", "2.2.0")
+		assert.NoError(t, err)
+		assert.Equal(t, "This provider is archived", pkg.Versions["2.2.0"].Deprecated)
+		assert.Empty(t, pkg.Time)
+	})
+
+	t.Run("module", func(t *testing.T) {
+		pkg, err := source.FetchPackageData(context.Background(), "terraform-aws-modules/vpc/aws", "5.8.1")
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]types.PackageVersion{
+			"5.8.1": {Name: "terraform-aws-modules/vpc/aws", Version: "5.8.1"},
+			"5.9.0": {Name: "terraform-aws-modules/vpc/aws", Version: "5.9.0"},
+		}, pkg.Versions)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := source.FetchPackageData(context.Background(), "hashicorp/missing", "1.0.0")
+		assert.ErrorIs(t, err, types.ErrPackageNotFound)
+	})
+}
+
+func TestTerraformSource_DefaultServices(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/modules/example-corp/subnets/aws/versions", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"modules": [{"versions": [{"version": "1.2.0"}]}]}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	// Names with a host don't use the default registry
+	source := TerraformSource{Host: "registry.example.com"}
+	host := server.Listener.Addr().String()
+
+	pkg, err := source.FetchPackageData(context.Background(), host+"/example-corp/subnets/aws", "1.2.0")
+	assert.NoError(t, err)
+	assert.Contains(t, pkg.Versions, "1.2.0")
+}
+
+func TestTerraformSource_Split(t *testing.T) {
+	source := TerraformSource{}
+
+	tests := []struct {
+		name    string
+		host    string
+		address string
+	}{
+		{name: "hashicorp/aws", address: "hashicorp/aws"},
+		{name: "terraform-aws-modules/vpc/aws", address: "terraform-aws-modules/vpc/aws"},
+		{name: "app.terraform.io/example-corp/subnets/aws", host: "app.terraform.io", address: "example-corp/subnets/aws"},
+		{name: "localhost:8080/example/tools", host: "localhost:8080", address: "example/tools"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			host, address := source.split(tt.name)
+			assert.Equal(t, tt.host, host)
+			assert.Equal(t, tt.address, address)
+		})
+	}
+}
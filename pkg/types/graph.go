@@ -0,0 +1,241 @@
+package types
+
+import (
+	"slices"
+	"strings"
+)
+
+// Graph is the dependency graph of a manifest, built from the packages of its
+// lockfile. It holds the packages reachable from the direct dependencies.
+type Graph struct {
+	// The packages keyed by ID
+	Nodes map[string]*Node
+	// The IDs of the dependencies of each package
+	Edges map[string][]string
+	// The IDs of the packages of the direct dependencies
+	Roots []string
+	// The IDs of the packages matched to the direct dependencies, keyed by name and version
+	direct map[string]string
+}
+
+// Node is a package of a dependency graph.
+type Node struct {
+	LockedPackage
+	// The number of dependencies from the manifest to the package, 1 for direct dependencies
+	Depth int
+	// The direct dependency introducing the package on a shortest path
+	IntroducedBy Dependency
+	// The IDs of the packages from the direct dependency to the package on a shortest path
+	Path []string
+	// Dev is true when the package is only used by development dependencies
+	Dev bool
+}
+
+// NewGraph builds the graph of the direct dependencies of a manifest and the
+// packages of its lockfile. Direct dependencies are matched to the locked
+// package with the same name and version, or to the one recorded as direct
+// when the manifest has a version range.
+func NewGraph(dependencies []Dependency, packages []LockedPackage) *Graph {
+	g := &Graph{
+		Nodes:  make(map[string]*Node),
+		Edges:  make(map[string][]string),
+		direct: make(map[string]string),
+	}
+
+	byID := make(map[string]LockedPackage)
+	byName := make(map[string][]LockedPackage)
+
+	for _, pkg := range packages {
+		byID[strings.ToLower(pkg.ID())] = pkg
+		byName[strings.ToLower(pkg.Name)] = append(byName[strings.ToLower(pkg.Name)], pkg)
+	}
+
+	var queue []*Node
+	production := make(map[string]bool)
+
+	for _, dep := range dependencies {
+		pkg, ok := matchLocked(dep, byID, byName)
+		if !ok {
+			continue
+		}
+
+		g.direct[strings.ToLower(LockedID(dep.Name, dep.Version))] = pkg.ID()
+
+		if !dep.Dev {
+			production[pkg.ID()] = true
+		}
+
+		if _, ok := g.Nodes[pkg.ID()]; ok {
+			continue
+		}
+
+		node := &Node{LockedPackage: pkg, Depth: 1, IntroducedBy: dep, Path: []string{pkg.ID()}}
+		g.Nodes[pkg.ID()] = node
+		g.Roots = append(g.Roots, pkg.ID())
+		queue = append(queue, node)
+	}
+
+	// Breadth first, so nodes get the shortest path from the first direct dependency using them
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+
+		for _, id := range node.Dependencies {
+			child, ok := byID[strings.ToLower(id)]
+			if !ok {
+				continue
+			}
+
+			if !slices.Contains(g.Edges[node.ID()], child.ID()) {
+				g.Edges[node.ID()] = append(g.Edges[node.ID()], child.ID())
+			}
+
+			if _, ok := g.Nodes[child.ID()]; ok {
+				continue
+			}
+
+			next := &Node{
+				LockedPackage: child,
+				Depth:         node.Depth + 1,
+				IntroducedBy:  node.IntroducedBy,
+				Path:          append(slices.Clone(node.Path), child.ID()),
+			}
+			g.Nodes[child.ID()] = next
+			queue = append(queue, next)
+		}
+	}
+
+	// Packages that production dependencies don't use are development ones
+	used := make(map[string]bool)
+	var walk func(id string)
+	walk = func(id string) {
+		if used[id] {
+			return
+		}
+		used[id] = true
+		for _, child := range g.Edges[id] {
+			walk(child)
+		}
+	}
+
+	for id := range production {
+		walk(id)
+	}
+
+	for id, node := range g.Nodes {
+		node.Dev = !used[id]
+	}
+
+	return g
+}
+
+// Root returns the node of the locked package matched to a direct dependency.
+func (g *Graph) Root(dep Dependency) (*Node, bool) {
+	id, ok := g.direct[strings.ToLower(LockedID(dep.Name, dep.Version))]
+	if !ok {
+		return nil, false
+	}
+
+	node, ok := g.Nodes[id]
+	return node, ok
+}
+
+// Transitive returns the packages that aren't direct dependencies, sorted by
+// depth and ID.
+func (g *Graph) Transitive() []*Node {
+	var nodes []*Node
+
+	for _, node := range g.Nodes {
+		if node.Depth > 1 {
+			nodes = append(nodes, node)
+		}
+	}
+
+	slices.SortFunc(nodes, func(a, b *Node) int {
+		if a.Depth != b.Depth {
+			return a.Depth - b.Depth
+		}
+		return strings.Compare(a.ID(), b.ID())
+	})
+
+	return nodes
+}
+
+// Paths returns the paths from the direct dependencies to a package, as the
+// IDs of the packages from the direct dependency to it. Their number grows
+// exponentially with the packages depending on the same ones, so only the
+// first limit paths are returned. Cycles aren't followed.
+func (g *Graph) Paths(id string, limit int) [][]string {
+	if _, ok := g.Nodes[id]; !ok {
+		return nil
+	}
+
+	// Only the packages depending on the package, directly or not, are walked
+	dependents := make(map[string][]string)
+	for from, edges := range g.Edges {
+		for _, to := range edges {
+			dependents[to] = append(dependents[to], from)
+		}
+	}
+
+	reaches := map[string]bool{id: true}
+	queue := []string{id}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, from := range dependents[current] {
+			if !reaches[from] {
+				reaches[from] = true
+				queue = append(queue, from)
+			}
+		}
+	}
+
+	var paths [][]string
+
+	var walk func(path []string)
+	walk = func(path []string) {
+		current := path[len(path)-1]
+		if current == id {
+			paths = append(paths, slices.Clone(path))
+			return
+		}
+
+		for _, next := range g.Edges[current] {
+			if len(paths) >= limit {
+				return
+			}
+
+			if reaches[next] && !slices.Contains(path, next) {
+				walk(append(path, next))
+			}
+		}
+	}
+
+	for _, root := range g.Roots {
+		if reaches[root] && len(paths) < limit {
+			walk([]string{root})
+		}
+	}
+
+	return paths
+}
+
+func matchLocked(dep Dependency, byID map[string]LockedPackage, byName map[string][]LockedPackage) (LockedPackage, bool) {
+	if pkg, ok := byID[strings.ToLower(LockedID(dep.Name, dep.Version))]; ok {
+		return pkg, true
+	}
+
+	candidates := byName[strings.ToLower(dep.Name)]
+
+	if i := slices.IndexFunc(candidates, func(p LockedPackage) bool { return p.Direct }); i != -1 {
+		return candidates[i], true
+	}
+
+	if len(candidates) == 1 {
+		return candidates[0], true
+	}
+
+	return LockedPackage{}, false
+}
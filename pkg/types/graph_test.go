@@ -0,0 +1,100 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewGraph(t *testing.T) {
+	react := Dependency{Manager: Npm, Name: "react", Version: "^18.3.1", Definition: Definition{Line: 2}}
+	eslint := Dependency{Manager: Npm, Name: "eslint", Version: "9.0.0", Dev: true, Definition: Definition{Line: 5}}
+	missing := Dependency{Manager: Npm, Name: "left-pad", Version: "1.3.0"}
+
+	g := NewGraph([]Dependency{react, eslint, missing}, []LockedPackage{
+		{Manager: Npm, Name: "react", Version: "18.3.1", Dependencies: []string{"loose-envify@1.4.0"}, Direct: true},
+		{Manager: Npm, Name: "react", Version: "17.0.2"},
+		{Manager: Npm, Name: "loose-envify", Version: "1.4.0", Dependencies: []string{"js-tokens@4.0.0"}},
+		{Manager: Npm, Name: "js-tokens", Version: "4.0.0"},
+		{Manager: Npm, Name: "eslint", Version: "9.0.0", Dependencies: []string{"debug@4.3.7", "unknown@1.0.0"}},
+		{Manager: Npm, Name: "debug", Version: "4.3.7", Dependencies: []string{"js-tokens@4.0.0"}},
+		{Manager: Npm, Name: "unused", Version: "1.0.0"},
+	})
+
+	// Direct dependencies missing from the lockfile and unused packages aren't in the graph
+	assert.Equal(t, []string{"react@18.3.1", "eslint@9.0.0"}, g.Roots)
+	assert.Len(t, g.Nodes, 5)
+	assert.Equal(t, []string{"debug@4.3.7"}, g.Edges["eslint@9.0.0"])
+
+	// Direct dependencies with a version range get the locked package recorded as direct
+	root, ok := g.Root(react)
+	assert.True(t, ok)
+	assert.Equal(t, "18.3.1", root.Version)
+	_, ok = g.Root(missing)
+	assert.False(t, ok)
+	assert.Equal(t, []string{"js-tokens@4.0.0"}, g.Edges["debug@4.3.7"])
+
+	// Packages get the shortest path from the first direct dependency using them
+	tokens := g.Nodes["js-tokens@4.0.0"]
+	assert.Equal(t, 3, tokens.Depth)
+	assert.Equal(t, react, tokens.IntroducedBy)
+	assert.Equal(t, []string{"react@18.3.1", "loose-envify@1.4.0", "js-tokens@4.0.0"}, tokens.Path)
+	assert.False(t, tokens.Dev)
+
+	// Packages only used by development dependencies are development ones
+	assert.True(t, g.Nodes["debug@4.3.7"].Dev)
+	assert.Equal(t, eslint, g.Nodes["debug@4.3.7"].IntroducedBy)
+
+	var transitive []string
+	for _, node := range g.Transitive() {
+		transitive = append(transitive, node.ID())
+	}
+	assert.Equal(t, []string{"debug@4.3.7", "loose-envify@1.4.0", "js-tokens@4.0.0"}, transitive)
+}
+
+func TestGraph_Paths(t *testing.T) {
+	a := Dependency{Name: "a", Version: "1.0.0"}
+	b := Dependency{Name: "b", Version: "1.0.0"}
+
+	g := NewGraph([]Dependency{a, b}, []LockedPackage{
+		{Name: "a", Version: "1.0.0", Dependencies: []string{"c@1.0.0", "d@1.0.0"}},
+		{Name: "b", Version: "1.0.0", Dependencies: []string{"d@1.0.0"}},
+		{Name: "c", Version: "1.0.0", Dependencies: []string{"d@1.0.0"}},
+		// Cycles aren't followed
+		{Name: "d", Version: "1.0.0", Dependencies: []string{"c@1.0.0"}},
+	})
+
+	assert.Equal(t, [][]string{
+		{"a@1.0.0", "c@1.0.0", "d@1.0.0"},
+		{"a@1.0.0", "d@1.0.0"},
+		{"b@1.0.0", "d@1.0.0"},
+	}, g.Paths("d@1.0.0", 10))
+
+	assert.Equal(t, [][]string{{"a@1.0.0", "c@1.0.0", "d@1.0.0"}}, g.Paths("d@1.0.0", 1))
+	assert.Equal(t, [][]string{{"a@1.0.0"}}, g.Paths("a@1.0.0", 10))
+	assert.Nil(t, g.Paths("e@1.0.0", 10))
+}
+
+func TestGraph_PathsDiamonds(t *testing.T) {
+	// 40 diamonds in a row, with 2^40 paths from the root to the last package
+	packages := []LockedPackage{{Name: "root", Version: "1.0.0", Dependencies: []string{"top0@1.0.0"}}}
+	for i := 0; i < 40; i++ {
+		next := fmt.Sprintf("top%d@1.0.0", i+1)
+		packages = append(packages,
+			LockedPackage{Name: fmt.Sprintf("top%d", i), Version: "1.0.0", Dependencies: []string{fmt.Sprintf("left%d@1.0.0", i), fmt.Sprintf("right%d@1.0.0", i)}},
+			LockedPackage{Name: fmt.Sprintf("left%d", i), Version: "1.0.0", Dependencies: []string{next}},
+			LockedPackage{Name: fmt.Sprintf("right%d", i), Version: "1.0.0", Dependencies: []string{next}},
+		)
+	}
+	packages = append(packages, LockedPackage{Name: "top40", Version: "1.0.0"})
+
+	g := NewGraph([]Dependency{{Name: "root", Version: "1.0.0"}}, packages)
+
+	paths := g.Paths("top40@1.0.0", 100)
+	assert.Len(t, paths, 100)
+	for _, path := range paths {
+		assert.Equal(t, "root@1.0.0", path[0])
+		assert.Equal(t, "top40@1.0.0", path[len(path)-1])
+	}
+}
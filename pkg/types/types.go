@@ -1,9 +1,12 @@
 package types
 
 import (
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -17,8 +20,64 @@ const (
 	Pyproject
 	Hex
 	Maven
+	Pipfile
+	Bundler
+	Composer
+	NuGet
+	GitHubActions
+	Docker
+	Terraform
+	Pub
+	SwiftPM
+	Helm
+	Conda
+	Deno
 )
 
+// Ecosystem returns the package URL type of the packages of a manager, like
+// pypi for pip, Pipfile and pyproject.toml packages.
+// https://github.com/package-url/purl-spec/blob/main/PURL-TYPES.rst
+func (m ManagerType) Ecosystem() string {
+	switch m {
+	case Npm:
+		return "npm"
+	case Go:
+		return "golang"
+	case Cargo:
+		return "cargo"
+	case Pip, Pyproject, Pipfile:
+		return "pypi"
+	case Hex:
+		return "hex"
+	case Maven:
+		return "maven"
+	case Bundler:
+		return "gem"
+	case Composer:
+		return "composer"
+	case NuGet:
+		return "nuget"
+	case GitHubActions:
+		return "github"
+	case Docker:
+		return "docker"
+	case Terraform:
+		return "terraform"
+	case Pub:
+		return "pub"
+	case SwiftPM:
+		return "swift"
+	case Helm:
+		return "helm"
+	case Conda:
+		return "conda"
+	case Deno:
+		return "jsr"
+	}
+
+	return ""
+}
+
 var ErrPackageNotFound = errors.New("package not found")
 var ErrPackageUnpublished = errors.New("package unpublished")
 
@@ -27,6 +86,8 @@ type Manifest struct {
 	Path         string
 	Dependencies []Dependency
 	*Lockfile
+	// The dependency graph built from the lockfile, nil when the manager doesn't read it
+	Graph *Graph
 }
 
 type Level string
@@ -57,6 +118,18 @@ type RuleSetter interface {
 	SetValue(any) error
 }
 
+// TransitiveRule is a rule that can check the transitive packages of the
+// dependency graphs of the manifests, when include_transitive is set.
+type TransitiveRule interface {
+	SetIncludeTransitive(bool)
+}
+
+// UnknownLicensesRule is a rule checking licenses, which can allow, warn about
+// or deny the licenses that aren't known when unknown_licenses is set.
+type UnknownLicensesRule interface {
+	SetUnknownLicenses(string) error
+}
+
 type Mistake struct {
 	Rule        RuleGetter
 	Definitions []Definition
@@ -66,13 +139,88 @@ var ErrInvalidRuleValue = errors.New("invalid rule value")
 
 type Lockfile struct {
 	Path string
+	// The packages resolved in the lockfile, including the transitive ones.
+	// Only set for the managers reading the packages of their lockfile.
+	Packages []LockedPackage
+}
+
+// LockedPackage is a package resolved in a lockfile.
+type LockedPackage struct {
+	Manager ManagerType
+	Name    string
+	Version string
+	// The checksums of the package archive
+	Hashes []Hash
+	// The IDs of the packages of the lockfile this package depends on
+	Dependencies []string
+	// Direct is true when the lockfile records the package as a dependency of
+	// the project, which tells the version used by a manifest with version ranges
+	Direct bool
+}
+
+// ID identifies a package in its lockfile, like react@18.3.1.
+func (p LockedPackage) ID() string {
+	return LockedID(p.Name, p.Version)
+}
+
+// LockedID returns the ID of the locked package with the given name and version.
+func LockedID(name string, version string) string {
+	return name + "@" + version
+}
+
+// Hash is a checksum of a package. Algorithms are named like SHA-256 and
+// values are hex encoded.
+type Hash struct {
+	Algorithm string
+	Value     string
+}
+
+var integrityAlgorithms = map[string]string{
+	"sha1":   "SHA-1",
+	"sha256": "SHA-256",
+	"sha384": "SHA-384",
+	"sha512": "SHA-512",
+}
+
+// IntegrityHash parses a subresource integrity value like sha512-<base64>, used
+// by npm lockfiles.
+func IntegrityHash(integrity string) (Hash, bool) {
+	algorithm, value, ok := strings.Cut(strings.TrimSpace(integrity), "-")
+	if !ok {
+		return Hash{}, false
+	}
+
+	name, ok := integrityAlgorithms[algorithm]
+	if !ok {
+		return Hash{}, false
+	}
+
+	digest, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return Hash{}, false
+	}
+
+	return Hash{Algorithm: name, Value: hex.EncodeToString(digest)}, true
 }
 
 type Dependency struct {
 	Manager ManagerType
 	Name    string
 	Version string
-	Dev     bool
+	// Digest pins the dependency to its exact content, like sha256:... for container images
+	Digest string
+	// The version requirement declared in the manifest, like ^1.2.0, for the
+	// managers cleaning it or resolving it with the lockfile in Version
+	Constraint string
+	// Locked is true when Version is the exact version installed from the lockfile
+	Locked bool
+	// The URL of the registry hosting the package when the manifest sets one,
+	// like a private pub server. Empty for the default registry.
+	Registry string
+	Dev      bool
+	// The group of the manifest declaring the dependency, like an extra of
+	// pyproject.toml, for the managers with more than one group per Dev value
+	Group string
 	Definition
 }
 
@@ -86,6 +234,8 @@ type PackageVersion struct {
 	Name       string `json:"name"`
 	Version    string `json:"version"`
 	Deprecated string `json:"deprecated"`
+	// The SPDX license expression of the version, when the registry provides it
+	License string `json:"license"`
 }
 
 type Download struct {
@@ -93,23 +243,57 @@ type Download struct {
 	Downloads int
 }
 
-// A map of package names to package information.
-type PackagesInfo = map[string]Package
+// PackagesInfo is a map of package keys to package information. Packages are
+// keyed by ecosystem and name, see PackageKey, since registries of different
+// ecosystems have packages with the same name, like redis on npm and Docker Hub.
+type PackagesInfo map[string]Package
+
+// PackageKey returns the key of a package in PackagesInfo, like npm:redis.
+func PackageKey(manager ManagerType, name string) string {
+	return manager.Ecosystem() + ":" + name
+}
+
+// Get returns the information of the package of a manager.
+func (p PackagesInfo) Get(manager ManagerType, name string) (Package, bool) {
+	pkg, ok := p[PackageKey(manager, name)]
+	return pkg, ok
+}
 
 type Package struct {
-	Name      string
-	Versions  map[string]PackageVersion
-	Time      map[string]time.Time `json:"time"`
+	Name     string
+	Versions map[string]PackageVersion
+	Time     map[string]time.Time `json:"time"`
+	// The license of the latest version, or of the package when the registry doesn't have licenses per version
 	License   string
 	Downloads []Download
 }
 
+// LicenseOf returns the license of a version, or the license of the package
+// when the version doesn't have one.
+func (p Package) LicenseOf(version string) string {
+	if v, ok := p.Versions[version]; ok && v.License != "" {
+		return v.License
+	}
+
+	return p.License
+}
+
+// SetVersionLicense sets the license of a version listed in Versions.
+func (p *Package) SetVersionLicense(version string, license string) {
+	if v, ok := p.Versions[version]; ok {
+		v.License = license
+		p.Versions[version] = v
+	}
+}
+
 func (pv *PackageVersion) UnmarshalJSON(data []byte) error {
 	// Create an auxiliary struct with Deprecated as json.RawMessage
 	aux := struct {
-		Name       string          `json:"name"`
-		Version    string          `json:"version"`
-		Deprecated json.RawMessage `json:"deprecated"`
+		Name       string            `json:"name"`
+		Version    string            `json:"version"`
+		Deprecated json.RawMessage   `json:"deprecated"`
+		License    json.RawMessage   `json:"license"`
+		Licenses   []json.RawMessage `json:"licenses"`
 	}{}
 
 	if err := json.Unmarshal(data, &aux); err != nil {
@@ -120,6 +304,20 @@ func (pv *PackageVersion) UnmarshalJSON(data []byte) error {
 	pv.Name = aux.Name
 	pv.Version = aux.Version
 
+	// Licenses of versions are optional, ignore the ones that can't be parsed
+	pv.License, _ = parseLicense(aux.License)
+
+	// Old npm packages list licenses like [{"type": "MIT"}, {"type": "GPL-2.0"}]
+	if pv.License == "" && len(aux.Licenses) > 0 {
+		var licenses []string
+		for _, l := range aux.Licenses {
+			if license, err := parseLicense(l); err == nil && license != "" {
+				licenses = append(licenses, license)
+			}
+		}
+		pv.License = strings.Join(licenses, " OR ")
+	}
+
 	// Handle the Deprecated field based on its type
 	if len(aux.Deprecated) == 0 {
 		pv.Deprecated = ""
@@ -182,12 +380,24 @@ func checkUnpublished(timeMap map[string]json.RawMessage) error {
 }
 
 func handleLicense(licenseData json.RawMessage, pd *Package) error {
+	license, err := parseLicense(licenseData)
+	if err != nil {
+		return err
+	}
+
+	pd.License = license
+	return nil
+}
+
+// parseLicense reads licenses like "MIT" or {"type": "MIT", "url": "..."}
+func parseLicense(licenseData json.RawMessage) (string, error) {
 	if len(licenseData) == 0 {
-		return nil // No license data
+		return "", nil // No license data
 	}
 
-	if err := json.Unmarshal(licenseData, &pd.License); err == nil {
-		return nil
+	var value string
+	if err := json.Unmarshal(licenseData, &value); err == nil {
+		return value, nil
 	}
 
 	var license struct {
@@ -195,11 +405,10 @@ func handleLicense(licenseData json.RawMessage, pd *Package) error {
 		URL  string `json:"url"`
 	}
 	if err := json.Unmarshal(licenseData, &license); err != nil {
-		return fmt.Errorf("invalid license format: %w", err)
+		return "", fmt.Errorf("invalid license format: %w", err)
 	}
 
-	pd.License = license.Type
-	return nil
+	return license.Type, nil
 }
 
 func handleTime(timeMap map[string]json.RawMessage, pd *Package) error {